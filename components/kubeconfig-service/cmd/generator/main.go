@@ -38,6 +38,7 @@ func main() {
 	router := mux.NewRouter()
 	router.Use(authn.AuthMiddleware(oidcAuthenticator))
 	router.Methods("GET").Path("/kubeconfig/{tenantID}/{runtimeID}").HandlerFunc(ec.GetKubeConfig)
+	router.Methods("DELETE").Path("/kubeconfig/{tenantID}/{runtimeID}").HandlerFunc(ec.RevokeKubeConfig)
 
 	healthRouter := mux.NewRouter()
 	healthRouter.Methods("GET").Path("/health/ready").HandlerFunc(ec.GetHealthStatus)
@@ -45,16 +46,14 @@ func main() {
 	term := make(chan os.Signal)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
-	go func() {
-		err := runtime.SetupConfigMap()
-		if err != nil {
-			log.Errorf("Error initializing ConfigMaps: %v", err)
-			term <- os.Interrupt
-		}
-		log.Infof("ConfigMaps initialization completed.")
-	}()
+	kcpK8s, err := runtime.GetK8sClient()
+	if err != nil {
+		log.Fatalf("Cannot create KCP client, %v", err)
+	}
+	sweeper := runtime.NewSweeper(kcpK8s, env.Config.GraphqlURL, env.Config.Sweeper.Interval, runtime.TokenTTL())
+	go sweeper.Run(fileWatcherCtx)
 
-	log.Infof("ConfigMaps initialization started.")
+	log.Infof("Sweeper of expired kubeconfig users started, interval: %v, token TTL: %v", env.Config.Sweeper.Interval, runtime.TokenTTL())
 
 	go func() {
 		err := http.ListenAndServe(fmt.Sprintf(":%d", env.Config.Port.Service), router)
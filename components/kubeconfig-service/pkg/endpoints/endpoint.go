@@ -3,7 +3,6 @@ package endpoints
 import (
 	"errors"
 	"net/http"
-	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -19,9 +18,6 @@ const (
 	mimeTypeText = "text/plain"
 )
 
-// mutex to avoid critical section in config map deployment
-var mu sync.Mutex
-
 // EndpointClient Wrpper for Endpoints
 type EndpointClient struct {
 	gqlURL string
@@ -67,6 +63,35 @@ func (ec EndpointClient) GetKubeConfig(w http.ResponseWriter, req *http.Request)
 	}
 }
 
+// RevokeKubeConfig REST Path for revoking the access of the calling user to the runtime
+func (ec EndpointClient) RevokeKubeConfig(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	tenant := vars["tenantID"]
+	runtime := vars["runtimeID"]
+
+	var err error
+	userInfo, ok := req.Context().Value("userInfo").(authn.UserInfo)
+	if ok {
+		log.Infof("Revoking kubeconfig for %s/%s %s", tenant, runtime, userInfo)
+		err = ec.revokeKubeConfig(tenant, runtime, userInfo)
+	} else {
+		err = errors.New("User info is null")
+	}
+
+	if err != nil {
+		w.Header().Add("Content-Type", mimeTypeText)
+		w.Header().Set("Content-Security-Policy", "default-src 'none';")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, err2 := w.Write([]byte(err.Error()))
+		log.Errorf("Error while revoking the kubeconfig: %s", err)
+		if err2 != nil {
+			log.Errorf("Error while sending response: %s", err2)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // GetHealthStatus REST Path for health checks
 func (ec EndpointClient) GetHealthStatus(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -97,6 +122,14 @@ func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo aut
 		return nil, err
 	}
 
+	// The ConfigMap entry is stored first, so the sweeper does not treat the new objects as orphaned
+	startTime := time.Now()
+	err = runtimeClient.DeployConfigMap(runtime, userInfo.Role, startTime)
+	if err != nil {
+		log.Errorf("Cannot generate config map, %s", err.Error())
+		return nil, err
+	}
+
 	tc.SaToken, err = runtimeClient.Run()
 	if err != nil {
 		return nil, err
@@ -107,16 +140,19 @@ func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo aut
 		return nil, err
 	}
 
-	mu.Lock()
-	startTime := time.Now()
-	err = runtimeClient.DeployConfigMap(runtime, userInfo.Role, startTime)
-	mu.Unlock()
+	return saKubeConfig, nil
+}
+
+func (ec EndpointClient) revokeKubeConfig(tenant, runtime string, userInfo authn.UserInfo) error {
+	rawConfig, err := ec.callGQL(tenant, runtime)
 	if err != nil {
-		log.Errorf("Cannot generate config map, %s", err.Error())
-		return nil, err
+		return err
 	}
 
-	go runtimeClient.SetupTimer(startTime, runtime)
+	runtimeClient, err := run.NewRuntimeClient([]byte(rawConfig), userInfo.ID, userInfo.Role, tenant)
+	if err != nil {
+		return err
+	}
 
-	return saKubeConfig, nil
+	return runtimeClient.Revoke(runtime)
 }
@@ -1,6 +1,8 @@
 package env
 
 import (
+	"time"
+
 	"github.com/vrischmann/envconfig"
 )
 
@@ -32,6 +34,12 @@ type EnvConfig struct {
 		}
 		SupportedSigningAlgs []string `envconfig:"default=RS256"`
 	}
+	Token struct {
+		TTL time.Duration `envconfig:"default=24h"`
+	}
+	Sweeper struct {
+		Interval time.Duration `envconfig:"default=10m"`
+	}
 	LogLevel string `envconfig:"default=info"`
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,6 +42,8 @@ const RUNTIME_ADMIN = "runtimeAdmin"
 const RUNTIME_OPERATOR = "runtimeOperator"
 const ServiceAccount = "ServiceAccount"
 const Token = "token"
+const ManagedByLabel = "app.kubernetes.io/managed-by"
+const ManagedByValue = "kubeconfig-service"
 
 var L2L3OperatorPolicyRule = map[string][]rbacv1.PolicyRule{
 	RUNTIME_ADMIN: {
@@ -210,6 +213,37 @@ func (rtc *RuntimeClient) createClusterRoleBinding() error {
 	return err
 }
 
+// ManagedUsers returns the IDs of the users owning a ServiceAccount, ClusterRole or ClusterRoleBinding
+// labeled as managed by the kubeconfig-service in the runtime
+func (rtc *RuntimeClient) ManagedUsers() (map[string]bool, error) {
+	listOptions := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", ManagedByLabel, ManagedByValue)}
+	users := map[string]bool{}
+
+	serviceAccounts, err := rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ServiceAccounts")
+	}
+	for _, sa := range serviceAccounts.Items {
+		users[sa.Name] = true
+	}
+	clusterRoles, err := rtc.K8s.RbacV1().ClusterRoles().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ClusterRoles")
+	}
+	for _, cr := range clusterRoles.Items {
+		users[strings.TrimSuffix(cr.Name, "-rules")] = true
+	}
+	bindings, err := rtc.K8s.RbacV1().ClusterRoleBindings().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ClusterRoleBindings")
+	}
+	for _, crb := range bindings.Items {
+		users[crb.Name] = true
+	}
+
+	return users, nil
+}
+
 func (rtc *RuntimeClient) deleteServiceAccount() (bool, error) {
 	err := rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Delete(context.TODO(), rtc.User.ServiceAccountName, metav1.DeleteOptions{})
 	if err == nil || apierr.IsNotFound(err) {
@@ -316,16 +350,21 @@ func (rtc *RuntimeClient) verifyCRBinding(roleRef rbacv1.RoleRef, subjects []rba
 	return false, err
 }
 
+// getServiceAccountToken requests a short-lived token bound to the ServiceAccount, it stops working
+// when the TTL passes or the ServiceAccount is deleted
 func (rtc *RuntimeClient) getServiceAccountToken() (string, error) {
-	var expirationSeconds int64 = int64(ExpireTime.Seconds())
+	var expirationSeconds int64 = int64(TokenTTL().Seconds())
 	tokenRequest := authenticationv1.TokenRequest{
 		Spec: authenticationv1.TokenRequestSpec{
 			ExpirationSeconds: &expirationSeconds,
 		},
 	}
 	req, err := rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).CreateToken(context.TODO(), rtc.User.ServiceAccountName, &tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
 
-	return req.Status.Token, err
+	return req.Status.Token, nil
 }
 
 func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
@@ -333,6 +372,7 @@ func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      user.ServiceAccountName,
 			Namespace: user.Namespace,
+			Labels:    map[string]string{ManagedByLabel: ManagedByValue},
 		},
 	}
 }
@@ -343,6 +383,7 @@ func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggr
 			Name: clusterRoleName,
 			Labels: map[string]string{
 				aggregationLabel: "true",
+				ManagedByLabel:   ManagedByValue,
 			},
 		},
 		Rules: L2L3OperatorPolicyRule[l2L3OperatiorRole],
@@ -352,7 +393,8 @@ func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggr
 func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregationLabel string) *rbacv1.ClusterRole {
 	clusterrole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterRoleName,
+			Name:   clusterRoleName,
+			Labels: map[string]string{ManagedByLabel: ManagedByValue},
 		},
 		AggregationRule: &rbacv1.AggregationRule{
 			ClusterRoleSelectors: []metav1.LabelSelector{},
@@ -370,7 +412,8 @@ func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregati
 
 func initCRBindingE(user SAInfo) (metav1.ObjectMeta, rbacv1.RoleRef, []rbacv1.Subject) {
 	objectMeta := metav1.ObjectMeta{
-		Name: user.ClusterRoleBindingName,
+		Name:   user.ClusterRoleBindingName,
+		Labels: map[string]string{ManagedByLabel: ManagedByValue},
 	}
 
 	roleRef := rbacv1.RoleRef{
@@ -2,7 +2,6 @@ package runtime
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -10,15 +9,16 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/caller"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/env"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
+	"k8s.io/client-go/util/retry"
 )
 
 /*
@@ -36,67 +36,203 @@ Data:map[string]string
 
 const KcpNamespace string = "kcp-system"
 
-const ExpireTime time.Duration = 24 * time.Hour
+// DefaultTokenTTL is used when no token TTL is configured
+const DefaultTokenTTL time.Duration = 24 * time.Hour
 
-type JsonPatchType struct {
-	Op    string `json:"op"`
-	Path  string `json:"path"`
-	Value string `json:"value"`
+const startTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+
+// TokenTTL returns how long the generated kubeconfig is valid, the per-user objects are swept afterwards
+func TokenTTL() time.Duration {
+	if env.Config.Token.TTL > 0 {
+		return env.Config.Token.TTL
+	}
+	return DefaultTokenTTL
 }
 
-func SetupConfigMap() error {
-	configMapList, err := getConfigMapList()
-	if configMapList == nil && err == nil {
-		log.Info("No Timer will be setup.")
-		return nil
-	} else if err != nil {
-		log.Errorf("Failed to setup timer.")
+// Sweeper removes the per-user ServiceAccounts, ClusterRoles and ClusterRoleBindings from the runtimes
+// once the generated kubeconfig expired. The start time of each user and runtime is stored in a ConfigMap.
+type Sweeper struct {
+	KcpK8s            kubernetes.Interface
+	Interval          time.Duration
+	TTL               time.Duration
+	RuntimeKubeconfig func(tenantID, runtimeID string) (string, error)
+	NewRuntimeClient  func(kubeConfig []byte, userID string, L2L3OperatiorRole string, tenant string) (*RuntimeClient, error)
+}
+
+func NewSweeper(kcpK8s kubernetes.Interface, graphqlURL string, interval, ttl time.Duration) *Sweeper {
+	return &Sweeper{
+		KcpK8s:   kcpK8s,
+		Interval: interval,
+		TTL:      ttl,
+		RuntimeKubeconfig: func(tenantID, runtimeID string) (string, error) {
+			status, err := caller.NewCaller(graphqlURL, tenantID).RuntimeStatus(runtimeID)
+			if err != nil {
+				return "", err
+			}
+			return *status.RuntimeConfiguration.Kubeconfig, nil
+		},
+		NewRuntimeClient: NewRuntimeClient,
+	}
+}
+
+// Run sweeps immediately and then in every interval until the context is cancelled
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sweep(time.Now()); err != nil {
+			log.Errorf("Failed to sweep expired kubeconfig users: %s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep revokes the access of all users whose kubeconfig expired before now. In every runtime with an expired
+// kubeconfig it also removes the managed objects of the users without a ConfigMap entry for the runtime.
+func (s *Sweeper) Sweep(now time.Time) error {
+	configMaps, err := s.listConfigMaps()
+	if err != nil {
 		return err
 	}
 
-	for _, configMap := range (*configMapList).Items {
-		userID := configMap.ObjectMeta.Name
-		role := configMap.ObjectMeta.Annotations["role"]
-		tenantID := configMap.ObjectMeta.Annotations["tenant"]
+	runtimes := map[string]string{}
+	for _, configMap := range configMaps {
 		for runtimeID, startTimeString := range configMap.Data {
-			log.Infof("Found ConfigMap for runtime %s user %s.", runtimeID, userID)
-			c := caller.NewCaller(env.Config.GraphqlURL, tenantID)
-			status, err := c.RuntimeStatus(runtimeID)
-			if strings.Contains(fmt.Sprint(err), "not found") && strings.Contains(fmt.Sprint(err), "error getting Shoot") {
-				//delete ConfigMap if shoot no longer exists
-				coreClientset, err := GetK8sClient()
-				if err != nil {
-					log.Errorf("Failed to create core client set.")
-					return err
-				}
-				err = cleanConfigMap(coreClientset, userID, runtimeID)
-				if err != nil {
-					log.Errorf("Failed to clean ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-					return err
-				}
-				continue
-			} else if err != nil {
-				log.Errorf("Failed to fetch runtime status.")
-				return err
+			if s.expired(now, startTimeString) {
+				runtimes[runtimeID] = configMap.ObjectMeta.Annotations["tenant"]
 			}
-			rawConfig := *status.RuntimeConfiguration.Kubeconfig
-			rtc, err := NewRuntimeClient([]byte(rawConfig), userID, role, tenantID)
-			if err != nil {
-				log.Errorf("Failed to create runtime client.")
-				return err
-			}
-			startTime, err := time.Parse("2006-01-02 15:04:05 +0000 UTC", startTimeString)
-			if err != nil {
-				log.Errorf("Failed to convert start time.")
-				return err
-			}
-			go rtc.SetupTimer(startTime, runtimeID)
+		}
+	}
+
+	var sweepErr error
+	for runtimeID, tenantID := range runtimes {
+		if err := s.sweepRuntime(now, tenantID, runtimeID); err != nil {
+			log.Errorf("Failed to sweep runtime %s, %s", runtimeID, err.Error())
+			sweepErr = err
+		}
+	}
+
+	return sweepErr
+}
+
+func (s *Sweeper) listConfigMaps() ([]v1.ConfigMap, error) {
+	configMapList, err := s.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: "service=kubeconfig"})
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing kubeconfig ConfigMaps")
+	}
+	return configMapList.Items, nil
+}
+
+func (s *Sweeper) expired(now time.Time, startTimeString string) bool {
+	startTime, err := time.Parse(startTimeLayout, startTimeString)
+	if err != nil {
+		log.Warnf("Invalid start time %q, sweeping it.", startTimeString)
+		return true
+	}
+	return !now.Before(startTime.Add(s.TTL))
+}
+
+func (s *Sweeper) sweepRuntime(now time.Time, tenantID, runtimeID string) error {
+	// The ConfigMaps are read again, the user could have requested a new kubeconfig in the meantime
+	configMaps, err := s.listConfigMaps()
+	if err != nil {
+		return err
+	}
+	live := map[string]bool{}
+	expired := map[string]*v1.ConfigMap{}
+	for i, configMap := range configMaps {
+		startTimeString, ok := configMap.Data[runtimeID]
+		if !ok {
+			continue
+		}
+		userID := configMap.ObjectMeta.Name
+		if s.expired(now, startTimeString) {
+			log.Infof("Kubeconfig expired for runtime %s user %s.", runtimeID, userID)
+			expired[userID] = &configMaps[i]
+		} else {
+			live[userID] = true
+		}
+	}
+
+	// The expired entry is removed only if it was not renewed, the objects are revoked only for the removed entries
+	roles := map[string]string{}
+	for userID, configMap := range expired {
+		removed, err := removeConfigMapEntry(s.KcpK8s, userID, runtimeID, configMap.Data[runtimeID])
+		if err != nil {
+			return errors.Wrapf(err, "while cleaning ConfigMap of user %s", userID)
+		}
+		if !removed {
+			log.Infof("Kubeconfig renewed for runtime %s user %s.", runtimeID, userID)
+			live[userID] = true
+			continue
+		}
+		roles[userID] = configMap.ObjectMeta.Annotations["role"]
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+
+	rawConfig, err := s.RuntimeKubeconfig(tenantID, runtimeID)
+	if isShootNotFound(err) {
+		// Nothing left on the runtime
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "while fetching runtime status")
+	}
+
+	clients := map[string]*RuntimeClient{}
+	for userID, role := range roles {
+		if clients[userID], err = s.runtimeClient(rawConfig, userID, role, tenantID); err != nil {
+			return err
+		}
+	}
+
+	// The objects of the users without an entry in the ConfigMaps are swept as well
+	var lister *RuntimeClient
+	for _, rtc := range clients {
+		lister = rtc
+		break
+	}
+	managed, err := lister.ManagedUsers()
+	if err != nil {
+		return errors.Wrap(err, "while listing managed objects")
+	}
+	for userID := range managed {
+		if clients[userID] != nil || live[userID] {
+			continue
+		}
+		log.Infof("No kubeconfig found for runtime %s user %s, sweeping it.", runtimeID, userID)
+		if clients[userID], err = s.runtimeClient(rawConfig, userID, "", tenantID); err != nil {
+			return err
+		}
+	}
+
+	for userID, rtc := range clients {
+		if err := rtc.RevokeObjects(runtimeID); err != nil {
+			return errors.Wrapf(err, "while revoking user %s", userID)
 		}
 	}
 
 	return nil
 }
 
+func (s *Sweeper) runtimeClient(rawConfig, userID, role, tenantID string) (*RuntimeClient, error) {
+	rtc, err := s.NewRuntimeClient([]byte(rawConfig), userID, role, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating runtime client")
+	}
+	rtc.KcpK8s = s.KcpK8s
+	return rtc, nil
+}
+
+func isShootNotFound(err error) bool {
+	return strings.Contains(fmt.Sprint(err), "not found") && strings.Contains(fmt.Sprint(err), "error getting Shoot")
+}
+
 func GetK8sConfig() (*restclient.Config, error) {
 	k8sConfig, err := restclient.InClusterConfig()
 	if err != nil {
@@ -126,185 +262,150 @@ func GetK8sClient() (kubernetes.Interface, error) {
 	return clientset, err
 }
 
-func (rtc *RuntimeClient) SetupTimer(startTime time.Time, runtimeID string) {
-	userID := rtc.User.ServiceAccountName
-	endTime := startTime.Add(ExpireTime)
-	duration := time.Until(endTime)
-	if duration >= 0 {
-		timer := time.NewTimer(duration)
-		<-timer.C
-		defer timer.Stop()
+// Revoke removes the user's objects from the runtime right away, which invalidates the issued tokens,
+// and removes the runtime from the user's ConfigMap
+func (rtc *RuntimeClient) Revoke(runtimeID string) error {
+	if err := rtc.RevokeObjects(runtimeID); err != nil {
+		return err
 	}
 
-	//After timer, check start time, if changed, clean up SA and ConfigMap
-	timeBefore := strings.Split(startTime.String(), " m=")[0]
-	cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), userID, metav1.GetOptions{})
-	if err != nil {
-		log.Errorf("Failed to get ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-		return
-	}
-	timeAfter := cm.Data[runtimeID]
-	if timeBefore != timeAfter {
-		log.Infof("StartTime changed for runtime %s for user %s, skip clean up.", runtimeID, userID)
-		return
+	err := rtc.UpdateConfigMap(runtimeID)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while cleaning ConfigMap")
 	}
 
-	log.Infof("Start to clean everything for runtime %s for user %s.", runtimeID, userID)
-	rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA)
-	rtc.RollbackE.Data = append(rtc.RollbackE.Data, ClusterRole)
-	rtc.RollbackE.Data = append(rtc.RollbackE.Data, ClusterRoleBinding)
-	err = rtc.Cleaner()
-	if err != nil {
-		log.Errorf("Failed to clean runtime %s for user %s.", runtimeID, userID)
-		return
-	}
+	return nil
+}
 
-	err = rtc.UpdateConfigMap(runtimeID)
-	if err != nil {
-		log.Errorf("Failed to clean ConfigMap for runtime %s user %s", runtimeID, userID)
-		return
+// RevokeObjects removes the user's objects from the runtime, the user's ConfigMap is not changed
+func (rtc *RuntimeClient) RevokeObjects(runtimeID string) error {
+	userID := rtc.User.ServiceAccountName
+	log.Infof("Start to revoke access to runtime %s for user %s.", runtimeID, userID)
+
+	if err := rtc.deleteCRBinding(); err != nil {
+		return errors.Wrapf(err, "while deleting ClusterRoleBinding %s", rtc.User.ClusterRoleBindingName)
+	}
+	for _, name := range []string{rtc.User.ClusterRoleName, rtc.User.ClusterRoleRulesName} {
+		if _, err := rtc.deleteClusterRole(name); err != nil {
+			return errors.Wrapf(err, "while deleting ClusterRole %s", name)
+		}
 	}
+	if _, err := rtc.deleteServiceAccount(); err != nil {
+		return errors.Wrapf(err, "while deleting ServiceAccount %s in %s", rtc.User.ServiceAccountName, rtc.User.Namespace)
+	}
+	log.Infof("Access to runtime %s revoked for user %s.", runtimeID, userID)
+
+	return nil
 }
 
 func (rtc *RuntimeClient) UpdateConfigMap(runtimeID string) error {
 	log.Infof("Trying to remove expired information for runtime %s.", runtimeID)
 	userID := rtc.User.ServiceAccountName
 
-	//checking configmap existance
-	cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), userID, metav1.GetOptions{})
+	removed, err := removeConfigMapEntry(rtc.KcpK8s, userID, runtimeID, "")
 	if err != nil {
-		log.Errorf("Failed to get ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
+		log.Errorf("Failed to clean ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
 		return err
 	}
-	if len(cm.Data[runtimeID]) == 0 {
+	if !removed {
 		log.Infof("Configmap of runtime %s already deleted.", runtimeID)
-		return nil
-	}
-
-	err = cleanConfigMap(rtc.KcpK8s, userID, runtimeID)
-	if err != nil {
-		log.Errorf("Failed to clean ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-		return err
 	}
 
 	return nil
 }
 
+// DeployConfigMap stores the start time of the user's kubeconfig for the runtime. The ConfigMap is updated with
+// its resourceVersion, so concurrent changes of other runtimes' entries are retried instead of overwritten.
 func (rtc *RuntimeClient) DeployConfigMap(runtimeID string, L2L3OperatorRole string, startTime time.Time) error {
 	userID := rtc.User.ServiceAccountName
 	tenantID := rtc.User.TenantID
 	startTimeString := strings.Split(startTime.String(), " m=")[0]
+	configMaps := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace)
 
-	log.Info("Checking if the user exists")
-	cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), userID, metav1.GetOptions{})
-	if k8serrors.IsNotFound(err) {
-		log.Info("User doens't exist. Trying to create configmap.")
-		configmap := &v1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:        userID,
-				Namespace:   KcpNamespace,
-				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": L2L3OperatorRole, "tenant": tenantID},
-			},
-			Data: map[string]string{runtimeID: startTimeString},
-		}
-		_, err = rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Create(context.Background(), configmap, metav1.CreateOptions{})
-		if err != nil && !k8serrors.IsAlreadyExists(err) {
-			log.Errorf("Failed to create ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-			return err
-		}
-		log.Infof("Configmap created for runtime %s user %s.", runtimeID, userID)
-	} else if err != nil {
-		log.Errorf("Failed to get ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-		return err
-	} else {
-		log.Info("User already exist. Trying to update ConfigMap.")
-		var patches []*JsonPatchType
-		var patch *JsonPatchType
-		path := "/data/" + runtimeID
-		if len(cm.Data[runtimeID]) != 0 {
-			log.Info("Runtime already exist. Trying to update expire time.")
-			patch = &JsonPatchType{
-				Op:    "replace",
-				Path:  path,
-				Value: startTimeString,
-			}
-		} else {
-			log.Info("Runtime not exist. Trying to create new entry.")
-			patch = &JsonPatchType{
-				Op:    "add",
-				Path:  path,
-				Value: startTimeString,
+	err := retry.OnError(retry.DefaultRetry, isConfigMapConflict, func() error {
+		cm, err := configMaps.Get(context.Background(), userID, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			log.Info("User doens't exist. Trying to create configmap.")
+			configmap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        userID,
+					Namespace:   KcpNamespace,
+					Labels:      map[string]string{"service": "kubeconfig"},
+					Annotations: map[string]string{"role": L2L3OperatorRole, "tenant": tenantID},
+				},
+				Data: map[string]string{runtimeID: startTimeString},
 			}
-		}
-		patches = append(patches, patch)
-		payload, err := json.Marshal(patches)
-		if err != nil {
-			log.Errorf("Failed to marshal patch for user %s runtime %s, %s", userID, runtimeID, err.Error())
+			_, err = configMaps.Create(context.Background(), configmap, metav1.CreateOptions{})
 			return err
-		}
-		_, err = rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Patch(context.Background(), userID, types.JSONPatchType, payload, metav1.PatchOptions{})
-		if err != nil {
-			log.Errorf("Failed to update ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
+		} else if err != nil {
 			return err
 		}
-		log.Infof("Configmap updated for runtime %s user %s.", runtimeID, userID)
+
+		log.Info("User already exist. Trying to update ConfigMap.")
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[runtimeID] = startTimeString
+		_, err = configMaps.Update(context.Background(), cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		log.Errorf("Failed to deploy ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
+		return err
 	}
+	log.Infof("Configmap deployed for runtime %s user %s.", runtimeID, userID)
 
 	return nil
 }
 
-func getConfigMapList() (*v1.ConfigMapList, error) {
-	coreClientset, err := GetK8sClient()
-	if err != nil {
-		log.Errorf("Failed to get kcp k8s client.")
-		return nil, err
-	}
-	cmlist, err := coreClientset.CoreV1().ConfigMaps(KcpNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: "service=kubeconfig"})
-	if k8serrors.IsNotFound(err) {
-		log.Info("All ConfigMap cleaned up.")
-		return nil, nil
-	} else if err != nil {
-		log.Errorf("Failed to get ConfigMap list: %s", err.Error())
-		return nil, err
-	}
-	return cmlist, nil
-}
+// removeConfigMapEntry removes the runtime from the user's ConfigMap and deletes the ConfigMap if no runtime is left.
+// If expectedStartTime is set, the entry is removed only if it still has this start time.
+// It returns false if the entry was not removed.
+func removeConfigMapEntry(coreClientset kubernetes.Interface, userID, runtimeID, expectedStartTime string) (bool, error) {
+	configMaps := coreClientset.CoreV1().ConfigMaps(KcpNamespace)
+	removed := false
 
-func cleanConfigMap(coreClientset kubernetes.Interface, userID string, runtimeID string) error {
-	var patches []*JsonPatchType
-	path := "/data/" + runtimeID
-	patch := &JsonPatchType{
-		Op:   "remove",
-		Path: path,
-	}
-	patches = append(patches, patch)
-	payload, err := json.Marshal(patches)
-	if err != nil {
-		log.Errorf("Failed to marshal patch, %s", err.Error())
-		return err
-	}
-	_, err = coreClientset.CoreV1().ConfigMaps(KcpNamespace).Patch(context.Background(), userID, types.JSONPatchType, payload, metav1.PatchOptions{})
-	if err != nil {
-		log.Errorf("Failed to update ConfigMap, %s", err.Error())
-		return err
-	}
-	log.Infof("Succeeded in cleaning up everything for runtime %s user %s", runtimeID, userID)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		removed = false
+		cm, err := configMaps.Get(context.Background(), userID, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		startTimeString, ok := cm.Data[runtimeID]
+		if !ok || (expectedStartTime != "" && startTimeString != expectedStartTime) {
+			return nil
+		}
 
-	//remove user ConfigMap if no runtime left
-	cm, err := coreClientset.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), userID, metav1.GetOptions{})
-	if err != nil {
-		log.Errorf("Failed to get ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
-		return err
-	}
-	if len(cm.Data) == 0 {
-		log.Infof("No runtime left for user %s, start to remove ConfigMap.", userID)
-		err = coreClientset.CoreV1().ConfigMaps(KcpNamespace).Delete(context.Background(), userID, metav1.DeleteOptions{})
+		delete(cm.Data, runtimeID)
+		if len(cm.Data) == 0 {
+			log.Infof("No runtime left for user %s, start to remove ConfigMap.", userID)
+			err = configMaps.Delete(context.Background(), userID, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{ResourceVersion: &cm.ResourceVersion},
+			})
+			if k8serrors.IsNotFound(err) {
+				return nil
+			}
+		} else {
+			_, err = configMaps.Update(context.Background(), cm, metav1.UpdateOptions{})
+		}
 		if err != nil {
-			log.Errorf("Failed to delete ConfigMap for user %s", userID)
 			return err
 		}
-		log.Infof("Succeeded in removing ConfigMap for user %s.", userID)
+		removed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
-	return nil
+	if removed {
+		log.Infof("Succeeded in cleaning up everything for runtime %s user %s", runtimeID, userID)
+	}
+
+	return removed, nil
+}
+
+func isConfigMapConflict(err error) bool {
+	return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err)
 }
@@ -10,7 +10,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 var sa1 = corev1.ServiceAccount{
@@ -319,4 +321,229 @@ func TestCreateserviceaccount(t *testing.T) {
 		assert.NotEqual(t, "startTime1", cm.Data["runtime1"])
 	})
 
+	t.Run("If kcp config map deployed after a conflicting update", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		assert.NoError(t, err)
+
+		configmap := &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:        "sa1",
+				Namespace:   "kcp-system",
+				Labels:      map[string]string{"service": "kubeconfig"},
+				Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenantID"},
+			},
+			Data: map[string]string{"runtime1": "startTime1"},
+		}
+		_, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Create(context.Background(), configmap, v1.CreateOptions{})
+		assert.NoError(t, err)
+
+		// another runtime is added to the ConfigMap between the read and the update
+		kcpK8s := rtc.KcpK8s.(*fake.Clientset)
+		conflicted := false
+		kcpK8s.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			if conflicted {
+				return false, nil, nil
+			}
+			conflicted = true
+			cm, err := kcpK8s.Tracker().Get(corev1.SchemeGroupVersion.WithResource("configmaps"), "kcp-system", "sa1")
+			assert.NoError(t, err)
+			cm.(*corev1.ConfigMap).Data["runtime3"] = "startTime3"
+			assert.NoError(t, kcpK8s.Tracker().Update(corev1.SchemeGroupVersion.WithResource("configmaps"), cm, "kcp-system"))
+			return true, nil, k8serrors.NewConflict(corev1.Resource("configmaps"), "sa1", fmt.Errorf("changed"))
+		})
+
+		err = rtc.DeployConfigMap("runtime2", "runtimeOperator", time.Now())
+		assert.NoError(t, err)
+
+		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.True(t, conflicted)
+		assert.Equal(t, "startTime1", cm.Data["runtime1"])
+		assert.NotEmpty(t, cm.Data["runtime2"])
+		assert.Equal(t, "startTime3", cm.Data["runtime3"])
+	})
+}
+
+func TestRemoveConfigMapEntry(t *testing.T) {
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "sa1",
+				Namespace: "kcp-system",
+				Labels:    map[string]string{"service": "kubeconfig"},
+			},
+			Data: map[string]string{"runtime1": "startTime1", "runtime2": "startTime2"},
+		}
+	}
+
+	t.Run("Entry is kept when it was renewed", func(t *testing.T) {
+		kcpK8s := fake.NewSimpleClientset(newConfigMap())
+
+		removed, err := removeConfigMapEntry(kcpK8s, "sa1", "runtime1", "startTime0")
+		assert.NoError(t, err)
+		assert.False(t, removed)
+
+		cm, err := kcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "startTime1", cm.Data["runtime1"])
+	})
+
+	t.Run("Entry is removed when it has the expected start time", func(t *testing.T) {
+		kcpK8s := fake.NewSimpleClientset(newConfigMap())
+
+		removed, err := removeConfigMapEntry(kcpK8s, "sa1", "runtime1", "startTime1")
+		assert.NoError(t, err)
+		assert.True(t, removed)
+
+		cm, err := kcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"runtime2": "startTime2"}, cm.Data)
+	})
+
+	t.Run("ConfigMap is deleted with the last entry", func(t *testing.T) {
+		kcpK8s := fake.NewSimpleClientset(newConfigMap())
+
+		for _, runtimeID := range []string{"runtime1", "runtime2"} {
+			removed, err := removeConfigMapEntry(kcpK8s, "sa1", runtimeID, "")
+			assert.NoError(t, err)
+			assert.True(t, removed)
+		}
+
+		_, err := kcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("Missing ConfigMap is not an error", func(t *testing.T) {
+		removed, err := removeConfigMapEntry(fake.NewSimpleClientset(), "sa1", "runtime1", "")
+		assert.NoError(t, err)
+		assert.False(t, removed)
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	t.Run("Revoke removes the user objects and the runtime from the ConfigMap", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		assert.NoError(t, err)
+
+		assert.NoError(t, rtc.createServiceAccount())
+		assert.NoError(t, rtc.createClusterRoleRules())
+		assert.NoError(t, rtc.createClusterRole())
+		assert.NoError(t, rtc.createClusterRoleBinding())
+		assert.NoError(t, rtc.DeployConfigMap("runtime1", "runtimeOperator", time.Now()))
+		assert.NoError(t, rtc.DeployConfigMap("runtime2", "runtimeOperator", time.Now()))
+
+		err = rtc.Revoke("runtime1")
+		assert.NoError(t, err)
+
+		_, err = rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Get(context.TODO(), rtc.User.ServiceAccountName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleRulesName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), "sa1", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, cm.Data["runtime1"])
+		assert.NotEmpty(t, cm.Data["runtime2"])
+	})
+
+	t.Run("Revoke succeeds when nothing was generated", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		assert.NoError(t, err)
+
+		assert.NoError(t, rtc.Revoke("runtime1"))
+	})
+}
+
+func TestSweeper(t *testing.T) {
+	now := time.Now()
+	rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+	assert.NoError(t, err)
+	assert.NoError(t, rtc.createServiceAccount())
+	assert.NoError(t, rtc.createClusterRoleRules())
+	assert.NoError(t, rtc.createClusterRole())
+	assert.NoError(t, rtc.createClusterRoleBinding())
+	assert.NoError(t, rtc.DeployConfigMap("expired", "runtimeOperator", now.Add(-2*time.Hour)))
+	assert.NoError(t, rtc.DeployConfigMap("valid", "runtimeOperator", now.Add(-30*time.Minute)))
+	assert.NoError(t, rtc.DeployConfigMap("deleted", "runtimeOperator", now.Add(-2*time.Hour)))
+
+	// The objects of sa2 have no ConfigMap entry
+	orphan, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa2", "runtimeOperator", "tenantID")
+	assert.NoError(t, err)
+	orphan.K8s = rtc.K8s
+	orphan.KcpK8s = rtc.KcpK8s
+	assert.NoError(t, orphan.createServiceAccount())
+	assert.NoError(t, orphan.createClusterRoleRules())
+	assert.NoError(t, orphan.createClusterRole())
+	assert.NoError(t, orphan.createClusterRoleBinding())
+
+	sweeper := &Sweeper{
+		KcpK8s: rtc.KcpK8s,
+		TTL:    time.Hour,
+		RuntimeKubeconfig: func(tenantID, runtimeID string) (string, error) {
+			assert.Equal(t, "tenantID", tenantID)
+			if runtimeID == "deleted" {
+				return "", fmt.Errorf("error getting Shoot: shoot not found")
+			}
+			return "kubeconfig", nil
+		},
+		NewRuntimeClient: func(kubeConfig []byte, userID string, L2L3OperatiorRole string, tenant string) (*RuntimeClient, error) {
+			switch userID {
+			case "sa1":
+				assert.Equal(t, "runtimeOperator", L2L3OperatiorRole)
+				return rtc, nil
+			case "sa2":
+				return orphan, nil
+			}
+			return nil, fmt.Errorf("unexpected user %s", userID)
+		},
+	}
+
+	err = sweeper.Sweep(now)
+	assert.NoError(t, err)
+
+	for _, user := range []SAInfo{rtc.User, orphan.User} {
+		_, err = rtc.K8s.CoreV1().ServiceAccounts(user.Namespace).Get(context.TODO(), user.ServiceAccountName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), user.ClusterRoleBindingName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), user.ClusterRoleRulesName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	}
+
+	cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), "sa1", v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(cm.Data))
+	assert.NotEmpty(t, cm.Data["valid"])
+}
+
+func TestSweeperKeepsRenewedKubeconfig(t *testing.T) {
+	now := time.Now()
+	rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+	assert.NoError(t, err)
+	assert.NoError(t, rtc.createServiceAccount())
+	assert.NoError(t, rtc.DeployConfigMap("runtime1", "runtimeOperator", now.Add(-2*time.Hour)))
+
+	sweeper := &Sweeper{
+		KcpK8s: rtc.KcpK8s,
+		TTL:    time.Hour,
+		RuntimeKubeconfig: func(tenantID, runtimeID string) (string, error) {
+			t.Fatal("the renewed kubeconfig must not be swept")
+			return "", nil
+		},
+	}
+
+	// The user requests a new kubeconfig after the sweeper listed the ConfigMaps
+	configMaps, err := sweeper.listConfigMaps()
+	assert.NoError(t, err)
+	assert.Len(t, configMaps, 1)
+	assert.NoError(t, rtc.DeployConfigMap("runtime1", "runtimeOperator", now))
+
+	assert.NoError(t, sweeper.sweepRuntime(now, "tenantID", "runtime1"))
+
+	_, err = rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Get(context.TODO(), rtc.User.ServiceAccountName, v1.GetOptions{})
+	assert.NoError(t, err)
 }
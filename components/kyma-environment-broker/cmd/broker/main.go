@@ -18,6 +18,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/dlmiddlecote/sqlstats"
+	"github.com/google/uuid"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/director"
@@ -28,6 +29,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/appinfo"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 	kebConfig "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/edp"
@@ -40,7 +42,10 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/kubeconfig"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/metrics"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/migrations"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operations"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
@@ -61,8 +66,11 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtimeversion"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	postgres "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/postsql"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/suspension"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/swagger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -133,6 +141,9 @@ type Config struct {
 	Broker          broker.Config
 	CatalogFilePath string
 
+	// ProviderCatalog configures the regions, zones and machine types offered in the plans
+	ProviderCatalog catalog.Config
+
 	Avs avs.Config
 	IAS ias.Config
 	EDP edp.Config
@@ -148,6 +159,9 @@ type Config struct {
 
 	OrchestrationConfig orchestration.Config
 
+	// RuntimeResolver configures how the runtime targets of orchestrations are resolved
+	RuntimeResolver orchestration.RuntimeResolverConfig
+
 	TrialRegionMappingFilePath string
 
 	EuAccessWhitelistedGlobalAccountsFilePath string
@@ -168,6 +182,19 @@ type Config struct {
 	Profiler ProfilerConfig
 
 	Events events.Config
+
+	// OperationQueue configures provisioning, deprovisioning and update queues to store
+	// the schedule of operations in the database instead of memory
+	OperationQueue process.PersistentQueueConfig
+
+	// Webhooks configures the delivery of the lifecycle events to the external subscribers
+	Webhooks webhook.Config
+
+	// Reencryption rewrites the stored data encrypted with other than the primary key of the keyring
+	Reencryption migrations.ReencryptionConfig
+
+	// APIServerAllowlist configures the access to the Kubernetes API server of the clusters
+	APIServerAllowlist networking.APIServerAllowlistConfig
 }
 
 type ProfilerConfig struct {
@@ -218,6 +245,9 @@ func main() {
 	err = checkDefaultVersions(cfg.KymaVersion)
 	panicOnError(err)
 
+	// without the KCP egress ranges KEB would lose the access to the clusters with a restricted API server
+	fatalOnError(cfg.APIServerAllowlist.Validate())
+
 	cfg.OrchestrationConfig.KymaVersion = cfg.KymaVersion
 	cfg.OrchestrationConfig.KubernetesVersion = cfg.Provisioner.KubernetesVersion
 
@@ -233,6 +263,12 @@ func main() {
 		logs.SetLevel(l)
 	}
 
+	if cfg.ProviderCatalog.FilePath != "" {
+		err = catalog.Load(cfg.ProviderCatalog.FilePath)
+		fatalOnError(err)
+		go catalog.Watch(ctx, cfg.ProviderCatalog.FilePath, cfg.ProviderCatalog.ReloadInterval, logs.WithField("service", "providerCatalog"))
+	}
+
 	logger.Info("Registering healthz endpoint for health probes")
 	health.NewServer(cfg.Host, cfg.StatusPort, logs).ServeAsync()
 	go periodicProfile(logger, cfg.Profiler)
@@ -249,7 +285,11 @@ func main() {
 	fatalOnError(err)
 
 	// create storage
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
+	if cfg.Reencryption.Enabled && cipher.WritesLegacy() {
+		fatalOnError(fmt.Errorf("the re-encryption requires the legacy encryption writes to be disabled"))
+	}
 	var db storage.BrokerStorage
 	if cfg.DbInMemory {
 		db = storage.NewMemoryStorage()
@@ -259,6 +299,15 @@ func main() {
 		db = store
 		dbStatsCollector := sqlstats.NewStatsCollector("broker", conn)
 		prometheus.MustRegister(dbStatsCollector)
+
+		if cfg.Reencryption.Enabled {
+			reencryption := migrations.NewReencryption(postgres.NewEncryptedData(postsql.NewFactory(conn)), cipher, cfg.Reencryption, logs)
+			go func() {
+				if _, err := reencryption.Run(ctx); err != nil {
+					logs.Errorf("while re-encrypting the stored data: %s", err)
+				}
+			}()
+		}
 	}
 
 	// Customer Notification
@@ -337,6 +386,15 @@ func main() {
 	// metrics collectors
 	metrics.RegisterAll(eventBroker, db.Operations(), db.Instances())
 	metrics.StartOpsMetricService(ctx, db.Operations(), logs)
+
+	// webhook notifications of the lifecycle events
+	if cfg.Webhooks.Enabled {
+		subscribers, err := webhook.ReadSubscribersFromYAML(cfg.Webhooks.SubscribersFilePath)
+		fatalOnError(err)
+		webhook.NewOutbox(db.WebhookDeliveries(), subscribers, logs).Subscribe(eventBroker)
+		go webhook.NewDispatcher(db.WebhookDeliveries(), subscribers, cfg.Webhooks, logs).Run(ctx)
+		logs.Infof("Webhook notifications enabled for %d subscribers", len(subscribers))
+	}
 	//setup runtime overrides appender
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
 
@@ -346,19 +404,20 @@ func main() {
 
 	// run queues
 	const workersAmount = 5
-	provisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("provisioning", "manager"))
+	provisionManager := process.NewStagedManager(db.Operations(), db.OperationSteps(), eventBroker, cfg.OperationTimeout, logs.WithField("provisioning", "manager"))
 	provisionQueue := NewProvisioningProcessingQueue(ctx, provisionManager, 60, &cfg, db, provisionerClient, inputFactory,
 		avsDel, internalEvalAssistant, externalEvalCreator, internalEvalUpdater, runtimeVerConfigurator,
 		runtimeOverrides, edpClient, accountProvider, reconcilerClient, k8sClientProvider, cli, logs)
 
-	deprovisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("deprovisioning", "manager"))
+	deprovisionManager := process.NewStagedManager(db.Operations(), db.OperationSteps(), eventBroker, cfg.OperationTimeout, logs.WithField("deprovisioning", "manager"))
 	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, workersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient,
 		avsDel, internalEvalAssistant, externalEvalAssistant, bundleBuilder, edpClient, accountProvider, reconcilerClient,
 		k8sClientProvider, cli, configProvider, logs)
 
-	updateManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("update", "manager"))
+	updateManager := process.NewStagedManager(db.Operations(), db.OperationSteps(), eventBroker, cfg.OperationTimeout, logs.WithField("update", "manager"))
 	updateQueue := NewUpdateProcessingQueue(ctx, updateManager, 20, db, inputFactory, provisionerClient, eventBroker,
-		runtimeVerConfigurator, db.RuntimeStates(), componentsProvider, reconcilerClient, cfg, k8sClientProvider, cli, logs)
+		runtimeVerConfigurator, db.RuntimeStates(), componentsProvider, reconcilerClient, cfg, k8sClientProvider, cli,
+		accountProvider, dynamicGardener, gardenerNamespace, logs)
 
 	/***/
 	servicesConfig, err := broker.NewServicesConfigFromFile(cfg.CatalogFilePath)
@@ -367,7 +426,8 @@ func main() {
 	// create server
 	router := mux.NewRouter()
 
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults)
+	bindingCredentials := kubeconfig.NewTokenProvider(provisionerClient, k8sClientProvider)
+	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults, bindingCredentials)
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
@@ -377,8 +437,15 @@ func main() {
 	kcHandler := kubeconfig.NewHandler(db, kcBuilder, cfg.Kubeconfig.AllowOrigins, logs.WithField("service", "kubeconfigHandle"))
 	kcHandler.AttachRoutes(router)
 
-	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
-	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
+	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), db.RuntimeStates(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
+	var runtimeResolver orchestrationExt.RuntimeResolver = orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
+	if cfg.RuntimeResolver.CacheEnabled {
+		cachedResolver, err := orchestrationExt.NewCachedGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister,
+			cfg.RuntimeResolver.ResyncPeriod, cfg.RuntimeResolver.RuntimeSyncInterval, logs)
+		fatalOnError(err)
+		fatalOnError(cachedResolver.Start(ctx))
+		runtimeResolver = cachedResolver
+	}
 
 	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager, &cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, logs, cli, 1)
 	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
@@ -388,6 +455,8 @@ func main() {
 	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, cfg.MaxPaginationPage, logs)
 
 	if !cfg.DisableProcessOperationsInProgress {
+		// the persistent queue schedules only the operations which are neither leased nor waiting for a retry,
+		// so the operations started while the queue was kept in memory are not stranded
 		err = processOperationsInProgressByType(internal.OperationTypeProvision, db.Operations(), provisionQueue, logs)
 		fatalOnError(err)
 		err = processOperationsInProgressByType(internal.OperationTypeDeprovision, db.Operations(), deprovisionQueue, logs)
@@ -416,6 +485,14 @@ func main() {
 	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion)
 	runtimeHandler.AttachRoutes(router)
 
+	// create operations endpoint
+	operationsHandler := operations.NewHandler(db.Operations(), db.OperationSteps(), map[internal.OperationType]operations.Queue{
+		internal.OperationTypeProvision:   provisionQueue,
+		internal.OperationTypeUpdate:      updateQueue,
+		internal.OperationTypeDeprovision: deprovisionQueue,
+	}, logs.WithField("service", "operationsHandler"))
+	operationsHandler.AttachRoutes(router)
+
 	router.StrictSlash(true).PathPrefix("/").Handler(http.StripPrefix("/", http.FileServer(http.Dir("/swagger"))))
 	svr := handlers.CustomLoggingHandler(os.Stdout, router, func(writer io.Writer, params handlers.LogFormatterParams) {
 		logs.Infof("Call handled: method=%s url=%s statusCode=%d size=%d", params.Request.Method, params.URL.Path, params.StatusCode, params.Size)
@@ -453,7 +530,7 @@ func isVersionFollowingSemanticVersioning(version string) bool {
 	return false
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults, bindingCredentials broker.BindingCredentialsProvider) {
 	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
@@ -483,10 +560,10 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 			planDefaults, logs, cfg.KymaDashboardConfig),
 		broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), logs),
 		broker.NewLastOperation(db.Operations(), logs),
-		broker.NewBind(logs),
-		broker.NewUnbind(logs),
-		broker.NewGetBinding(logs),
-		broker.NewLastBindingOperation(logs),
+		broker.NewBind(cfg.Broker.Binding, db.Instances(), db.Bindings(), bindingCredentials, logs),
+		broker.NewUnbind(cfg.Broker.Binding, db.Instances(), db.Bindings(), bindingCredentials, logs),
+		broker.NewGetBinding(cfg.Broker.Binding, db.Bindings(), logs),
+		broker.NewLastBindingOperation(cfg.Broker.Binding, db.Bindings(), logs),
 	}
 
 	router.Use(middleware.AddRegionToContext(cfg.DefaultRequestRegion))
@@ -512,7 +589,7 @@ func processOperationsInProgressByType(opType internal.OperationType, op storage
 		return fmt.Errorf("while getting in progress operations from storage: %w", err)
 	}
 	for _, operation := range operations {
-		queue.Add(operation.ID)
+		queue.Resume(operation.ID)
 		log.Infof("Resuming the processing of %s operation ID: %s", opType, operation.ID)
 	}
 	return nil
@@ -531,6 +608,9 @@ func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrat
 	if err := processOrchestration(orchestrationType, orchestrationExt.Retrying, orchestrationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing retrying %s orchestrations: %w", orchestrationType, err)
 	}
+	if err := processOrchestration(orchestrationType, orchestrationExt.Paused, orchestrationsStorage, queue, log); err != nil {
+		return fmt.Errorf("while processing paused %s orchestrations: %w", orchestrationType, err)
+	}
 	return nil
 }
 
@@ -590,6 +670,19 @@ func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orc
 	return nil
 }
 
+// newOperationQueue creates the queue for operations, which is backed by the database if the persistent queue is enabled
+func newOperationQueue(name string, executor process.Executor, cfg process.PersistentQueueConfig, db storage.BrokerStorage, logs logrus.FieldLogger) *process.Queue {
+	if !cfg.Enabled {
+		return process.NewQueue(executor, logs)
+	}
+	hostname, err := os.Hostname()
+	fatalOnError(err)
+	owner := fmt.Sprintf("%s-%s", hostname, uuid.NewString())
+	logs.Infof("Using persistent %s queue with lease owner %s", name, owner)
+
+	return process.NewPersistentQueue(name, owner, executor, db.OperationsQueue(), cfg, logs)
+}
+
 func initClient(cfg *rest.Config) (client.Client, error) {
 	mapper, err := apiutil.NewDiscoveryRESTMapper(cfg)
 	if err != nil {
@@ -650,6 +743,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		stage     string
 		step      process.Step
 		condition process.StepCondition
+		options   []process.StepOption
 	}{
 		{
 			stage: startStageName,
@@ -693,17 +787,20 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		{
 			condition: provisioning.SkipForOwnClusterPlan,
 			stage:     createRuntimeStageName,
-			step:      provisioning.NewCreateRuntimeWithoutKymaStep(db.Operations(), db.RuntimeStates(), db.Instances(), provisionerClient),
+			step:      provisioning.NewCreateRuntimeWithoutKymaStep(db.Operations(), db.RuntimeStates(), db.Instances(), provisionerClient, cfg.APIServerAllowlist),
+			options:   []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: provisioning.CreateRuntimeTimeout})},
 		},
 		{
 			condition: provisioning.DoForOwnClusterPlanOnly,
 			stage:     createRuntimeStageName,
 			step:      provisioning.NewCreateRuntimeForOwnClusterStep(db.Operations(), db.Instances()),
+			options:   []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: provisioning.CreateRuntimeTimeout})},
 		},
 		{
 			stage:     createRuntimeStageName,
-			step:      provisioning.NewCheckRuntimeStep(db.Operations(), provisionerClient, cfg.Provisioner.ProvisioningTimeout),
+			step:      provisioning.NewCheckRuntimeStep(db.Operations(), provisionerClient),
 			condition: provisioning.SkipForOwnClusterPlan,
+			options:   []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: cfg.Provisioner.ProvisioningTimeout})},
 		},
 		{
 			stage: createRuntimeStageName,
@@ -728,8 +825,9 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		{
 			disabled:  cfg.ReconcilerIntegrationDisabled,
 			stage:     checkKymaStageName,
-			step:      provisioning.NewCheckClusterConfigurationStep(db.Operations(), reconcilerClient, cfg.Reconciler.ProvisioningTimeout),
+			step:      provisioning.NewCheckClusterConfigurationStep(db.Operations(), reconcilerClient),
 			condition: skipForPreviewPlan,
+			options:   []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: cfg.Reconciler.ProvisioningTimeout})},
 		},
 		{
 			disabled: cfg.LifecycleManagerIntegrationDisabled,
@@ -749,14 +847,14 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 	}
 	for _, step := range provisioningSteps {
 		if !step.disabled {
-			err := provisionManager.AddStep(step.stage, step.step, step.condition)
+			err := provisionManager.AddStep(step.stage, step.step, step.condition, step.options...)
 			if err != nil {
 				fatalOnError(err)
 			}
 		}
 	}
 
-	queue := process.NewQueue(provisionManager, logs)
+	queue := newOperationQueue("provisioning", provisionManager, cfg.OperationQueue, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
@@ -764,17 +862,19 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 
 func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManager, workersAmount int, db storage.BrokerStorage, inputFactory input.CreatorForPlan,
 	provisionerClient provisioner.Client, publisher event.Publisher, runtimeVerConfigurator *runtimeversion.RuntimeVersionConfigurator, runtimeStatesDb storage.RuntimeStates,
-	runtimeProvider input.ComponentListProvider, reconcilerClient reconciler.Client, cfg Config, k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client, logs logrus.FieldLogger) *process.Queue {
+	runtimeProvider input.ComponentListProvider, reconcilerClient reconciler.Client, cfg Config, k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client,
+	accountProvider hyperscaler.AccountProvider, gardenerClient dynamic.Interface, gardenerNamespace string, logs logrus.FieldLogger) *process.Queue {
 
 	requiresReconcilerUpdate := update.RequiresReconcilerUpdate
 	if cfg.ReconcilerIntegrationDisabled {
 		requiresReconcilerUpdate = func(op internal.Operation) bool { return false }
 	}
-	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check"})
+	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check", "plan"})
 	updateSteps := []struct {
 		stage     string
 		step      process.Step
 		condition process.StepCondition
+		options   []process.StepOption
 	}{
 		{
 			stage: "cluster",
@@ -782,7 +882,12 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 		},
 		{
 			stage:     "cluster",
-			step:      update.NewUpgradeShootStep(db.Operations(), db.RuntimeStates(), provisionerClient),
+			step:      update.NewPlanMigrationCredentialsStep(db.Operations(), accountProvider, gardenerClient, gardenerNamespace),
+			condition: update.ForPlanMigration,
+		},
+		{
+			stage:     "cluster",
+			step:      update.NewUpgradeShootStep(db.Operations(), db.RuntimeStates(), provisionerClient, cfg.APIServerAllowlist),
 			condition: update.SkipForOwnClusterPlan,
 		},
 		{
@@ -811,18 +916,24 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 		},
 		{
 			stage:     "check",
-			step:      update.NewCheckStep(db.Operations(), provisionerClient, 40*time.Minute),
+			step:      update.NewCheckStep(db.Operations(), provisionerClient),
 			condition: update.SkipForOwnClusterPlan,
+			options:   []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: 40 * time.Minute})},
+		},
+		{
+			stage:     "plan",
+			step:      update.NewMigratePlanStep(db.Operations(), db.Instances()),
+			condition: update.ForPlanMigration,
 		},
 	}
 
 	for _, step := range updateSteps {
-		err := manager.AddStep(step.stage, step.step, step.condition)
+		err := manager.AddStep(step.stage, step.step, step.condition, step.options...)
 		if err != nil {
 			fatalOnError(err)
 		}
 	}
-	queue := process.NewQueue(manager, logs)
+	queue := newOperationQueue("update", manager, cfg.OperationQueue, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
@@ -838,6 +949,7 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 	deprovisioningSteps := []struct {
 		disabled bool
 		step     process.Step
+		options  []process.StepOption
 	}{
 		{
 			step: deprovisioning.NewInitStep(db.Operations(), db.Instances(), 12*time.Hour),
@@ -873,10 +985,12 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 			step:     deprovisioning.NewCheckClusterDeregistrationStep(db.Operations(), reconcilerClient, 90*time.Minute),
 		},
 		{
-			step: deprovisioning.NewRemoveRuntimeStep(db.Operations(), db.Instances(), provisionerClient, cfg.Provisioner.DeprovisioningTimeout),
+			step:    deprovisioning.NewRemoveRuntimeStep(db.Operations(), db.Instances(), provisionerClient),
+			options: []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: cfg.Provisioner.DeprovisioningTimeout})},
 		},
 		{
-			step: deprovisioning.NewCheckRuntimeRemovalStep(db.Operations(), db.Instances(), provisionerClient),
+			step:    deprovisioning.NewCheckRuntimeRemovalStep(db.Operations(), db.Instances(), provisionerClient),
+			options: []process.StepOption{process.WithRetryPolicy(process.RetryPolicy{Timeout: deprovisioning.CheckStatusTimeout})},
 		},
 		{
 			step: deprovisioning.NewReleaseSubscriptionStep(db.Operations(), db.Instances(), accountProvider),
@@ -898,11 +1012,11 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 	deprovisionManager.DefineStages(stages)
 	for _, step := range deprovisioningSteps {
 		if !step.disabled {
-			deprovisionManager.AddStep(step.step.Name(), step.step, nil)
+			deprovisionManager.AddStep(step.step.Name(), step.step, nil, step.options...)
 		}
 	}
 
-	queue := process.NewQueue(deprovisionManager, logs)
+	queue := newOperationQueue("deprovisioning", deprovisionManager, cfg.OperationQueue, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
@@ -914,12 +1028,13 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 	upgradeKymaInit := upgrade_kyma.NewInitialisationStep(db.Operations(), db.Orchestrations(), db.Instances(),
 		provisionerClient, inputFactory, upgradeEvalManager, icfg, runtimeVerConfigurator, notificationBuilder)
 
-	upgradeKymaManager.InitStep(upgradeKymaInit)
+	upgradeKymaManager.InitStep(upgradeKymaInit, upgrade_kyma.WithRetryPolicy(process.RetryPolicy{Timeout: upgrade_kyma.CheckStatusTimeout}))
 	upgradeKymaSteps := []struct {
 		disabled bool
 		weight   int
 		step     upgrade_kyma.Step
 		cnd      upgrade_kyma.StepCondition
+		options  []upgrade_kyma.StepOption
 	}{
 		// check cluster configuration is the first step - to not execute other steps, when cluster configuration was applied
 		// this should be moved to the end when we introduce stages like in the provisioning process
@@ -927,8 +1042,9 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 		{
 			weight:   1,
 			disabled: cfg.ReconcilerIntegrationDisabled,
-			step:     upgrade_kyma.NewCheckClusterConfigurationStep(db.Operations(), reconcilerClient, upgradeEvalManager, cfg.Reconciler.ProvisioningTimeout),
+			step:     upgrade_kyma.NewCheckClusterConfigurationStep(db.Operations(), reconcilerClient, upgradeEvalManager),
 			cnd:      upgrade_kyma.SkipForPreviewPlan,
+			options:  []upgrade_kyma.StepOption{upgrade_kyma.WithRetryPolicy(process.RetryPolicy{Timeout: cfg.Reconciler.ProvisioningTimeout})},
 		},
 		{
 			weight: 1,
@@ -970,13 +1086,13 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 	}
 	for _, step := range upgradeKymaSteps {
 		if !step.disabled {
-			upgradeKymaManager.AddStep(step.weight, step.step, step.cnd)
+			upgradeKymaManager.AddStep(step.weight, step.step, step.cnd, step.options...)
 		}
 	}
 
 	orchestrateKymaManager := manager.NewUpgradeKymaManager(db.Orchestrations(), db.Operations(), db.Instances(),
 		upgradeKymaManager, runtimeResolver, pollingInterval, logs.WithField("upgradeKyma", "orchestration"),
-		cli, &cfg.OrchestrationConfig, notificationBuilder, speedFactor)
+		cli, &cfg.OrchestrationConfig, notificationBuilder, speedFactor, pub)
 	queue := process.NewQueue(orchestrateKymaManager, logs)
 
 	queue.Run(ctx.Done(), 3)
@@ -991,13 +1107,18 @@ func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.Brok
 
 	upgradeClusterManager := upgrade_cluster.NewManager(db.Operations(), pub, logs.WithField("upgradeCluster", "manager"))
 	upgradeClusterInit := upgrade_cluster.NewInitialisationStep(db.Operations(), db.Orchestrations(), provisionerClient, inputFactory, upgradeEvalManager, icfg, notificationBuilder)
-	upgradeClusterManager.InitStep(upgradeClusterInit)
+	upgradeClusterManager.InitStep(upgradeClusterInit, upgrade_cluster.WithRetryPolicy(process.RetryPolicy{Timeout: upgrade_cluster.CheckStatusTimeout}))
 
+	upgradeClusterTimeout := time.Hour
+	if icfg != nil && icfg.UpgradeClusterTimeout != 0 {
+		upgradeClusterTimeout = icfg.UpgradeClusterTimeout
+	}
 	upgradeClusterSteps := []struct {
 		disabled  bool
 		weight    int
 		step      upgrade_cluster.Step
 		condition upgrade_cluster.StepCondition
+		options   []upgrade_cluster.StepOption
 	}{
 		{
 			weight:    1,
@@ -1013,18 +1134,19 @@ func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.Brok
 			weight:    10,
 			step:      upgrade_cluster.NewUpgradeClusterStep(db.Operations(), db.RuntimeStates(), provisionerClient, icfg),
 			condition: provisioning.SkipForOwnClusterPlan,
+			options:   []upgrade_cluster.StepOption{upgrade_cluster.WithRetryPolicy(process.RetryPolicy{Timeout: upgradeClusterTimeout})},
 		},
 	}
 
 	for _, step := range upgradeClusterSteps {
 		if !step.disabled {
-			upgradeClusterManager.AddStep(step.weight, step.step, step.condition)
+			upgradeClusterManager.AddStep(step.weight, step.step, step.condition, step.options...)
 		}
 	}
 
 	orchestrateClusterManager := manager.NewUpgradeClusterManager(db.Orchestrations(), db.Operations(), db.Instances(),
 		upgradeClusterManager, runtimeResolver, pollingInterval, logs.WithField("upgradeCluster", "orchestration"),
-		cli, cfg.OrchestrationConfig, notificationBuilder, speedFactor)
+		cli, cfg.OrchestrationConfig, notificationBuilder, speedFactor, pub)
 	queue := process.NewQueue(orchestrateClusterManager, logs)
 
 	queue.Run(ctx.Done(), 3)
@@ -134,6 +134,40 @@ func TestProvisioning_AzureWithEURestrictedAccessBadRequest(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
+func TestProvisioning_NodesRangeNotSplittableBetweenZones(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+
+	// when
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/cf-eu10/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+					"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+					"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+					"context": {
+						"globalaccount_id": "g-account-id",
+						"subaccount_id": "sub-id",
+						"user_id": "john.smith@email.com"
+					},
+					"parameters": {
+						"name": "testing-cluster",
+						"zones": ["eu-central-1a", "eu-central-1b", "eu-central-1c", "eu-central-1d", "eu-central-1e"],
+						"networking": {
+							"nodes": "10.250.0.0/20"
+						}
+					}
+		}`)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "nodes range 10.250.0.0/20 cannot be split between 5 zones")
+	_, err = suite.db.Instances().GetByID(iid)
+	assert.Error(t, err)
+}
+
 func TestProvisioning_AzureWithEURestrictedAccessHappyFlow(t *testing.T) {
 	// given
 	suite := NewBrokerSuiteTest(t)
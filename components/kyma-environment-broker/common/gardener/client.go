@@ -72,6 +72,37 @@ func (b Shoot) GetSpecRegion() string {
 	return str
 }
 
+func (b Shoot) GetSpecKubernetesVersion() string {
+	str, _, err := unstructured.NestedString(b.Unstructured.Object, "spec", "kubernetes", "version")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.kubernetes.version': %v", err))
+	}
+	return str
+}
+
+// GetSpecMachineImageVersions returns the machine image versions of all worker pools
+func (b Shoot) GetSpecMachineImageVersions() []string {
+	workers, _, err := unstructured.NestedSlice(b.Unstructured.Object, "spec", "provider", "workers")
+	if err != nil {
+		// NOTE this is a safety net, gardener v1beta1 API would need to break the contract for this to panic
+		panic(fmt.Sprintf("Shoot missing field '.spec.provider.workers': %v", err))
+	}
+	versions := make([]string, 0, len(workers))
+	for _, w := range workers {
+		worker, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		version, _, err := unstructured.NestedString(worker, "machine", "image", "version")
+		if err != nil || version == "" {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	return versions
+}
+
 var SecretBindingResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "secretbindings"}
 var ShootResource = schema.GroupVersionResource{Group: "core.gardener.cloud", Version: "v1beta1", Resource: "shoots"}
 
@@ -0,0 +1,154 @@
+package operation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type StepOutcome string
+
+const (
+	StepSucceeded StepOutcome = "succeeded"
+	StepRetrying  StepOutcome = "retrying"
+	StepFailed    StepOutcome = "failed"
+	StepSkipped   StepOutcome = "skipped"
+)
+
+// StepDTO describes a single execution of an operation step
+type StepDTO struct {
+	OperationID string      `json:"operationID"`
+	Stage       string      `json:"stage"`
+	Step        string      `json:"step"`
+	Attempt     int         `json:"attempt"`
+	StartedAt   time.Time   `json:"startedAt"`
+	FinishedAt  time.Time   `json:"finishedAt"`
+	Outcome     StepOutcome `json:"outcome"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// StepsResponse is the response of the KEB /operations/{operation_id}/steps API
+type StepsResponse struct {
+	Data []StepDTO `json:"data"`
+}
+
+// ResumeRequest is the body of the KEB /operations/{operation_id}/resume API
+type ResumeRequest struct {
+	// ResetTimeout starts the operation timeout from the time of the resume
+	ResetTimeout bool `json:"resetTimeout"`
+}
+
+// ResumeResponse is the response of the KEB /operations/{operation_id}/resume API
+type ResumeResponse struct {
+	OperationID string `json:"operationID"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
+
+// Client is the interface to interact with the KEB /operations API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListSteps(operationID string) ([]StepDTO, error)
+	Resume(operationID string, resetTimeout bool) (ResumeResponse, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /operations API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// ListSteps fetches the step journal of the given operation
+func (c *client) ListSteps(operationID string) ([]StepDTO, error) {
+	steps := StepsResponse{}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/operations/%s/steps", c.url, operationID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("while creating request: %v", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("while calling %s: %v", req.URL.String(), err)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&steps)
+	if err != nil {
+		return nil, fmt.Errorf("while decoding response body: %v", err)
+	}
+	return steps.Data, nil
+}
+
+// Resume continues the failed operation from the first unfinished stage
+func (c *client) Resume(operationID string, resetTimeout bool) (ResumeResponse, error) {
+	response := ResumeResponse{}
+	body, err := json.Marshal(ResumeRequest{ResetTimeout: resetTimeout})
+	if err != nil {
+		return response, fmt.Errorf("while marshalling request body: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/operations/%s/resume", c.url, operationID), bytes.NewBuffer(body))
+	if err != nil {
+		return response, fmt.Errorf("while creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return response, fmt.Errorf("while calling %s: %v", req.URL.String(), err)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return response, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return response, fmt.Errorf("while decoding response body: %v", err)
+	}
+	return response, nil
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(io.Discard, io.LimitReader(body, 4096))
+	return err
+}
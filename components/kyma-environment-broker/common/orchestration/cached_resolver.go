@@ -0,0 +1,268 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	shootGlobalAccountIndex = "globalAccount"
+	shootSubAccountIndex    = "subAccount"
+	shootRegionIndex        = "region"
+	shootRuntimeIDIndex     = "runtimeID"
+)
+
+// CachedGardenerRuntimeResolver implements the RuntimeResolver interface using the shoots from the informer cache
+// instead of listing all the shoots on every Resolve() call. The cache is indexed by global account, subaccount,
+// region and runtime ID, so the targets specifying them evaluate only the matching shoots.
+//
+// All the runtimes are synced from KEB when the sync interval passes. When a shoot of an unknown runtime appears
+// in between, only the runtimes of the new shoots are read from KEB. The runtimes are removed when their shoot is deleted.
+// The implementation is thread safe, i.e. it is safe to call Resolve() from multiple threads concurrently.
+type CachedGardenerRuntimeResolver struct {
+	*GardenerRuntimeResolver
+
+	informer            cache.SharedIndexInformer
+	runtimeSyncInterval time.Duration
+
+	syncMutex       sync.Mutex
+	lastRuntimeSync time.Time
+	// runtimes which shoots exist but were not returned by the last sync, they do not trigger a new sync
+	missingRuntimes map[string]bool
+}
+
+// NewCachedGardenerRuntimeResolver constructs a CachedGardenerRuntimeResolver, Start() must be called before the first Resolve().
+func NewCachedGardenerRuntimeResolver(gardenerClient dynamic.Interface, gardenerNamespace string, lister RuntimeLister, resyncPeriod, runtimeSyncInterval time.Duration, logger logrus.FieldLogger) (*CachedGardenerRuntimeResolver, error) {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(gardenerClient, resyncPeriod, gardenerNamespace, nil)
+	informer := factory.ForResource(gardener.ShootResource).Informer()
+	err := informer.AddIndexers(cache.Indexers{
+		shootGlobalAccountIndex: shootIndexFunc(true, func(shoot *gardener.Shoot) string { return shoot.GetLabels()[globalAccountLabel] }),
+		shootSubAccountIndex:    shootIndexFunc(true, func(shoot *gardener.Shoot) string { return shoot.GetLabels()[subAccountLabel] }),
+		shootRegionIndex:        shootIndexFunc(true, func(shoot *gardener.Shoot) string { return shoot.GetSpecRegion() }),
+		// the shoots without the runtime ID are never resolved, there is no need to index them
+		shootRuntimeIDIndex: shootIndexFunc(false, func(shoot *gardener.Shoot) string { return shoot.GetAnnotations()[runtimeIDAnnotation] }),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while adding shoot indexers: %w", err)
+	}
+
+	resolver := &CachedGardenerRuntimeResolver{
+		GardenerRuntimeResolver: NewGardenerRuntimeResolver(gardenerClient, gardenerNamespace, lister, logger),
+		informer:                informer,
+		runtimeSyncInterval:     runtimeSyncInterval,
+		missingRuntimes:         map[string]bool{},
+	}
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: resolver.onShootDelete,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while adding shoot event handler: %w", err)
+	}
+
+	return resolver, nil
+}
+
+// Start runs the shoot informer until the context is done and waits for the initial sync of the cache
+func (resolver *CachedGardenerRuntimeResolver) Start(ctx context.Context) error {
+	go resolver.informer.Run(ctx.Done())
+
+	if !cache.WaitForCacheSync(ctx.Done(), resolver.informer.HasSynced) {
+		return fmt.Errorf("while waiting for the shoots cache in namespace %s to sync", resolver.gardenerNamespace)
+	}
+	resolver.logger.Infof("Shoots cache synced")
+	return nil
+}
+
+// Resolve given an input slice of target specs to include and exclude, returns back a list of unique Runtime objects
+func (resolver *CachedGardenerRuntimeResolver) Resolve(targets TargetSpec) ([]Runtime, error) {
+	if !resolver.informer.HasSynced() {
+		return nil, fmt.Errorf("shoots cache in namespace %s is not synced", resolver.gardenerNamespace)
+	}
+	if err := resolver.syncRuntimesIfNeeded(); err != nil {
+		return nil, fmt.Errorf("while syncing runtimes: %w", err)
+	}
+
+	return resolver.resolveTargets(targets, resolver.shootsForTarget)
+}
+
+func (resolver *CachedGardenerRuntimeResolver) syncRuntimesIfNeeded() error {
+	resolver.syncMutex.Lock()
+	defer resolver.syncMutex.Unlock()
+
+	runtimeIDs := resolver.informer.GetIndexer().ListIndexFuncValues(shootRuntimeIDIndex)
+	if time.Since(resolver.lastRuntimeSync) >= resolver.runtimeSyncInterval {
+		if err := resolver.syncRuntimeOperations(); err != nil {
+			return err
+		}
+		resolver.lastRuntimeSync = time.Now()
+		resolver.missingRuntimes = map[string]bool{}
+		resolver.markMissingRuntimes(runtimeIDs)
+		return nil
+	}
+
+	newRuntimeIDs := resolver.newRuntimes(runtimeIDs)
+	if len(newRuntimeIDs) == 0 {
+		return nil
+	}
+	runtimes, err := resolver.runtimeLister.ListRuntimes(newRuntimeIDs)
+	if err != nil {
+		return err
+	}
+	resolver.storeRuntimes(runtimes)
+	resolver.markMissingRuntimes(newRuntimeIDs)
+
+	return nil
+}
+
+// newRuntimes returns the runtimes which have a shoot, but are not known and were not missing in the previous syncs
+func (resolver *CachedGardenerRuntimeResolver) newRuntimes(runtimeIDs []string) []string {
+	newRuntimeIDs := []string{}
+	for _, runtimeID := range runtimeIDs {
+		if _, ok := resolver.getRuntime(runtimeID); !ok && !resolver.missingRuntimes[runtimeID] {
+			newRuntimeIDs = append(newRuntimeIDs, runtimeID)
+		}
+	}
+	return newRuntimeIDs
+}
+
+// markMissingRuntimes remembers the runtimes which have a shoot but were not returned by KEB, so they do not trigger a sync
+func (resolver *CachedGardenerRuntimeResolver) markMissingRuntimes(runtimeIDs []string) {
+	for _, runtimeID := range runtimeIDs {
+		if _, ok := resolver.getRuntime(runtimeID); !ok {
+			resolver.missingRuntimes[runtimeID] = true
+		}
+	}
+}
+
+// shootsForTarget returns the cached shoots matching the indexed fields of the target, all the shoots if none is specified
+func (resolver *CachedGardenerRuntimeResolver) shootsForTarget(rt RuntimeTarget) ([]unstructured.Unstructured, error) {
+	indexer := resolver.informer.GetIndexer()
+	// nil means the target does not narrow the shoots by any index
+	var candidates map[string]*unstructured.Unstructured
+	narrow := func(objects []interface{}) {
+		matched := map[string]*unstructured.Unstructured{}
+		for _, obj := range objects {
+			shoot, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if candidates == nil || candidates[shoot.GetName()] != nil {
+				matched[shoot.GetName()] = shoot
+			}
+		}
+		candidates = matched
+	}
+
+	// the runtime ID target matches the runtime regardless of the other fields, the same as in GardenerRuntimeResolver
+	if rt.RuntimeID != "" {
+		objects, err := indexer.ByIndex(shootRuntimeIDIndex, rt.RuntimeID)
+		if err != nil {
+			return nil, fmt.Errorf("while getting shoots by runtime ID: %w", err)
+		}
+		narrow(objects)
+		return sortedShoots(candidates), nil
+	}
+	for _, indexed := range []struct {
+		index   string
+		pattern string
+	}{
+		{index: shootGlobalAccountIndex, pattern: rt.GlobalAccount},
+		{index: shootSubAccountIndex, pattern: rt.SubAccount},
+		{index: shootRegionIndex, pattern: rt.Region},
+	} {
+		if indexed.pattern == "" {
+			continue
+		}
+		objects, err := byIndexPattern(indexer, indexed.index, indexed.pattern)
+		if err != nil {
+			return nil, err
+		}
+		narrow(objects)
+	}
+	if candidates == nil {
+		narrow(indexer.List())
+	}
+
+	return sortedShoots(candidates), nil
+}
+
+func sortedShoots(candidates map[string]*unstructured.Unstructured) []unstructured.Unstructured {
+	shoots := make([]unstructured.Unstructured, 0, len(candidates))
+	for _, shoot := range candidates {
+		shoots = append(shoots, *shoot)
+	}
+	sort.Slice(shoots, func(i, j int) bool {
+		return shoots[i].GetName() < shoots[j].GetName()
+	})
+
+	return shoots
+}
+
+// byIndexPattern returns the objects which index value matches the regexp pattern,
+// an invalid pattern matches nothing, the same as in GardenerRuntimeResolver
+func byIndexPattern(indexer cache.Indexer, index, pattern string) ([]interface{}, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return []interface{}{}, nil
+	}
+
+	objects := []interface{}{}
+	for _, value := range indexer.ListIndexFuncValues(index) {
+		if !re.MatchString(value) {
+			continue
+		}
+		matched, err := indexer.ByIndex(index, value)
+		if err != nil {
+			return nil, fmt.Errorf("while getting shoots by %s index: %w", index, err)
+		}
+		objects = append(objects, matched...)
+	}
+
+	return objects, nil
+}
+
+func (resolver *CachedGardenerRuntimeResolver) onShootDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	shoot, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	runtimeID := shoot.GetAnnotations()[runtimeIDAnnotation]
+	if runtimeID == "" {
+		return
+	}
+
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	delete(resolver.runtimes, runtimeID)
+}
+
+// shootIndexFunc returns the index function of the shoot value, the empty values are indexed too if indexEmpty is set,
+// so the patterns matching the empty string (e.g. ".*") select the shoots without the value, the same as in GardenerRuntimeResolver
+func shootIndexFunc(indexEmpty bool, value func(shoot *gardener.Shoot) string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+		v := value(&gardener.Shoot{Unstructured: *u})
+		if v == "" && !indexEmpty {
+			return []string{}, nil
+		}
+		return []string{v}, nil
+	}
+}
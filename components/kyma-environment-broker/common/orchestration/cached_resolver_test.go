@@ -0,0 +1,223 @@
+package orchestration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	brokerapi "github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+)
+
+func TestCachedResolver_Resolve(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lister := newRuntimeListerMock()
+	defer lister.AssertExpectations(t)
+	resolver, err := NewCachedGardenerRuntimeResolver(newFakeGardenerClient(), shootNamespace, lister, time.Hour, time.Hour, newLogDummy())
+	require.NoError(t, err)
+	require.NoError(t, resolver.Start(ctx))
+
+	for tn, tc := range map[string]struct {
+		target             TargetSpec
+		expectedRuntimeIDs []string
+	}{
+		"IncludeAll": {
+			target:             TargetSpec{Include: []RuntimeTarget{{Target: TargetAll}}},
+			expectedRuntimeIDs: []string{runtime1.RuntimeID, runtime2.RuntimeID, runtime3.RuntimeID, runtime10.RuntimeID},
+		},
+		"IncludeAllExcludeOne": {
+			target: TargetSpec{
+				Include: []RuntimeTarget{{Target: TargetAll}},
+				Exclude: []RuntimeTarget{{GlobalAccount: runtime2.GlobalAccountID, SubAccount: runtime2.SubAccountID}},
+			},
+			expectedRuntimeIDs: []string{runtime1.RuntimeID, runtime3.RuntimeID, runtime10.RuntimeID},
+		},
+		"IncludeRuntime": {
+			target:             TargetSpec{Include: []RuntimeTarget{{RuntimeID: runtime1.RuntimeID}}},
+			expectedRuntimeIDs: []string{runtime1.RuntimeID},
+		},
+		"IncludeTenant": {
+			target:             TargetSpec{Include: []RuntimeTarget{{GlobalAccount: globalAccountID1}}},
+			expectedRuntimeIDs: []string{runtime1.RuntimeID, runtime2.RuntimeID, runtime10.RuntimeID},
+		},
+		"IncludeRegionPattern": {
+			target:             TargetSpec{Include: []RuntimeTarget{{Region: "europe|eu|uk"}}},
+			expectedRuntimeIDs: []string{runtime1.RuntimeID, runtime3.RuntimeID, runtime10.RuntimeID},
+		},
+		"IncludeTenantAndRegion": {
+			target:             TargetSpec{Include: []RuntimeTarget{{GlobalAccount: globalAccountID1, Region: region2}}},
+			expectedRuntimeIDs: []string{runtime2.RuntimeID},
+		},
+		"IncludePlanName": {
+			target:             TargetSpec{Include: []RuntimeTarget{{PlanName: plan1}}},
+			expectedRuntimeIDs: []string{runtime2.RuntimeID, runtime3.RuntimeID, runtime10.RuntimeID},
+		},
+		"InvalidPattern": {
+			target:             TargetSpec{Include: []RuntimeTarget{{Region: "("}}},
+			expectedRuntimeIDs: []string{},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			runtimes, err := resolver.Resolve(tc.target)
+
+			// then
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectedRuntimeIDs, runtimeIDs(runtimes))
+		})
+	}
+	lister.AssertNumberOfCalls(t, "ListAllRuntimes", 1)
+}
+
+func TestCachedResolver_Resolve_ShootEvents(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := gardener.NewDynamicFakeClient(&shoot1)
+	lister := &RuntimeListerMock{}
+	lister.On("ListAllRuntimes").Return([]runtime.RuntimeDTO{runtime1}, nil).Once()
+	lister.On("ListRuntimes", []string{runtime2.RuntimeID}).Return([]runtime.RuntimeDTO{runtime2}, nil).Once()
+	defer lister.AssertExpectations(t)
+	resolver, err := NewCachedGardenerRuntimeResolver(client, shootNamespace, lister, time.Hour, time.Hour, newLogDummy())
+	require.NoError(t, err)
+	require.NoError(t, resolver.Start(ctx))
+	all := TargetSpec{Include: []RuntimeTarget{{Target: TargetAll}}}
+
+	runtimes, err := resolver.Resolve(all)
+	require.NoError(t, err)
+	assert.Equal(t, []string{runtime1.RuntimeID}, runtimeIDs(runtimes))
+
+	// when the shoot of a new runtime is created
+	_, err = client.Resource(gardener.ShootResource).Namespace(shootNamespace).Create(ctx, &shoot2, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// then only the new runtime is synced
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		runtimes, err = resolver.Resolve(all)
+		return err == nil && len(runtimes) == 2, err
+	})
+	require.NoError(t, err)
+	lister.AssertNumberOfCalls(t, "ListAllRuntimes", 1)
+	lister.AssertNumberOfCalls(t, "ListRuntimes", 1)
+
+	// when the shoot is deleted
+	err = client.Resource(gardener.ShootResource).Namespace(shootNamespace).Delete(ctx, shoot1.GetName(), metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	// then the runtime is not resolved
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		runtimes, err = resolver.Resolve(all)
+		return err == nil && len(runtimes) == 1, err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{runtime2.RuntimeID}, runtimeIDs(runtimes))
+	_, found := resolver.getRuntime(runtime1.RuntimeID)
+	assert.False(t, found)
+}
+
+func TestCachedResolver_Resolve_MissingRuntimeDoesNotResync(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// shoot3 has no runtime in KEB
+	client := gardener.NewDynamicFakeClient(&shoot1, &shoot3)
+	lister := &RuntimeListerMock{}
+	lister.On("ListAllRuntimes").Return([]runtime.RuntimeDTO{runtime1}, nil)
+	defer lister.AssertExpectations(t)
+	resolver, err := NewCachedGardenerRuntimeResolver(client, shootNamespace, lister, time.Hour, time.Hour, newLogDummy())
+	require.NoError(t, err)
+	require.NoError(t, resolver.Start(ctx))
+
+	// when
+	for i := 0; i < 3; i++ {
+		runtimes, err := resolver.Resolve(TargetSpec{Include: []RuntimeTarget{{Target: TargetAll}}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{runtime1.RuntimeID}, runtimeIDs(runtimes))
+	}
+
+	// then
+	lister.AssertNumberOfCalls(t, "ListAllRuntimes", 1)
+	lister.AssertNotCalled(t, "ListRuntimes", mock.Anything)
+}
+
+func TestCachedResolver_Resolve_SameAsGardenerResolver(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// shoot11 has no subaccount label and no region
+	shootWithoutSubAccount := fixShoot(11, globalAccountID1, "")
+	delete(shootWithoutSubAccount.Object["metadata"].(map[string]interface{})["labels"].(map[string]interface{}), subAccountLabel)
+	client := gardener.NewDynamicFakeClient(&shoot1, &shoot2, &shoot3, &shoot4, &shoot5, &shoot6, &shoot8, &shoot9, &shoot10, &shootWithoutSubAccount)
+	lister := &RuntimeListerMock{}
+	lister.On("ListAllRuntimes").Return([]runtime.RuntimeDTO{runtime1, runtime2, runtime3, runtime4, runtime5, runtime6, runtime7, runtime8, runtime9, runtime10, runtime11Provisioned}, nil)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, newLogDummy())
+	cachedResolver, err := NewCachedGardenerRuntimeResolver(client, shootNamespace, lister, time.Hour, time.Hour, newLogDummy())
+	require.NoError(t, err)
+	require.NoError(t, cachedResolver.Start(ctx))
+
+	for tn, target := range map[string]RuntimeTarget{
+		"All":                       {Target: TargetAll},
+		"AnySubAccount":             {SubAccount: ".*"},
+		"EmptySubAccount":           {SubAccount: "^$"},
+		"AnyRegion":                 {Region: ".*"},
+		"RegionPattern":             {Region: "europe|eu|uk"},
+		"GlobalAccount":             {GlobalAccount: globalAccountID1},
+		"GlobalAccountAndPlan":      {GlobalAccount: globalAccountID1, PlanName: plan1},
+		"SubAccountAndRegion":       {SubAccount: "subaccount-id-[12]", Region: region2},
+		"RuntimeID":                 {RuntimeID: runtime11Provisioned.RuntimeID},
+		"RuntimeIDAndGlobalAccount": {RuntimeID: runtime1.RuntimeID, GlobalAccount: globalAccountID2},
+		"InvalidPattern":            {GlobalAccount: "("},
+		"NotExistingSubAccount":     {SubAccount: "not-existing"},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			targets := TargetSpec{Include: []RuntimeTarget{target}}
+
+			// when
+			expected, err := resolver.Resolve(targets)
+			require.NoError(t, err)
+			runtimes, err := cachedResolver.Resolve(targets)
+			require.NoError(t, err)
+
+			// then
+			assert.ElementsMatch(t, runtimeIDs(expected), runtimeIDs(runtimes))
+		})
+	}
+}
+
+func TestCachedResolver_Resolve_NotStarted(t *testing.T) {
+	// given
+	lister := &RuntimeListerMock{}
+	resolver, err := NewCachedGardenerRuntimeResolver(newFakeGardenerClient(), shootNamespace, lister, time.Hour, time.Hour, newLogDummy())
+	require.NoError(t, err)
+
+	// when
+	_, err = resolver.Resolve(TargetSpec{Include: []RuntimeTarget{{Target: TargetAll}}})
+
+	// then
+	assert.Error(t, err)
+	lister.AssertNotCalled(t, "ListAllRuntimes")
+}
+
+var runtime11Provisioned = fixRuntimeDTO(11, globalAccountID1, plan1, runtimeOpState{provision: string(brokerapi.Succeeded)})
+
+func runtimeIDs(runtimes []Runtime) []string {
+	ids := []string{}
+	for _, r := range runtimes {
+		ids = append(ids, r.RuntimeID)
+	}
+	return ids
+}
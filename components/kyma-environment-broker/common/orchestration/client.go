@@ -29,6 +29,7 @@ type Client interface {
 	UpgradeKyma(params Parameters) (UpgradeResponse, error)
 	UpgradeCluster(params Parameters) (UpgradeResponse, error)
 	CancelOrchestration(orchestrationID string) error
+	ResumeOrchestration(orchestrationID string) error
 	RetryOrchestration(orchestrationID string, operationIDs []string, now bool) (RetryResponse, error)
 }
 
@@ -429,6 +430,38 @@ func (c client) CancelOrchestration(orchestrationID string) error {
 	return nil
 }
 
+func (c client) ResumeOrchestration(orchestrationID string) error {
+	url := fmt.Sprintf("%s/orchestrations/%s/resume", c.url, orchestrationID)
+
+	req, err := http.NewRequest(http.MethodPut, url, nil)
+	if err != nil {
+		return fmt.Errorf("while creating resume request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", url, err)
+	}
+
+	// Drain response body and close, return error to context if there isn't any.
+	defer func() {
+		derr := drainResponseBody(resp.Body)
+		if err == nil {
+			err = derr
+		}
+		cerr := resp.Body.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s returned %s status", url, resp.Status)
+	}
+
+	return nil
+}
+
 func setQuery(url *url.URL, params ListParameters) {
 	query := url.Query()
 	query.Add(pagination.PageParam, strconv.Itoa(params.Page))
@@ -350,6 +350,31 @@ func TestClient_CancelOrchestration(t *testing.T) {
 	})
 }
 
+func TestClient_ResumeOrchestration(t *testing.T) {
+	t.Run("test_URL__NoError_path", func(t *testing.T) {
+		// given
+		called := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called++
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, fmt.Sprintf("/orchestrations/%s/resume", orch1.OrchestrationID), r.URL.Path)
+			assert.Equal(t, fmt.Sprintf("Bearer %s", fixToken), r.Header.Get("Authorization"))
+
+			err := respondStatus(w, orch1)
+			require.NoError(t, err)
+		}))
+		defer ts.Close()
+		client := NewClient(context.TODO(), ts.URL, fixToken)
+
+		// when
+		err := client.ResumeOrchestration(orch1.OrchestrationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, called)
+	})
+}
+
 func TestClient_RetryOrchestration(t *testing.T) {
 	t.Run("test_URL_NoError_path", func(t *testing.T) {
 		// given
@@ -52,6 +52,7 @@ const (
 	Canceled   = "canceled"
 	Succeeded  = "succeeded"
 	Failed     = "failed"
+	Paused     = "paused" // waiting for the operator to resume or cancel the orchestration
 )
 
 // ListParameters hold attributes of list orchestrations / operations queries.
@@ -83,6 +84,18 @@ type RuntimeTarget struct {
 	Shoot string `json:"shoot,omitempty"`
 	// InstanceID is used to identify an instance by it's instance ID
 	InstanceID string `json:"instanceID,omitempty"`
+	// Semver range to match against the shoot cluster's Kubernetes version. E.g. "<1.26", "~1.25"
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Semver range to match against the machine image version of any shoot cluster's worker pool. E.g. "<934.8"
+	MachineImageVersion string `json:"machineImageVersion,omitempty"`
+	// Semver range to match against the runtime's Kyma version recorded in the runtime states. E.g. ">=2.10, <2.12"
+	KymaVersion string `json:"kymaVersion,omitempty"`
+	// Label selector to match against the shoot cluster's labels. E.g. "env=prod,tier in (a, b)"
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// MinAge matches the runtimes which instance was created at least the given time ago. E.g. "720h"
+	MinAge string `json:"minAge,omitempty"`
+	// MaxAge matches the runtimes which instance was created at most the given time ago. E.g. "24h"
+	MaxAge string `json:"maxAge,omitempty"`
 }
 
 type Type string
@@ -96,6 +109,7 @@ type StrategyType string
 
 const (
 	ParallelStrategy StrategyType = "parallel"
+	CanaryStrategy   StrategyType = "canary"
 )
 
 type ScheduleType string
@@ -111,6 +125,42 @@ type ParallelStrategySpec struct {
 	Workers int `json:"workers"`
 }
 
+// CanaryStrategySpec defines parameters for the canary orchestration strategy.
+// The operations are executed in waves, the first wave (canary) has CanarySize operations and every next wave
+// is GrowthFactor times bigger than the previous one. Operations of a wave are executed by Parallel.Workers workers.
+type CanaryStrategySpec struct {
+	// CanarySize is the number of operations in the first wave
+	CanarySize int `json:"canarySize,omitempty"`
+	// GrowthFactor multiplies the size of every next wave
+	GrowthFactor int `json:"growthFactor,omitempty"`
+	// SuccessRatio is the minimal ratio (0-1) of succeeded operations in a wave required to start the next wave
+	SuccessRatio float64 `json:"successRatio,omitempty"`
+	// SoakTime is the time to wait after a successful wave before the next wave starts, e.g. "30m"
+	SoakTime string `json:"soakTime,omitempty"`
+}
+
+const (
+	DefaultCanarySize   = 1
+	DefaultGrowthFactor = 2
+	DefaultSuccessRatio = 1.0
+	// DefaultMinFinishedOperations is the number of finished operations required to evaluate the failure percentage
+	// when the strategy does not define it
+	DefaultMinFinishedOperations = 10
+)
+
+// ExecutionProgress is the state of a strategy execution which is stored on the orchestration,
+// so a restarted execution continues where it stopped instead of starting over.
+// The pause itself is stored as the paused state of the orchestration.
+type ExecutionProgress struct {
+	// Wave is the number of the last started wave of the canary strategy
+	Wave int `json:"wave,omitempty"`
+	// NextWaveSize is the number of operations in the next wave of the canary strategy
+	NextWaveSize int `json:"nextWaveSize,omitempty"`
+	// AcknowledgedFailures is the number of failed operations when the orchestration was paused because of the failure limits,
+	// the limits are checked again only when more operations fail after resume
+	AcknowledgedFailures int `json:"acknowledgedFailures,omitempty"`
+}
+
 // StrategySpec is the strategy part common for all orchestration trigger/status API
 type StrategySpec struct {
 	Type              StrategyType `json:"type"`
@@ -118,6 +168,15 @@ type StrategySpec struct {
 	ScheduleTime      time.Time
 	MaintenanceWindow bool                 `json:"maintenanceWindow,omitempty"`
 	Parallel          ParallelStrategySpec `json:"parallel,omitempty"`
+	Canary            CanaryStrategySpec   `json:"canary,omitempty"`
+	// MaxFailures pauses the orchestration when the number of failed operations exceeds it, 0 means no limit
+	MaxFailures int `json:"maxFailures,omitempty"`
+	// MaxFailurePercentage pauses the orchestration when the percentage (0-100) of failed operations
+	// among the finished operations of the orchestration exceeds it, 0 means no limit
+	MaxFailurePercentage int `json:"maxFailurePercentage,omitempty"`
+	// MinFinishedOperations is the number of finished operations required before MaxFailurePercentage is evaluated,
+	// 0 means DefaultMinFinishedOperations, the orchestrations with fewer operations are evaluated once all of them are finished
+	MinFinishedOperations int `json:"minFinishedOperations,omitempty"`
 }
 
 // TargetSpec is the targets part common for all orchestration trigger/status API
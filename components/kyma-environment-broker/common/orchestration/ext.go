@@ -61,6 +61,34 @@ type Strategy interface {
 	SpeedUp(speedFactor int)
 }
 
+// PausableStrategy is a strategy which halts the execution when the results of the operations are not good enough.
+// The orchestration manager checks the paused executions, records the pause on the orchestration and resumes
+// the execution when the operator resumes the orchestration.
+type PausableStrategy interface {
+	Strategy
+	// Pause stops scheduling new operations of the execution with the given ID, the operations in progress are not interrupted
+	Pause(executionID, reason string)
+	// Paused returns true and the reason if the execution with the given ID waits for resume
+	Paused(executionID string) (bool, string)
+	// Resume continues the paused execution with the given ID
+	Resume(executionID string)
+}
+
+// WaveStrategy is a pausable strategy which executes the operations in waves. The orchestration manager stores
+// the progress of the waves on the orchestration and passes it back to the strategy when the execution is restarted.
+type WaveStrategy interface {
+	PausableStrategy
+	// Progress returns the wave and the size of the next wave of the execution with the given ID
+	Progress(executionID string) ExecutionProgress
+	// ExecuteFrom starts the execution of the operations with the wave which follows the given progress
+	ExecuteFrom(operations []RuntimeOperation, strategySpec StrategySpec, progress ExecutionProgress) (string, error)
+}
+
+// OperationStateGetter returns the current state of the operation, e.g. "succeeded" or "failed"
+type OperationStateGetter interface {
+	GetOperationState(operationID string) (string, error)
+}
+
 func ConvertSliceOfDaysToMap(days []string) map[time.Weekday]bool {
 	m := make(map[time.Weekday]bool)
 	for _, day := range days {
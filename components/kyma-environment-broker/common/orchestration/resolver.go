@@ -22,13 +22,14 @@ import (
 //go:generate mockery --name=RuntimeLister --output=. --outpkg=orchestration --case=underscore --structname RuntimeListerMock --filename runtime_lister_mock.go
 type RuntimeLister interface {
 	ListAllRuntimes() ([]runtime.RuntimeDTO, error)
+	ListRuntimes(runtimeIDs []string) ([]runtime.RuntimeDTO, error)
 }
 
 // GardenerRuntimeResolver is the default resolver which implements the RuntimeResolver interface.
 // This resolver uses the Shoot resources on the Gardener cluster to resolve the runtime targets.
 //
 // Naive implementation, listing all the shoots and perfom filtering on the result.
-// The logic could be optimized with k8s client cache using shoot lister / indexer.
+// See CachedGardenerRuntimeResolver for the implementation using the shoot informer cache.
 // The implementation is thread safe, i.e. it is safe to call Resolve() from multiple threads concurrently.
 type GardenerRuntimeResolver struct {
 	gardenerClient    dynamic.Interface
@@ -59,9 +60,6 @@ func NewGardenerRuntimeResolver(gardenerClient dynamic.Interface, gardenerNamesp
 
 // Resolve given an input slice of target specs to include and exclude, returns back a list of unique Runtime objects
 func (resolver *GardenerRuntimeResolver) Resolve(targets TargetSpec) ([]Runtime, error) {
-	runtimeIncluded := map[string]bool{}
-	runtimeExcluded := map[string]bool{}
-	runtimes := []Runtime{}
 	shoots, err := resolver.getAllShoots()
 	if err != nil {
 		return nil, fmt.Errorf("while listing gardener shoots in namespace %s: %w", resolver.gardenerNamespace, err)
@@ -71,8 +69,24 @@ func (resolver *GardenerRuntimeResolver) Resolve(targets TargetSpec) ([]Runtime,
 		return nil, fmt.Errorf("while syncing runtimes: %w", err)
 	}
 
+	return resolver.resolveTargets(targets, func(RuntimeTarget) ([]unstructured.Unstructured, error) {
+		return shoots, nil
+	})
+}
+
+// resolveTargets returns the runtimes of the included targets which are not excluded, the shootsForTarget function
+// returns the shoots to evaluate for the given target
+func (resolver *GardenerRuntimeResolver) resolveTargets(targets TargetSpec, shootsForTarget func(RuntimeTarget) ([]unstructured.Unstructured, error)) ([]Runtime, error) {
+	runtimeIncluded := map[string]bool{}
+	runtimeExcluded := map[string]bool{}
+	runtimes := []Runtime{}
+
 	// Assemble IDs of runtimes to exclude
 	for _, rt := range targets.Exclude {
+		shoots, err := shootsForTarget(rt)
+		if err != nil {
+			return nil, err
+		}
 		runtimesToExclude, err := resolver.resolveRuntimeTarget(rt, shoots)
 		if err != nil {
 			return nil, err
@@ -84,6 +98,10 @@ func (resolver *GardenerRuntimeResolver) Resolve(targets TargetSpec) ([]Runtime,
 
 	// Include runtimes which are not excluded
 	for _, rt := range targets.Include {
+		shoots, err := shootsForTarget(rt)
+		if err != nil {
+			return nil, err
+		}
 		runtimesToAdd, err := resolver.resolveRuntimeTarget(rt, shoots)
 		if err != nil {
 			return nil, err
@@ -114,14 +132,18 @@ func (resolver *GardenerRuntimeResolver) syncRuntimeOperations() error {
 	if err != nil {
 		return err
 	}
+	resolver.storeRuntimes(runtimes)
+
+	return nil
+}
+
+func (resolver *GardenerRuntimeResolver) storeRuntimes(runtimes []runtime.RuntimeDTO) {
 	resolver.mutex.Lock()
 	defer resolver.mutex.Unlock()
 
 	for _, rt := range runtimes {
 		resolver.runtimes[rt.RuntimeID] = rt
 	}
-
-	return nil
 }
 
 func (resolver *GardenerRuntimeResolver) getRuntime(runtimeID string) (runtime.RuntimeDTO, bool) {
@@ -134,6 +156,10 @@ func (resolver *GardenerRuntimeResolver) getRuntime(runtimeID string) (runtime.R
 
 func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget, shoots []unstructured.Unstructured) ([]Runtime, error) {
 	runtimes := []Runtime{}
+	selectors, err := NewRuntimeSelectors(rt)
+	if err != nil {
+		return nil, fmt.Errorf("while building selectors of runtime target: %w", err)
+	}
 	// Iterate over all shoots. Evaluate target specs. If multiple are specified, all must match for a given shoot.
 	for _, s := range shoots {
 		shoot := &gardener.Shoot{s}
@@ -219,6 +245,11 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 			}
 		}
 
+		// Perform match against the version, label and age selectors
+		if !matchesAll(selectors, shoot, r) {
+			continue
+		}
+
 		// Check if target: all is specified
 		if rt.Target != "" && rt.Target != TargetAll {
 			continue
@@ -230,6 +261,15 @@ func (resolver *GardenerRuntimeResolver) resolveRuntimeTarget(rt RuntimeTarget,
 	return runtimes, nil
 }
 
+func matchesAll(selectors []RuntimeSelector, shoot *gardener.Shoot, r runtime.RuntimeDTO) bool {
+	for _, selector := range selectors {
+		if !selector.Match(shoot, r) {
+			return false
+		}
+	}
+	return true
+}
+
 func (*GardenerRuntimeResolver) runtimeFromDTO(runtime runtime.RuntimeDTO, shootName string, windowBegin, windowEnd time.Time) Runtime {
 	return Runtime{
 		InstanceID:             runtime.InstanceID,
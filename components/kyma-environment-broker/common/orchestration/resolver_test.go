@@ -256,6 +256,91 @@ func TestResolver_Resolve_StorageFailure(t *testing.T) {
 	assert.Len(t, runtimes, 0)
 }
 
+func TestResolver_Resolve_Selectors(t *testing.T) {
+	// given
+	oldShoot := fixShootWithVersions(fixShoot(1, globalAccountID1, region1), "1.25.6", "934.8.0", map[string]string{"env": "dev"})
+	newShoot := fixShootWithVersions(fixShoot(2, globalAccountID1, region2), "1.26.1", "938.0.0", map[string]string{"env": "prod"})
+	client := gardener.NewDynamicFakeClient(&oldShoot, &newShoot)
+
+	oldRuntime := fixRuntimeDTO(1, globalAccountID1, plan1, runtimeOpState{provision: string(brokerapi.Succeeded)})
+	oldRuntime.KymaVersion = "2.10.3"
+	oldRuntime.Status.CreatedAt = time.Now().Add(-90 * 24 * time.Hour)
+	newRuntime := fixRuntimeDTO(2, globalAccountID1, plan1, runtimeOpState{provision: string(brokerapi.Succeeded)})
+	newRuntime.KymaVersion = "PR-1234"
+	newRuntime.Status.CreatedAt = time.Now().Add(-time.Hour)
+
+	lister := &RuntimeListerMock{}
+	lister.On("ListAllRuntimes").Return([]runtime.RuntimeDTO{oldRuntime, newRuntime}, nil)
+	defer lister.AssertExpectations(t)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, newLogDummy())
+
+	for tn, tc := range map[string]struct {
+		target             RuntimeTarget
+		expectedRuntimeIDs []string
+	}{
+		"KubernetesVersion": {
+			target:             RuntimeTarget{KubernetesVersion: "~1.25"},
+			expectedRuntimeIDs: []string{oldRuntime.RuntimeID},
+		},
+		"MachineImageVersion": {
+			target:             RuntimeTarget{MachineImageVersion: ">=938"},
+			expectedRuntimeIDs: []string{newRuntime.RuntimeID},
+		},
+		"KymaVersionSkipsNonSemver": {
+			target:             RuntimeTarget{KymaVersion: ">=2.10, <2.11"},
+			expectedRuntimeIDs: []string{oldRuntime.RuntimeID},
+		},
+		"LabelSelector": {
+			target:             RuntimeTarget{LabelSelector: "env in (prod, staging)"},
+			expectedRuntimeIDs: []string{newRuntime.RuntimeID},
+		},
+		"MinAge": {
+			target:             RuntimeTarget{MinAge: "720h"},
+			expectedRuntimeIDs: []string{oldRuntime.RuntimeID},
+		},
+		"MaxAge": {
+			target:             RuntimeTarget{MaxAge: "24h"},
+			expectedRuntimeIDs: []string{newRuntime.RuntimeID},
+		},
+		"AllSelectorsMustMatch": {
+			target:             RuntimeTarget{KubernetesVersion: "<1.27", LabelSelector: "env=dev", MaxAge: "24h"},
+			expectedRuntimeIDs: []string{},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			runtimes, err := resolver.Resolve(TargetSpec{Include: []RuntimeTarget{tc.target}})
+
+			// then
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectedRuntimeIDs, runtimeIDs(runtimes))
+		})
+	}
+}
+
+func TestResolver_Resolve_InvalidSelector(t *testing.T) {
+	// given
+	client := newFakeGardenerClient()
+	lister := newRuntimeListerMock()
+	defer lister.AssertExpectations(t)
+	resolver := NewGardenerRuntimeResolver(client, shootNamespace, lister, newLogDummy())
+
+	for tn, target := range map[string]RuntimeTarget{
+		"KubernetesVersion": {KubernetesVersion: "not a range"},
+		"LabelSelector":     {LabelSelector: "env in prod"},
+		"MinAge":            {MinAge: "a month"},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			runtimes, err := resolver.Resolve(TargetSpec{Include: []RuntimeTarget{target}})
+
+			// then
+			assert.Error(t, err)
+			assert.Empty(t, runtimes)
+		})
+	}
+}
+
 var (
 	shoot1 = fixShoot(1, globalAccountID1, region1)
 	shoot2 = fixShoot(2, globalAccountID1, region2)
@@ -311,6 +396,32 @@ func fixShoot(id int, globalAccountID, region string) unstructured.Unstructured
 	}
 }
 
+func fixShootWithVersions(shoot unstructured.Unstructured, kubernetesVersion, machineImageVersion string, labels map[string]string) unstructured.Unstructured {
+	shoot.Object["spec"].(map[string]interface{})["kubernetes"] = map[string]interface{}{
+		"version": kubernetesVersion,
+	}
+	shoot.Object["spec"].(map[string]interface{})["provider"] = map[string]interface{}{
+		"workers": []interface{}{
+			map[string]interface{}{
+				"name": "cpu-worker-0",
+				"machine": map[string]interface{}{
+					"image": map[string]interface{}{
+						"name":    "gardenlinux",
+						"version": machineImageVersion,
+					},
+				},
+			},
+		},
+	}
+	shootLabels := shoot.GetLabels()
+	for k, v := range labels {
+		shootLabels[k] = v
+	}
+	shoot.SetLabels(shootLabels)
+
+	return shoot
+}
+
 type runtimeOpState struct {
 	provision    string
 	deprovision  string
@@ -36,6 +36,29 @@ func (_m *RuntimeListerMock) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
 	return r0, r1
 }
 
+// ListRuntimes provides a mock function with given fields: runtimeIDs
+func (_m *RuntimeListerMock) ListRuntimes(runtimeIDs []string) ([]runtime.RuntimeDTO, error) {
+	ret := _m.Called(runtimeIDs)
+
+	var r0 []runtime.RuntimeDTO
+	if rf, ok := ret.Get(0).(func([]string) []runtime.RuntimeDTO); ok {
+		r0 = rf(runtimeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runtime.RuntimeDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(runtimeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRuntimeListerMock interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,119 @@
+package orchestration
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// RuntimeSelector matches a shoot and its runtime against a single criterion of a runtime target.
+type RuntimeSelector interface {
+	Match(shoot *gardener.Shoot, runtime runtime.RuntimeDTO) bool
+}
+
+// RuntimeSelectorFunc is an adapter to use ordinary functions as runtime selectors.
+type RuntimeSelectorFunc func(shoot *gardener.Shoot, runtime runtime.RuntimeDTO) bool
+
+func (f RuntimeSelectorFunc) Match(shoot *gardener.Shoot, runtime runtime.RuntimeDTO) bool {
+	return f(shoot, runtime)
+}
+
+// NewRuntimeSelectors builds the selectors for the version, label and age criteria of the runtime target.
+// An error is returned when any of the criteria is not valid, e.g. the semver range cannot be parsed.
+func NewRuntimeSelectors(rt RuntimeTarget) ([]RuntimeSelector, error) {
+	selectors := []RuntimeSelector{}
+
+	if rt.KubernetesVersion != "" {
+		constraint, err := semver.NewConstraint(rt.KubernetesVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kubernetesVersion range %q: %w", rt.KubernetesVersion, err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(shoot *gardener.Shoot, _ runtime.RuntimeDTO) bool {
+			return versionMatches(constraint, shoot.GetSpecKubernetesVersion())
+		}))
+	}
+
+	if rt.MachineImageVersion != "" {
+		constraint, err := semver.NewConstraint(rt.MachineImageVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid machineImageVersion range %q: %w", rt.MachineImageVersion, err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(shoot *gardener.Shoot, _ runtime.RuntimeDTO) bool {
+			for _, version := range shoot.GetSpecMachineImageVersions() {
+				if versionMatches(constraint, version) {
+					return true
+				}
+			}
+			return false
+		}))
+	}
+
+	if rt.KymaVersion != "" {
+		constraint, err := semver.NewConstraint(rt.KymaVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kymaVersion range %q: %w", rt.KymaVersion, err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(_ *gardener.Shoot, r runtime.RuntimeDTO) bool {
+			return versionMatches(constraint, r.KymaVersion)
+		}))
+	}
+
+	if rt.LabelSelector != "" {
+		selector, err := labels.Parse(rt.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid labelSelector %q: %w", rt.LabelSelector, err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(shoot *gardener.Shoot, _ runtime.RuntimeDTO) bool {
+			return selector.Matches(labels.Set(shoot.GetLabels()))
+		}))
+	}
+
+	if rt.MinAge != "" {
+		minAge, err := parseAge(rt.MinAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minAge: %w", err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(_ *gardener.Shoot, r runtime.RuntimeDTO) bool {
+			return !r.Status.CreatedAt.IsZero() && time.Since(r.Status.CreatedAt) >= minAge
+		}))
+	}
+
+	if rt.MaxAge != "" {
+		maxAge, err := parseAge(rt.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxAge: %w", err)
+		}
+		selectors = append(selectors, RuntimeSelectorFunc(func(_ *gardener.Shoot, r runtime.RuntimeDTO) bool {
+			return !r.Status.CreatedAt.IsZero() && time.Since(r.Status.CreatedAt) <= maxAge
+		}))
+	}
+
+	return selectors, nil
+}
+
+// versionMatches returns false for the empty or non semver versions, e.g. Kyma versions built from a PR
+func versionMatches(constraint *semver.Constraints, version string) bool {
+	if version == "" {
+		return false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(v)
+}
+
+func parseAge(age string) (time.Duration, error) {
+	d, err := time.ParseDuration(age)
+	if err != nil {
+		return 0, fmt.Errorf("while parsing duration %q: %w", age, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration %q must not be negative", age)
+	}
+	return d, nil
+}
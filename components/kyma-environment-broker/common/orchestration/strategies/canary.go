@@ -0,0 +1,363 @@
+package strategies
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+)
+
+type canaryExecution struct {
+	pending    []orchestration.RuntimeOperation // operations not assigned to any wave yet
+	waveExecID string                           // execution ID of the running wave in the parallel strategy
+	wave       int                              // number of the last started wave
+	nextSize   int                              // number of operations in the next wave
+	paused     bool
+	reason     string
+	finished   bool
+	canceled   bool
+
+	resume chan struct{}
+	cancel chan struct{}
+	done   chan struct{}
+}
+
+type CanaryOrchestrationStrategy struct {
+	states      orchestration.OperationStateGetter
+	parallel    orchestration.PausableStrategy // executes the operations of a single wave
+	executions  map[string]*canaryExecution
+	mux         sync.RWMutex
+	log         logrus.FieldLogger
+	speedFactor int
+}
+
+// NewCanaryOrchestrationStrategy returns a new canary orchestration strategy, which executes operations in growing waves.
+// The first wave is the canary. The next wave starts when the ratio of succeeded operations in the previous wave
+// reaches the configured success ratio and the soak time passes. Otherwise, the execution is paused until it is resumed or canceled.
+func NewCanaryOrchestrationStrategy(executor orchestration.OperationExecutor, states orchestration.OperationStateGetter, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.WaveStrategy {
+	return &CanaryOrchestrationStrategy{
+		states:      states,
+		parallel:    NewParallelOrchestrationStrategy(executor, log, rescheduleDelay),
+		executions:  map[string]*canaryExecution{},
+		log:         log,
+		speedFactor: 1,
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) SpeedUp(factor int) {
+	c.speedFactor = factor
+	c.parallel.SpeedUp(factor)
+}
+
+// Execute starts the execution of the operations in waves.
+func (c *CanaryOrchestrationStrategy) Execute(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) (string, error) {
+	return c.ExecuteFrom(operations, strategySpec, orchestration.ExecutionProgress{})
+}
+
+// ExecuteFrom starts the execution of the operations with the wave which follows the given one,
+// it is used to continue the execution of an orchestration after a restart.
+func (c *CanaryOrchestrationStrategy) ExecuteFrom(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec, progress orchestration.ExecutionProgress) (string, error) {
+	if len(operations) == 0 {
+		return "", nil
+	}
+
+	soakTime, err := CanarySoakTime(strategySpec.Canary)
+	if err != nil {
+		return "", err
+	}
+
+	nextSize := progress.NextWaveSize
+	if nextSize <= 0 {
+		nextSize = strategySpec.Canary.CanarySize
+	}
+	if nextSize <= 0 {
+		nextSize = orchestration.DefaultCanarySize
+	}
+
+	execID := uuid.New().String()
+	exec := &canaryExecution{
+		pending:  append([]orchestration.RuntimeOperation{}, operations...),
+		wave:     progress.Wave,
+		nextSize: nextSize,
+		resume:   make(chan struct{}, 1),
+		cancel:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	c.mux.Lock()
+	c.executions[execID] = exec
+	c.mux.Unlock()
+
+	go c.run(execID, exec, strategySpec, soakTime)
+
+	return execID, nil
+}
+
+// Insert adds operations to the last wave of the given execution
+func (c *CanaryOrchestrationStrategy) Insert(execID string, operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec, exist := c.executions[execID]
+	if !exist {
+		return fmt.Errorf("no execution with ID: %s", execID)
+	}
+	if exec.finished || exec.canceled {
+		return fmt.Errorf("the execution ID %s is finished", execID)
+	}
+	exec.pending = append(exec.pending, operations...)
+
+	return nil
+}
+
+func (c *CanaryOrchestrationStrategy) run(execID string, exec *canaryExecution, strategySpec orchestration.StrategySpec, soakTime time.Duration) {
+	defer close(exec.done)
+	log := c.log.WithField("executionID", execID)
+
+	growthFactor := strategySpec.Canary.GrowthFactor
+	if growthFactor <= 0 {
+		growthFactor = orchestration.DefaultGrowthFactor
+	}
+	successRatio := strategySpec.Canary.SuccessRatio
+	if successRatio <= 0 {
+		successRatio = orchestration.DefaultSuccessRatio
+	}
+	if strategySpec.Parallel.Workers <= 0 {
+		strategySpec.Parallel.Workers = 1
+	}
+
+	for {
+		if c.isPaused(exec) {
+			log.Infof("execution is paused, waiting for resume")
+			if !c.pause(exec, "") {
+				return
+			}
+		}
+
+		wave, operations, last := c.nextWave(exec, growthFactor)
+		if len(operations) == 0 {
+			log.Infof("all waves are finished")
+			return
+		}
+
+		log.Infof("starting wave %d with %d operations", wave, len(operations))
+		waveExecID, err := c.parallel.Execute(operations, strategySpec)
+		if err != nil {
+			log.Errorf("while starting wave %d: %v", wave, err)
+			return
+		}
+		if !c.setWave(exec, waveExecID) {
+			c.parallel.Cancel(waveExecID)
+			return
+		}
+		c.parallel.Wait(waveExecID)
+
+		succeeded := c.countSucceeded(operations, log)
+		ratio := float64(succeeded) / float64(len(operations))
+		log.Infof("wave %d finished, %d of %d operations succeeded", wave, succeeded, len(operations))
+
+		switch {
+		case ratio < successRatio:
+			reason := fmt.Sprintf("wave %d breached the failure threshold: %d of %d operations succeeded, the required success ratio is %.2f", wave, succeeded, len(operations), successRatio)
+			log.Warnf("pausing the execution: %s", reason)
+			if !c.pause(exec, reason) {
+				return
+			}
+			log.Infof("execution resumed")
+		case soakTime > 0 && !last:
+			log.Infof("soaking wave %d for %s", wave, soakTime)
+			if !c.sleep(exec, time.Duration(int64(soakTime)/int64(c.speedFactor))) {
+				return
+			}
+		}
+	}
+}
+
+// nextWave takes the operations of the next wave from the pending ones and returns the number of the wave,
+// marks the execution finished if there are no operations left
+func (c *CanaryOrchestrationStrategy) nextWave(exec *canaryExecution, growthFactor int) (int, []orchestration.RuntimeOperation, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if exec.canceled || len(exec.pending) == 0 {
+		exec.finished = true
+		return exec.wave, nil, true
+	}
+	size := exec.nextSize
+	if size > len(exec.pending) {
+		size = len(exec.pending)
+	}
+	operations := exec.pending[:size]
+	exec.pending = exec.pending[size:]
+	exec.wave++
+	exec.nextSize *= growthFactor
+
+	return exec.wave, operations, len(exec.pending) == 0
+}
+
+func (c *CanaryOrchestrationStrategy) setWave(exec *canaryExecution, waveExecID string) bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec.waveExecID = waveExecID
+	if exec.paused {
+		c.parallel.Pause(waveExecID, exec.reason)
+	}
+	return !exec.canceled
+}
+
+func (c *CanaryOrchestrationStrategy) isPaused(exec *canaryExecution) bool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	return exec.paused
+}
+
+// countSucceeded returns the number of operations in the succeeded state, the operations with unknown state are treated as failed
+func (c *CanaryOrchestrationStrategy) countSucceeded(operations []orchestration.RuntimeOperation, log logrus.FieldLogger) int {
+	succeeded := 0
+	for _, op := range operations {
+		state, err := c.states.GetOperationState(op.ID)
+		if err != nil {
+			log.Errorf("while getting state of operation %s: %v", op.ID, err)
+			continue
+		}
+		if state == orchestration.Succeeded {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+// pause blocks until the execution is resumed (returns true) or canceled (returns false), the empty reason keeps the current one
+func (c *CanaryOrchestrationStrategy) pause(exec *canaryExecution, reason string) bool {
+	c.mux.Lock()
+	// drop the resume signal of a previous pause which was resumed while a wave was running
+	select {
+	case <-exec.resume:
+	default:
+	}
+	exec.paused = true
+	if reason != "" {
+		exec.reason = reason
+	}
+	c.mux.Unlock()
+
+	select {
+	case <-exec.resume:
+		return true
+	case <-exec.cancel:
+		return false
+	}
+}
+
+// sleep blocks for the given time, returns false if the execution was canceled in the meantime
+func (c *CanaryOrchestrationStrategy) sleep(exec *canaryExecution, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-exec.cancel:
+		return false
+	}
+}
+
+// Pause stops the running wave from scheduling new operations and holds the next waves until the execution is resumed
+func (c *CanaryOrchestrationStrategy) Pause(executionID, reason string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec, exist := c.executions[executionID]
+	if !exist || exec.paused || exec.finished || exec.canceled {
+		return
+	}
+	c.log.Infof("Pausing strategy execution %s: %s", executionID, reason)
+	exec.paused = true
+	exec.reason = reason
+	if exec.waveExecID != "" {
+		c.parallel.Pause(exec.waveExecID, reason)
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Paused(executionID string) (bool, string) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	exec, exist := c.executions[executionID]
+	if !exist {
+		return false, ""
+	}
+	return exec.paused, exec.reason
+}
+
+// Progress returns the number of the last started wave and the size of the next one
+func (c *CanaryOrchestrationStrategy) Progress(executionID string) orchestration.ExecutionProgress {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	exec, exist := c.executions[executionID]
+	if !exist {
+		return orchestration.ExecutionProgress{}
+	}
+	return orchestration.ExecutionProgress{Wave: exec.wave, NextWaveSize: exec.nextSize}
+}
+
+func (c *CanaryOrchestrationStrategy) Resume(executionID string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec, exist := c.executions[executionID]
+	if !exist || !exec.paused {
+		return
+	}
+	c.log.Infof("Resuming strategy execution %s", executionID)
+	exec.paused = false
+	exec.reason = ""
+	c.parallel.Resume(exec.waveExecID)
+	select {
+	case exec.resume <- struct{}{}:
+	default:
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Wait(executionID string) {
+	c.mux.RLock()
+	exec := c.executions[executionID]
+	c.mux.RUnlock()
+	if exec != nil {
+		<-exec.done
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Cancel(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Cancelling strategy execution %s", executionID)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	exec := c.executions[executionID]
+	if exec == nil || exec.canceled {
+		return
+	}
+	exec.canceled = true
+	close(exec.cancel)
+	c.parallel.Cancel(exec.waveExecID)
+}
+
+// CanarySoakTime parses the soak time of the canary strategy, the empty value means no soak time
+func CanarySoakTime(spec orchestration.CanaryStrategySpec) (time.Duration, error) {
+	if spec.SoakTime == "" {
+		return 0, nil
+	}
+	soakTime, err := time.ParseDuration(spec.SoakTime)
+	if err != nil {
+		return 0, fmt.Errorf("while parsing soak time %q: %w", spec.SoakTime, err)
+	}
+	if soakTime < 0 {
+		return 0, fmt.Errorf("soak time %q must not be negative", spec.SoakTime)
+	}
+	return soakTime, nil
+}
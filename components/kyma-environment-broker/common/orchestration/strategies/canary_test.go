@@ -0,0 +1,245 @@
+package strategies
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type stateExecutor struct {
+	mux      sync.Mutex
+	failing  map[string]bool
+	executed []string
+	states   map[string]string
+}
+
+func newStateExecutor(failing ...string) *stateExecutor {
+	e := &stateExecutor{failing: map[string]bool{}, states: map[string]string{}}
+	for _, id := range failing {
+		e.failing[id] = true
+	}
+	return e
+}
+
+func (e *stateExecutor) Execute(opID string) (time.Duration, error) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.executed = append(e.executed, opID)
+	e.states[opID] = orchestration.Succeeded
+	if e.failing[opID] {
+		e.states[opID] = orchestration.Failed
+	}
+	return 0, nil
+}
+
+func (e *stateExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (e *stateExecutor) GetOperationState(opID string) (string, error) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	state, found := e.states[opID]
+	if !found {
+		return "", fmt.Errorf("operation %s not found", opID)
+	}
+	return state, nil
+}
+
+func (e *stateExecutor) executedOperations() []string {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return append([]string{}, e.executed...)
+}
+
+func TestCanaryOrchestrationStrategy_ExecutesWaves(t *testing.T) {
+	// given
+	executor := newStateExecutor()
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := fixCanaryOperations(7)
+
+	// when
+	id, err := s.Execute(ops, fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 1, GrowthFactor: 2}))
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	executed := executor.executedOperations()
+	require.Len(t, executed, 7)
+	assert.Equal(t, "op-0", executed[0])
+	assert.ElementsMatch(t, []string{"op-1", "op-2"}, executed[1:3])
+	assert.ElementsMatch(t, []string{"op-3", "op-4", "op-5", "op-6"}, executed[3:])
+}
+
+func TestCanaryOrchestrationStrategy_PausesOnFailedWave(t *testing.T) {
+	// given
+	executor := newStateExecutor("op-0")
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := fixCanaryOperations(3)
+
+	// when
+	id, err := s.Execute(ops, fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 1, SuccessRatio: 1}))
+	require.NoError(t, err)
+
+	// then
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		paused, _ := s.Paused(id)
+		return paused, nil
+	})
+	require.NoError(t, err)
+	_, reason := s.Paused(id)
+	assert.Contains(t, reason, "wave 1 breached the failure threshold")
+	assert.Equal(t, []string{"op-0"}, executor.executedOperations())
+
+	// when
+	s.Resume(id)
+
+	// then
+	s.Wait(id)
+	paused, _ := s.Paused(id)
+	assert.False(t, paused)
+	assert.Len(t, executor.executedOperations(), 3)
+}
+
+func TestCanaryOrchestrationStrategy_CancelPausedExecution(t *testing.T) {
+	// given
+	executor := newStateExecutor("op-0")
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := fixCanaryOperations(3)
+
+	id, err := s.Execute(ops, fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 1}))
+	require.NoError(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		paused, _ := s.Paused(id)
+		return paused, nil
+	})
+	require.NoError(t, err)
+
+	// when
+	s.Cancel(id)
+
+	// then
+	s.Wait(id)
+	assert.Equal(t, []string{"op-0"}, executor.executedOperations())
+	assert.Error(t, s.Insert(id, fixCanaryOperations(1), orchestration.StrategySpec{}))
+}
+
+func TestCanaryOrchestrationStrategy_PauseAndResume(t *testing.T) {
+	// given
+	executor := newStateExecutor()
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	spec := fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 1})
+	spec.ScheduleTime = time.Now().Add(200 * time.Millisecond)
+
+	id, err := s.Execute(fixCanaryOperations(3), spec)
+	require.NoError(t, err)
+
+	// when
+	s.Pause(id, "too many failures")
+
+	// then
+	time.Sleep(500 * time.Millisecond)
+	paused, reason := s.Paused(id)
+	assert.True(t, paused)
+	assert.Equal(t, "too many failures", reason)
+	assert.Empty(t, executor.executedOperations())
+
+	// when
+	s.Resume(id)
+
+	// then
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 3)
+}
+
+func TestCanaryOrchestrationStrategy_AcceptsPartiallyFailedWave(t *testing.T) {
+	// given
+	executor := newStateExecutor("op-1")
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := fixCanaryOperations(5)
+
+	// when
+	id, err := s.Execute(ops, fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 2, GrowthFactor: 2, SuccessRatio: 0.5, SoakTime: "10ms"}))
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 5)
+}
+
+func TestCanaryOrchestrationStrategy_ExecuteFromProgress(t *testing.T) {
+	// given
+	executor := newStateExecutor("op-3")
+	s := NewCanaryOrchestrationStrategy(executor, executor, logrus.New(), 0)
+	ops := fixCanaryOperations(6)
+
+	// when
+	id, err := s.ExecuteFrom(ops, fixCanaryStrategySpec(orchestration.CanaryStrategySpec{CanarySize: 1, GrowthFactor: 2}),
+		orchestration.ExecutionProgress{Wave: 2, NextWaveSize: 4})
+
+	// then
+	require.NoError(t, err)
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		paused, _ := s.Paused(id)
+		return paused, nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"op-0", "op-1", "op-2", "op-3"}, executor.executedOperations())
+	assert.Equal(t, orchestration.ExecutionProgress{Wave: 3, NextWaveSize: 8}, s.Progress(id))
+
+	s.Cancel(id)
+	s.Wait(id)
+}
+
+func TestCanarySoakTime(t *testing.T) {
+	for name, tc := range map[string]struct {
+		soakTime string
+		expected time.Duration
+		wantErr  bool
+	}{
+		"empty":    {soakTime: "", expected: 0},
+		"valid":    {soakTime: "30m", expected: 30 * time.Minute},
+		"invalid":  {soakTime: "half an hour", wantErr: true},
+		"negative": {soakTime: "-1m", wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			soakTime, err := CanarySoakTime(orchestration.CanaryStrategySpec{SoakTime: tc.soakTime})
+
+			// then
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, soakTime)
+		})
+	}
+}
+
+func fixCanaryOperations(n int) []orchestration.RuntimeOperation {
+	ops := make([]orchestration.RuntimeOperation, n)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{ID: fmt.Sprintf("op-%d", i)}
+	}
+	return ops
+}
+
+func fixCanaryStrategySpec(canary orchestration.CanaryStrategySpec) orchestration.StrategySpec {
+	return orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+		Canary:   canary,
+	}
+}
@@ -21,12 +21,18 @@ type ParallelOrchestrationStrategy struct {
 	log             logrus.FieldLogger
 	rescheduleDelay time.Duration
 	scheduleNum     map[string]int
+	pauses          map[string]*executionPause
 	speedFactor     int
 }
 
+type executionPause struct {
+	reason  string
+	resumed chan struct{}
+}
+
 // NewParallelOrchestrationStrategy returns a new parallel orchestration strategy, which
 // executes operations in parallel using a pool of workers and a delaying queue to support time-based scheduling.
-func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.PausableStrategy {
 	strategy := &ParallelOrchestrationStrategy{
 		executor:        executor,
 		dq:              map[string]workqueue.DelayingInterface{},
@@ -35,6 +41,7 @@ func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor,
 		log:             log,
 		rescheduleDelay: rescheduleDelay,
 		scheduleNum:     map[string]int{},
+		pauses:          map[string]*executionPause{},
 		speedFactor:     1,
 	}
 
@@ -144,7 +151,12 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 		}
 
 		log := p.log.WithField("operationID", op.ID)
-		if duration <= 0 {
+		if duration <= 0 && p.waitWhilePaused(execID) {
+			// the maintenance window could pass during the pause, check it again
+			log.Infof("execution was resumed, rescheduling operation")
+			dq.Add(item)
+			dq.Done(item)
+		} else if duration <= 0 {
 			log.Infof("operation is scheduled now")
 
 			pq.Add(item)
@@ -162,6 +174,20 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 	}
 }
 
+// waitWhilePaused blocks until the paused execution is resumed or canceled, returns false if the execution was not paused
+func (p *ParallelOrchestrationStrategy) waitWhilePaused(execID string) bool {
+	p.mux.RLock()
+	pause := p.pauses[execID]
+	p.mux.RUnlock()
+	if pause == nil {
+		return false
+	}
+
+	p.log.Infof("execution %s is paused, waiting for resume", execID)
+	<-pause.resumed
+	return true
+}
+
 func (p *ParallelOrchestrationStrategy) processOperation(execID string) {
 	exit := false
 
@@ -263,6 +289,51 @@ func (p *ParallelOrchestrationStrategy) Cancel(executionID string) {
 	if pq != nil {
 		pq.ShutDown()
 	}
+
+	// release the workers waiting for resume, the queues are shutdown so they exit
+	p.releasePause(executionID)
+}
+
+// Pause stops scheduling new operations of the execution, the operations in progress are finished
+func (p *ParallelOrchestrationStrategy) Pause(executionID, reason string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if _, exist := p.dq[executionID]; !exist || p.pauses[executionID] != nil {
+		return
+	}
+	p.log.Infof("Pausing strategy execution %s: %s", executionID, reason)
+	p.pauses[executionID] = &executionPause{reason: reason, resumed: make(chan struct{})}
+}
+
+func (p *ParallelOrchestrationStrategy) Paused(executionID string) (bool, string) {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+
+	pause := p.pauses[executionID]
+	if pause == nil {
+		return false, ""
+	}
+	return true, pause.reason
+}
+
+func (p *ParallelOrchestrationStrategy) Resume(executionID string) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.pauses[executionID] == nil {
+		return
+	}
+	p.log.Infof("Resuming strategy execution %s", executionID)
+	p.releasePause(executionID)
+}
+
+// releasePause unblocks the workers waiting for resume, must be called with the lock held
+func (p *ParallelOrchestrationStrategy) releasePause(executionID string) {
+	if pause := p.pauses[executionID]; pause != nil {
+		close(pause.resumed)
+		delete(p.pauses, executionID)
+	}
 }
 
 func (p *ParallelOrchestrationStrategy) handleRescheduleErrorOperation(execID string, op *orchestration.RuntimeOperation) {
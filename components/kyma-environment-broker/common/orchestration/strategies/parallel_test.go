@@ -106,3 +106,49 @@ func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	assert.NoError(t, err)
 	s.Wait(id)
 }
+
+func TestNewParallelOrchestrationStrategy_PauseAndResume(t *testing.T) {
+	// given
+	executor := newStateExecutor()
+	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	ops := fixCanaryOperations(3)
+
+	id, err := s.Execute(ops, orchestration.StrategySpec{ScheduleTime: time.Now().Add(200 * time.Millisecond), Parallel: orchestration.ParallelStrategySpec{Workers: 2}})
+	assert.NoError(t, err)
+
+	// when
+	s.Pause(id, "too many failures")
+
+	// then
+	time.Sleep(500 * time.Millisecond)
+	paused, reason := s.Paused(id)
+	assert.True(t, paused)
+	assert.Equal(t, "too many failures", reason)
+	assert.Empty(t, executor.executedOperations())
+
+	// when
+	s.Resume(id)
+
+	// then
+	s.Wait(id)
+	paused, _ = s.Paused(id)
+	assert.False(t, paused)
+	assert.Len(t, executor.executedOperations(), 3)
+}
+
+func TestNewParallelOrchestrationStrategy_CancelPaused(t *testing.T) {
+	// given
+	executor := newStateExecutor()
+	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+
+	id, err := s.Execute(fixCanaryOperations(3), orchestration.StrategySpec{Schedule: time.Now().Format(time.RFC3339), Parallel: orchestration.ParallelStrategySpec{Workers: 1}})
+	assert.NoError(t, err)
+	s.Pause(id, "too many failures")
+
+	// when
+	s.Cancel(id)
+
+	// then
+	s.Wait(id)
+	assert.LessOrEqual(t, len(executor.executedOperations()), 1)
+}
@@ -2,28 +2,149 @@ package broker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
+//go:generate mockery --name=BindingCredentialsProvider --output=automock --outpkg=automock --case=underscore
+
+// BindingCredentialsProvider issues and revokes runtime credentials for service bindings
+type BindingCredentialsProvider interface {
+	CreateBindingKubeconfig(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int64) (string, time.Time, error)
+	RevokeBindingKubeconfig(ctx context.Context, instance *internal.Instance, bindingID string) error
+}
+
+type BindingParams struct {
+	ExpirationSeconds int64 `json:"expiration_seconds,omitempty"`
+}
+
 type BindEndpoint struct {
+	config      BindingConfig
+	instances   storage.Instances
+	bindings    storage.Bindings
+	credentials BindingCredentialsProvider
+
 	log logrus.FieldLogger
 }
 
-func NewBind(log logrus.FieldLogger) *BindEndpoint {
-	return &BindEndpoint{log: log.WithField("service", "BindEndpoint")}
+func NewBind(cfg BindingConfig, instances storage.Instances, bindings storage.Bindings, credentials BindingCredentialsProvider, log logrus.FieldLogger) *BindEndpoint {
+	return &BindEndpoint{
+		config:      cfg,
+		instances:   instances,
+		bindings:    bindings,
+		credentials: credentials,
+		log:         log.WithField("service", "BindEndpoint"),
+	}
 }
 
 // Bind creates a new service binding
 //
 //	PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, details domain.BindDetails, asyncAllowed bool) (domain.Binding, error) {
-	b.log.Infof("Bind instanceID:", instanceID)
-	b.log.Infof("Bind parameters: %s", string(details.RawParameters))
-	b.log.Infof("Bind context: %s", string(details.RawContext))
-	b.log.Infof("Bind asyncAllowed:", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Bind called, asyncAllowed: %v", asyncAllowed)
+
+	if !b.config.Enabled {
+		return domain.Binding{}, fmt.Errorf("not supported")
+	}
+
+	params, err := b.parseParameters(details.RawParameters)
+	if err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+
+	instance, err := b.instances.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.Binding{}, apiresponses.ErrInstanceDoesNotExist
+	case err != nil:
+		return domain.Binding{}, fmt.Errorf("while getting instance %s: %w", instanceID, err)
+	}
+	if instance.RuntimeID == "" || !instance.DeletedAt.IsZero() {
+		err := fmt.Errorf("runtime for instance %s is not available", instanceID)
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+
+	existing, err := b.bindings.Get(instanceID, bindingID)
+	switch {
+	case err == nil:
+		if existing.ExpirationSeconds != params.ExpirationSeconds {
+			return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+		}
+		if existing.IsExpired() {
+			err := fmt.Errorf("binding %s expired at %s, unbind it before creating it again", bindingID, existing.ExpiresAt.Format(time.RFC3339))
+			return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusConflict, err.Error())
+		}
+		logger.Infof("binding already exists")
+		return domain.Binding{
+			AlreadyExists: true,
+			Credentials:   bindingCredentials(existing),
+		}, nil
+	case !dberr.IsNotFound(err):
+		return domain.Binding{}, fmt.Errorf("while getting binding %s: %w", bindingID, err)
+	}
+
+	kubeconfig, expiresAt, err := b.credentials.CreateBindingKubeconfig(ctx, instance, bindingID, params.ExpirationSeconds)
+	if err != nil {
+		logger.Errorf("unable to create binding credentials: %s", err)
+		return domain.Binding{}, fmt.Errorf("while creating binding credentials: %w", err)
+	}
+
+	now := time.Now()
+	binding := &internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		ExpiresAt:         expiresAt,
+		Kubeconfig:        kubeconfig,
+		ExpirationSeconds: params.ExpirationSeconds,
+	}
+	if err := b.bindings.Insert(binding); err != nil {
+		logger.Errorf("unable to store binding: %s", err)
+		if revokeErr := b.credentials.RevokeBindingKubeconfig(ctx, instance, bindingID); revokeErr != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", revokeErr)
+		}
+		return domain.Binding{}, fmt.Errorf("while storing binding %s: %w", bindingID, err)
+	}
+	logger.Infof("binding created, expires at %s", expiresAt.Format(time.RFC3339))
+
+	return domain.Binding{
+		Credentials: bindingCredentials(binding),
+	}, nil
+}
+
+func (b *BindEndpoint) parseParameters(raw json.RawMessage) (BindingParams, error) {
+	params := BindingParams{}
+	if len(raw) != 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return BindingParams{}, fmt.Errorf("while unmarshalling binding parameters: %w", err)
+		}
+	}
+
+	if params.ExpirationSeconds == 0 {
+		params.ExpirationSeconds = b.config.ExpirationSeconds
+	}
+	if params.ExpirationSeconds < b.config.MinExpirationSeconds || params.ExpirationSeconds > b.config.MaxExpirationSeconds {
+		return BindingParams{}, fmt.Errorf("expiration_seconds must be between %d and %d", b.config.MinExpirationSeconds, b.config.MaxExpirationSeconds)
+	}
+
+	return params, nil
+}
 
-	return domain.Binding{}, fmt.Errorf("not supported")
+func bindingCredentials(binding *internal.Binding) map[string]interface{} {
+	return map[string]interface{}{
+		"kubeconfig": binding.Kubeconfig,
+		"expires_at": binding.ExpiresAt.Format(time.RFC3339),
+	}
 }
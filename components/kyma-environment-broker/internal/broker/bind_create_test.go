@@ -0,0 +1,229 @@
+package broker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bindingID         = "b5a2f5e4-2d1b-4a0f-8d8c-3d3b0f0b7a11"
+	bindingKubeconfig = "apiVersion: v1\nkind: Config"
+)
+
+func TestBindEndpoint_Bind(t *testing.T) {
+	t.Run("should create binding with default expiration", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, st.Instances().Insert(instance))
+
+		expiresAt := time.Now().Add(10 * time.Minute)
+		credentials := automock.NewBindingCredentialsProvider(t)
+		credentials.On("CreateBindingKubeconfig", mock.Anything, mock.Anything, bindingID, int64(600)).Return(bindingKubeconfig, expiresAt, nil).Once()
+
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), credentials, logrus.New())
+
+		// when
+		binding, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, binding.AlreadyExists)
+		assert.Equal(t, bindingKubeconfig, binding.Credentials.(map[string]interface{})["kubeconfig"])
+
+		stored, err := st.Bindings().Get(instanceID, bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, bindingKubeconfig, stored.Kubeconfig)
+		assert.Equal(t, int64(600), stored.ExpirationSeconds)
+		assert.True(t, expiresAt.Equal(stored.ExpiresAt))
+	})
+
+	t.Run("should create binding with expiration from parameters", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, st.Instances().Insert(instance))
+
+		credentials := automock.NewBindingCredentialsProvider(t)
+		credentials.On("CreateBindingKubeconfig", mock.Anything, mock.Anything, bindingID, int64(3600)).Return(bindingKubeconfig, time.Now().Add(time.Hour), nil).Once()
+
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), credentials, logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 3600}`),
+		}, false)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should return existing binding", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, st.Instances().Insert(instance))
+
+		credentials := automock.NewBindingCredentialsProvider(t)
+		credentials.On("CreateBindingKubeconfig", mock.Anything, mock.Anything, bindingID, int64(600)).Return(bindingKubeconfig, time.Now().Add(10*time.Minute), nil).Once()
+
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), credentials, logrus.New())
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+		require.NoError(t, err)
+
+		// when
+		binding, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.AlreadyExists)
+
+		// when
+		_, err = svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"expiration_seconds": 3600}`),
+		}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingAlreadyExists, err)
+	})
+
+	t.Run("should reject existing binding which has expired", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, st.Instances().Insert(instance))
+		require.NoError(t, st.Bindings().Insert(&internal.Binding{
+			ID:                bindingID,
+			InstanceID:        instanceID,
+			CreatedAt:         time.Now().Add(-20 * time.Minute),
+			ExpiresAt:         time.Now().Add(-10 * time.Minute),
+			Kubeconfig:        bindingKubeconfig,
+			ExpirationSeconds: 600,
+		}))
+
+		credentials := automock.NewBindingCredentialsProvider(t)
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), credentials, logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, apiErr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "expired")
+	})
+
+	t.Run("should reject expiration out of range", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), automock.NewBindingCredentialsProvider(t), logrus.New())
+
+		for _, expiration := range []int{60, 7201} {
+			// when
+			_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+				RawParameters: json.RawMessage(fmt.Sprintf(`{"expiration_seconds": %d}`, expiration)),
+			}, false)
+
+			// then
+			require.Error(t, err)
+			apierr := err.(*apiresponses.FailureResponse)
+			assert.Equal(t, http.StatusBadRequest, apierr.ValidatedStatusCode(nil))
+		}
+	})
+
+	t.Run("should return error when instance does not exist", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Bindings(), automock.NewBindingCredentialsProvider(t), logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+	})
+
+	t.Run("should return error when bindings are disabled", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewBind(broker.BindingConfig{}, st.Instances(), st.Bindings(), automock.NewBindingCredentialsProvider(t), logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		assert.EqualError(t, err, "not supported")
+	})
+}
+
+func TestBindEndpoint_GetAndUnbind(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	instance := fixture.FixInstance(instanceID)
+	require.NoError(t, st.Instances().Insert(instance))
+
+	credentials := automock.NewBindingCredentialsProvider(t)
+	credentials.On("CreateBindingKubeconfig", mock.Anything, mock.Anything, bindingID, int64(600)).Return(bindingKubeconfig, time.Now().Add(10*time.Minute), nil).Once()
+	credentials.On("RevokeBindingKubeconfig", mock.Anything, mock.Anything, bindingID).Return(nil).Once()
+
+	cfg := fixBindingConfig()
+	bindSvc := broker.NewBind(cfg, st.Instances(), st.Bindings(), credentials, logrus.New())
+	getSvc := broker.NewGetBinding(cfg, st.Bindings(), logrus.New())
+	lastOpSvc := broker.NewLastBindingOperation(cfg, st.Bindings(), logrus.New())
+	unbindSvc := broker.NewUnbind(cfg, st.Instances(), st.Bindings(), credentials, logrus.New())
+
+	_, err := bindSvc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+	require.NoError(t, err)
+
+	// when
+	spec, err := getSvc.GetBinding(context.Background(), instanceID, bindingID, domain.FetchBindingDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, bindingKubeconfig, spec.Credentials.(map[string]interface{})["kubeconfig"])
+	assert.Equal(t, broker.BindingParams{ExpirationSeconds: 600}, spec.Parameters)
+
+	// when
+	lastOp, err := lastOpSvc.LastBindingOperation(context.Background(), instanceID, bindingID, domain.PollDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, domain.Succeeded, lastOp.State)
+
+	// when
+	_, err = unbindSvc.Unbind(context.Background(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+	// then
+	require.NoError(t, err)
+	_, err = getSvc.GetBinding(context.Background(), instanceID, bindingID, domain.FetchBindingDetails{})
+	assert.Equal(t, apiresponses.ErrBindingNotFound, err)
+	_, err = unbindSvc.Unbind(context.Background(), instanceID, bindingID, domain.UnbindDetails{}, false)
+	assert.Equal(t, apiresponses.ErrBindingDoesNotExist, err)
+}
+
+func fixBindingConfig() broker.BindingConfig {
+	return broker.BindingConfig{
+		Enabled:              true,
+		ExpirationSeconds:    600,
+		MinExpirationSeconds: 600,
+		MaxExpirationSeconds: 7200,
+	}
+}
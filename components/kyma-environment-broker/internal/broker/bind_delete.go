@@ -4,25 +4,71 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type UnbindEndpoint struct {
+	config      BindingConfig
+	instances   storage.Instances
+	bindings    storage.Bindings
+	credentials BindingCredentialsProvider
+
 	log logrus.FieldLogger
 }
 
-func NewUnbind(log logrus.FieldLogger) *UnbindEndpoint {
-	return &UnbindEndpoint{log: log.WithField("service", "UnbindEndpoint")}
+func NewUnbind(cfg BindingConfig, instances storage.Instances, bindings storage.Bindings, credentials BindingCredentialsProvider, log logrus.FieldLogger) *UnbindEndpoint {
+	return &UnbindEndpoint{
+		config:      cfg,
+		instances:   instances,
+		bindings:    bindings,
+		credentials: credentials,
+		log:         log.WithField("service", "UnbindEndpoint"),
+	}
 }
 
 // Unbind deletes an existing service binding
 //
 //	DELETE /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *UnbindEndpoint) Unbind(ctx context.Context, instanceID, bindingID string, details domain.UnbindDetails, asyncAllowed bool) (domain.UnbindSpec, error) {
-	b.log.Infof("Unbind instanceID: %s", instanceID)
-	b.log.Infof("Unbind details: %+v", details)
-	b.log.Infof("Unbind asyncAllowed: %v", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Unbind called, asyncAllowed: %v", asyncAllowed)
+
+	if !b.config.Enabled {
+		return domain.UnbindSpec{}, fmt.Errorf("not supported")
+	}
+
+	_, err := b.bindings.Get(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	case err != nil:
+		return domain.UnbindSpec{}, fmt.Errorf("while getting binding %s: %w", bindingID, err)
+	}
+
+	instance, err := b.instances.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		logger.Infof("instance does not exist, skipping credentials revocation")
+	case err != nil:
+		return domain.UnbindSpec{}, fmt.Errorf("while getting instance %s: %w", instanceID, err)
+	case instance.RuntimeID == "":
+		logger.Infof("runtime does not exist, skipping credentials revocation")
+	default:
+		if err := b.credentials.RevokeBindingKubeconfig(ctx, instance, bindingID); err != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", err)
+			return domain.UnbindSpec{}, fmt.Errorf("while revoking binding credentials: %w", err)
+		}
+	}
+
+	if err := b.bindings.Delete(instanceID, bindingID); err != nil {
+		return domain.UnbindSpec{}, fmt.Errorf("while deleting binding %s: %w", bindingID, err)
+	}
+	logger.Infof("binding deleted")
 
-	return domain.UnbindSpec{}, fmt.Errorf("not supported")
+	return domain.UnbindSpec{}, nil
 }
@@ -4,16 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type GetBindingEndpoint struct {
+	config   BindingConfig
+	bindings storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewGetBinding(log logrus.FieldLogger) *GetBindingEndpoint {
-	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint")}
+func NewGetBinding(cfg BindingConfig, bindings storage.Bindings, log logrus.FieldLogger) *GetBindingEndpoint {
+	return &GetBindingEndpoint{
+		config:   cfg,
+		bindings: bindings,
+		log:      log.WithField("service", "GetBindingEndpoint"),
+	}
 }
 
 // GetBinding fetches an existing service binding
@@ -23,5 +34,25 @@ func (b *GetBindingEndpoint) GetBinding(_ context.Context, instanceID, bindingID
 	b.log.Infof("GetBinding instanceID: %s", instanceID)
 	b.log.Infof("GetBinding bindingID: %s", bindingID)
 
-	return domain.GetBindingSpec{}, fmt.Errorf("not supported")
+	if !b.config.Enabled {
+		return domain.GetBindingSpec{}, fmt.Errorf("not supported")
+	}
+
+	binding, err := b.bindings.Get(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	case err != nil:
+		return domain.GetBindingSpec{}, fmt.Errorf("while getting binding %s: %w", bindingID, err)
+	}
+	if binding.IsExpired() {
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	}
+
+	return domain.GetBindingSpec{
+		Credentials: bindingCredentials(binding),
+		Parameters: BindingParams{
+			ExpirationSeconds: binding.ExpirationSeconds,
+		},
+	}, nil
 }
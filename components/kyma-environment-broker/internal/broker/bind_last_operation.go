@@ -4,25 +4,53 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type LastBindingOperationEndpoint struct {
+	config   BindingConfig
+	bindings storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewLastBindingOperation(log logrus.FieldLogger) *LastBindingOperationEndpoint {
-	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint")}
+func NewLastBindingOperation(cfg BindingConfig, bindings storage.Bindings, log logrus.FieldLogger) *LastBindingOperationEndpoint {
+	return &LastBindingOperationEndpoint{
+		config:   cfg,
+		bindings: bindings,
+		log:      log.WithField("service", "LastBindingOperationEndpoint"),
+	}
 }
 
 // LastBindingOperation fetches last operation state for a service binding
 //
 //	GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}/last_operation
+//
+// Bindings are created synchronously, so an existing binding always reports a succeeded operation.
 func (b *LastBindingOperationEndpoint) LastBindingOperation(ctx context.Context, instanceID, bindingID string, details domain.PollDetails) (domain.LastOperation, error) {
 	b.log.Infof("LastBindingOperation instanceID: %s", instanceID)
 	b.log.Infof("LastBindingOperation bindingID: %s", bindingID)
 	b.log.Infof("LastBindingOperation details: %+v", details)
 
-	return domain.LastOperation{}, fmt.Errorf("not supported")
+	if !b.config.Enabled {
+		return domain.LastOperation{}, fmt.Errorf("not supported")
+	}
+
+	_, err := b.bindings.Get(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.LastOperation{}, apiresponses.ErrBindingDoesNotExist
+	case err != nil:
+		return domain.LastOperation{}, fmt.Errorf("while getting binding %s: %w", bindingID, err)
+	}
+
+	return domain.LastOperation{
+		State:       domain.Succeeded,
+		Description: "binding created",
+	}, nil
 }
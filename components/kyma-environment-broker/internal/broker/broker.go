@@ -41,6 +41,17 @@ type Config struct {
 	ShowTrialExpirationInfo                 bool   `envconfig:"default=false"`
 	SubaccountsIdsToShowTrialExpirationInfo string `envconfig:"default="`
 	TrialDocsURL                            string `envconfig:"default="`
+
+	Binding BindingConfig
+}
+
+// BindingConfig represents configuration for service bindings
+type BindingConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// ExpirationSeconds is used when the expiration_seconds parameter is not provided
+	ExpirationSeconds    int64 `envconfig:"default=600"`
+	MinExpirationSeconds int64 `envconfig:"default=600"`
+	MaxExpirationSeconds int64 `envconfig:"default=7200"`
 }
 
 type ServicesConfig map[string]Service
@@ -65,6 +76,9 @@ func (s ServicesConfig) DefaultPlansConfig() (PlansConfig, error) {
 	if !ok {
 		return nil, fmt.Errorf("while getting data about %s plans", KymaServiceName)
 	}
+	if err := ValidatePlanUpdatableTo(cfg.Plans); err != nil {
+		return nil, fmt.Errorf("while validating %s plans: %w", KymaServiceName, err)
+	}
 	return cfg.Plans, nil
 }
 
@@ -88,6 +102,8 @@ type PlansConfig map[string]PlanData
 type PlanData struct {
 	Description string       `yaml:"description"`
 	Metadata    PlanMetadata `yaml:"metadata"`
+	// PlanUpdatableTo lists the names of the plans the instances of the plan can be moved to with an update
+	PlanUpdatableTo []string `yaml:"planUpdatableTo"`
 }
 type PlanMetadata struct {
 	DisplayName string `yaml:"displayName"`
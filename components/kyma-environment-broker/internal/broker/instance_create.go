@@ -18,9 +18,11 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
@@ -260,6 +262,23 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if parameters.Networking != nil {
+		if !IsNetworkingSupported(details.PlanID) {
+			err := fmt.Errorf("networking parameters are not supported in plan %s", PlanNamesMapping[details.PlanID])
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+		if err := parameters.Networking.Validate(); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+		if err := validateZonesSplit(details.PlanID, parameters.Networking.NodesCidr, parameters.Zones, defaults); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+		}
+	}
+	if parameters.APIServerAllowedCIDRs != nil {
+		if err := validateAPIServerAllowedCIDRs(details.PlanID, parameters.APIServerAllowedCIDRs); err != nil {
+			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
 
 	planValidator, err := b.validator(&details, provider, ctx)
 	if err != nil {
@@ -427,3 +446,52 @@ func (b *ProvisionEndpoint) createDashboardURL(planID, instanceID string) string
 		return fmt.Sprintf("%s/?kubeconfigID=%s", b.dashboardConfig.LandscapeURL, instanceID)
 	}
 }
+
+// validateZonesSplit checks that the nodes range can be split between the zones of the cluster the same way as the
+// provisioning does, the zones are taken from the parameters or from the plan defaults. GCP subnets are regional,
+// so the nodes range is not split there.
+func validateZonesSplit(planID, nodes string, zones []string, defaults *gqlschema.ClusterConfigInput) error {
+	zonesCount := len(zones)
+	if zonesCount == 0 && defaults != nil && defaults.GardenerConfig != nil && defaults.GardenerConfig.ProviderSpecificConfig != nil {
+		providerConfig := defaults.GardenerConfig.ProviderSpecificConfig
+		if providerConfig.AwsConfig != nil {
+			zonesCount = len(providerConfig.AwsConfig.AwsZones)
+		}
+		if providerConfig.AzureConfig != nil {
+			zonesCount = len(providerConfig.AzureConfig.AzureZones)
+		}
+	}
+
+	var split func(index int) error
+	switch provider, _ := PlanProvider(planID); provider {
+	case internal.AWS:
+		split = func(index int) error {
+			_, _, _, err := networking.AWSZoneSubnets(nodes, index)
+			return err
+		}
+	case internal.Azure:
+		split = func(index int) error {
+			_, err := networking.AzureZoneSubnet(nodes, index)
+			return err
+		}
+	default:
+		return nil
+	}
+
+	for i := 0; i < zonesCount; i++ {
+		if err := split(i); err != nil {
+			return fmt.Errorf("nodes range %s cannot be split between %d zones", nodes, zonesCount)
+		}
+	}
+	return nil
+}
+
+func validateAPIServerAllowedCIDRs(planID string, cidrs []string) error {
+	if IsOwnClusterPlan(planID) {
+		return fmt.Errorf("apiServerAllowedCIDRs parameter is not supported in plan %s", PlanNamesMapping[planID])
+	}
+	if err := networking.ValidateAllowedCIDRs(cidrs); err != nil {
+		return fmt.Errorf("invalid apiServerAllowedCIDRs parameter: %w", err)
+	}
+	return nil
+}
@@ -18,10 +18,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type ContextUpdateHandler interface {
@@ -107,7 +109,14 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	logger.Infof("Received context: %s", marshallRawContext(hideSensitiveDataFromRawContext(details.RawContext)))
 
 	// validation of incoming input
-	if err := b.validateWithJsonSchemaValidator(details, instance); err != nil {
+	planID := instance.ServicePlanID
+	if isPlanChange(instance, details) {
+		if err := b.validatePlanMigration(instance, details.PlanID); err != nil {
+			return domain.UpdateServiceSpec{}, err
+		}
+		planID = details.PlanID
+	}
+	if err := b.validateWithJsonSchemaValidator(details, planID, instance); err != nil {
 		return domain.UpdateServiceSpec{}, err
 	}
 
@@ -169,9 +178,9 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}, nil
 }
 
-func (b *UpdateEndpoint) validateWithJsonSchemaValidator(details domain.UpdateDetails, instance *internal.Instance) error {
+func (b *UpdateEndpoint) validateWithJsonSchemaValidator(details domain.UpdateDetails, planID string, instance *internal.Instance) error {
 	if len(details.RawParameters) > 0 {
-		planValidator, err := b.getJsonSchemaValidator(instance.Provider, instance.ServicePlanID, instance.ProviderRegion)
+		planValidator, err := b.getJsonSchemaValidator(instance.Provider, planID, instance.ProviderRegion)
 		if err != nil {
 			return fmt.Errorf("while creating plan validator: %w", err)
 		}
@@ -187,12 +196,48 @@ func (b *UpdateEndpoint) validateWithJsonSchemaValidator(details domain.UpdateDe
 }
 
 func shouldUpdate(instance *internal.Instance, details domain.UpdateDetails, ersContext internal.ERSContext) bool {
-	if len(details.RawParameters) != 0 {
+	if len(details.RawParameters) != 0 || isPlanChange(instance, details) {
 		return true
 	}
 	return ersContext.ERSUpdate()
 }
 
+func isPlanChange(instance *internal.Instance, details domain.UpdateDetails) bool {
+	return details.PlanID != "" && details.PlanID != instance.ServicePlanID
+}
+
+// validatePlanMigration checks if the instance can be moved to the target plan, the allowed moves are declared
+// in the catalog with the planUpdatableTo list of the plan
+func (b *UpdateEndpoint) validatePlanMigration(instance *internal.Instance, targetPlanID string) error {
+	targetPlanName, found := PlanNamesMapping[targetPlanID]
+	if !found || !b.isPlanEnabled(targetPlanName) {
+		err := fmt.Errorf("plan ID %q is not available", targetPlanID)
+		return apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+	if instance.IsExpired() {
+		err := fmt.Errorf("an expired instance cannot be moved to plan %s", targetPlanName)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if !IsPlanUpdatable(b.plansConfig, instance.ServicePlanID, targetPlanID) {
+		err := fmt.Errorf("moving the instance from plan %s to plan %s is not supported", PlanNamesMapping[instance.ServicePlanID], targetPlanName)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if provider, found := PlanProvider(targetPlanID); found && provider != instance.Provider {
+		err := fmt.Errorf("plan %s is not available on the %s hyperscaler of the instance", targetPlanName, instance.Provider)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	return nil
+}
+
+func (b *UpdateEndpoint) isPlanEnabled(planName string) bool {
+	for _, name := range b.config.EnablePlans {
+		if name == planName {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, asyncAllowed bool, ersContext internal.ERSContext, logger logrus.FieldLogger) (domain.UpdateServiceSpec, error) {
 	if !shouldUpdate(instance, details, ersContext) {
 		logger.Debugf("Parameters not provided, skipping processing update parameters")
@@ -225,12 +270,16 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if params.APIServerAllowedCIDRs != nil {
+		if err := validateAPIServerAllowedCIDRs(instance.ServicePlanID, *params.APIServerAllowedCIDRs); err != nil {
+			logger.Errorf("invalid API server allowed CIDRs: %s", err.Error())
+			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
 
 	operationID := uuid.New().String()
 	logger = logger.WithField("operationID", operationID)
 
-	logger.Debugf("creating update operation %v", params)
-	operation := internal.NewUpdateOperation(operationID, instance, params)
 	planID := instance.Parameters.PlanID
 	if len(details.PlanID) != 0 {
 		planID = details.PlanID
@@ -240,6 +289,19 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 		logger.Errorf("unable to obtain plan defaults: %s", err.Error())
 		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to obtain plan defaults")
 	}
+	planChange := isPlanChange(instance, details)
+	if planChange && defaults.GardenerConfig != nil {
+		// the cluster gets the machine type and the autoscaler settings of the new plan, unless they are provided
+		applyPlanDefaults(&params, instance.Parameters.Parameters, planMachineTypes(details.PlanID, instance.Provider), defaults.GardenerConfig)
+	}
+
+	logger.Debugf("creating update operation %v", params)
+	operation := internal.NewUpdateOperation(operationID, instance, params)
+	if planChange {
+		logger.Infof("Moving the instance from plan %s to plan %s", PlanNamesMapping[instance.ServicePlanID], PlanNamesMapping[details.PlanID])
+		operation.PlanMigration = &internal.PlanMigration{FromPlanID: instance.ServicePlanID, ToPlanID: details.PlanID}
+		operation.ProvisioningParameters.PlanID = details.PlanID
+	}
 	var autoscalerMin, autoscalerMax int
 	if defaults.GardenerConfig != nil {
 		p := defaults.GardenerConfig
@@ -273,6 +335,10 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.MachineType != nil && *params.MachineType != "" {
 		instance.Parameters.Parameters.MachineType = params.MachineType
 	}
+	if params.APIServerAllowedCIDRs != nil {
+		instance.Parameters.Parameters.APIServerAllowedCIDRs = *params.APIServerAllowedCIDRs
+		updateStorage = append(updateStorage, "API server allowed CIDRs")
+	}
 	if len(updateStorage) > 0 {
 		if err := wait.Poll(500*time.Millisecond, 2*time.Second, func() (bool, error) {
 			instance, err = b.instanceStorage.Update(*instance)
@@ -300,6 +366,40 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	}, nil
 }
 
+// applyPlanDefaults sets the machine type and autoscaler parameters of the new plan which are neither provided
+// in the update nor valid for the new plan
+func applyPlanDefaults(params *internal.UpdatingParametersDTO, current internal.ProvisioningParametersDTO, machineTypes []string, defaults *gqlschema.GardenerConfigInput) {
+	if (params.MachineType == nil || *params.MachineType == "") && (current.MachineType == nil || !contains(machineTypes, *current.MachineType)) {
+		params.MachineType = ptr.String(defaults.MachineType)
+	}
+	if params.AutoScalerMin == nil && (current.AutoScalerMin == nil || *current.AutoScalerMin < defaults.AutoScalerMin || *current.AutoScalerMin > defaults.AutoScalerMax) {
+		params.AutoScalerMin = ptr.Integer(defaults.AutoScalerMin)
+	}
+	if params.AutoScalerMax == nil && (current.AutoScalerMax == nil || *current.AutoScalerMax < defaults.AutoScalerMin || *current.AutoScalerMax > defaults.AutoScalerMax) {
+		params.AutoScalerMax = ptr.Integer(defaults.AutoScalerMax)
+	}
+	if params.MaxSurge == nil {
+		params.MaxSurge = ptr.Integer(defaults.MaxSurge)
+	}
+	if params.MaxUnavailable == nil {
+		params.MaxUnavailable = ptr.Integer(defaults.MaxUnavailable)
+	}
+}
+
+func planMachineTypes(planID string, provider internal.CloudProvider) []string {
+	machineTypes, _ := catalog.Current().MachineTypes(PlanNamesMapping[planID], strings.ToLower(string(provider)), false)
+	return machineTypes
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *UpdateEndpoint) processContext(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, logger logrus.FieldLogger) (*internal.Instance, bool, error) {
 	var ersContext internal.ERSContext
 	err := json.Unmarshal(details.RawContext, &ersContext)
@@ -636,6 +636,55 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 		assert.Equal(t, expectedErr.ValidatedStatusCode(nil), apierr.ValidatedStatusCode(nil))
 		assert.Equal(t, expectedErr.LoggerAction(), apierr.LoggerAction())
 	})
+
+	t.Run("Should fail on invalid apiServerAllowedCIDRs param", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			ServiceID:       "",
+			PlanID:          AzurePlanID,
+			RawParameters:   json.RawMessage(`{"apiServerAllowedCIDRs":["203.0.113.1/24"]}`),
+			PreviousValues:  domain.PreviousValues{},
+			RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			MaintenanceInfo: nil,
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "must be a network address, for example 203.0.113.0/24")
+	})
+
+	t.Run("Should store apiServerAllowedCIDRs param and remove it with the empty list", func(t *testing.T) {
+		for _, tc := range []struct {
+			parameters string
+			expected   []string
+		}{
+			{parameters: `{"apiServerAllowedCIDRs":["203.0.113.0/24"]}`, expected: []string{"203.0.113.0/24"}},
+			{parameters: `{"autoScalerMin":4}`, expected: []string{"203.0.113.0/24"}},
+			{parameters: `{"apiServerAllowedCIDRs":[]}`, expected: []string{}},
+		} {
+			// when
+			response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+				ServiceID:       "",
+				PlanID:          AzurePlanID,
+				RawParameters:   json.RawMessage(tc.parameters),
+				PreviousValues:  domain.PreviousValues{},
+				RawContext:      json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+				MaintenanceInfo: nil,
+			}, true)
+
+			// then
+			require.NoError(t, err)
+			inst, err := st.Instances().GetByID(instanceID)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, inst.Parameters.Parameters.APIServerAllowedCIDRs)
+			op, err := st.Operations().GetOperationByID(response.OperationData)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, op.ProvisioningParameters.Parameters.APIServerAllowedCIDRs)
+		}
+	})
 }
 
 func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
@@ -689,3 +738,126 @@ func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	// check if the API response is correct
 	assert.Regexp(t, `^https:\/\/dashboard\.example\.com\/\?kubeconfigID=`, response.DashboardURL)
 }
+
+func TestUpdateEndpoint_UpdatePlan(t *testing.T) {
+	// given
+	instance := internal.Instance{
+		InstanceID:    instanceID,
+		ServicePlanID: TrialPlanID,
+		Provider:      internal.Azure,
+		Parameters: internal.ProvisioningParameters{
+			PlanID: TrialPlanID,
+			ErsContext: internal.ERSContext{
+				Active: ptr.Bool(true),
+			},
+		},
+	}
+	st := storage.NewMemoryStorage()
+	st.Instances().Insert(instance)
+	st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{
+				MachineType:    "Standard_D4_v3",
+				AutoScalerMin:  3,
+				AutoScalerMax:  20,
+				MaxSurge:       1,
+				MaxUnavailable: 0,
+			},
+		}, nil
+	}
+	plansConfig := PlansConfig{
+		TrialPlanName: PlanData{PlanUpdatableTo: []string{AzurePlanName}},
+	}
+	cfg := Config{EnablePlans: []string{TrialPlanName, AzurePlanName, AWSPlanName, GCPPlanName}}
+	svc := NewUpdate(cfg, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, plansConfig,
+		planDefaults, logrus.New(), dashboardConfig)
+
+	t.Run("Should reject a move which is not declared in the catalog", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     GCPPlanID,
+			RawContext: json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("Should reject an unknown plan", func(t *testing.T) {
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     "unknown-plan-id",
+			RawContext: json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apiErr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, apiErr.ValidatedStatusCode(nil))
+	})
+
+	t.Run("Should move the instance to the new plan", func(t *testing.T) {
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:     AzurePlanID,
+			RawContext: json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+		operation, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		require.NotNil(t, operation.PlanMigration)
+		assert.Equal(t, internal.PlanMigration{FromPlanID: TrialPlanID, ToPlanID: AzurePlanID}, *operation.PlanMigration)
+		assert.Equal(t, AzurePlanID, operation.ProvisioningParameters.PlanID)
+		assert.Equal(t, "Standard_D4_v3", *operation.UpdatingParameters.MachineType)
+		assert.Equal(t, 3, *operation.UpdatingParameters.AutoScalerMin)
+		assert.Equal(t, 20, *operation.UpdatingParameters.AutoScalerMax)
+	})
+}
+
+func TestUpdateEndpoint_UpdatePlanOfAnotherHyperscaler(t *testing.T) {
+	// given
+	instance := internal.Instance{
+		InstanceID:    instanceID,
+		ServicePlanID: TrialPlanID,
+		Provider:      internal.AWS,
+		Parameters: internal.ProvisioningParameters{
+			PlanID: TrialPlanID,
+		},
+	}
+	st := storage.NewMemoryStorage()
+	st.Instances().Insert(instance)
+	st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+
+	q := &automock.Queue{}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	plansConfig := PlansConfig{
+		TrialPlanName: PlanData{PlanUpdatableTo: []string{AzurePlanName}},
+	}
+	cfg := Config{EnablePlans: []string{TrialPlanName, AzurePlanName}}
+	svc := NewUpdate(cfg, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, plansConfig,
+		planDefaults, logrus.New(), dashboardConfig)
+
+	// when
+	_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+		PlanID:     AzurePlanID,
+		RawContext: json.RawMessage("{}"),
+	}, true)
+
+	// then
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "plan azure is not available on the AWS hyperscaler of the instance")
+}
@@ -1,6 +1,7 @@
 package broker
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
@@ -8,6 +9,7 @@ import (
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 )
 
 const (
@@ -76,45 +78,26 @@ type JSONSchemaValidator interface {
 }
 
 func AzureRegions(euRestrictedAccess bool) []string {
-	if euRestrictedAccess {
-		return []string{
-			"switzerlandnorth",
-		}
-	}
-	return []string{
-		"eastus",
-		"centralus",
-		"westus2",
-		"uksouth",
-		"northeurope",
-		"westeurope",
-		"japaneast",
-		"southeastasia",
-	}
+	return catalog.Current().Regions(AzurePlanName, catalog.Azure, euRestrictedAccess)
 }
 
 func GCPRegions() []string {
-	return []string{
-		"europe-west3",
-		"asia-south1",
-		"us-central1"}
+	return catalog.Current().Regions(GCPPlanName, catalog.GCP, false)
 }
 
 func AWSRegions(euRestrictedAccess bool) []string {
-	// be aware of zones defined in internal/provider/aws_provider.go
-	if euRestrictedAccess {
-		return []string{"eu-central-1"}
-	}
-	return []string{"eu-central-1", "eu-west-2", "ca-central-1", "sa-east-1", "us-east-1", "us-west-1",
-		"ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"}
+	// be aware of zones defined in the provider catalog, see internal/catalog
+	return catalog.Current().Regions(AWSPlanName, catalog.AWS, euRestrictedAccess)
 }
 
 func OpenStackRegions() []string {
-	return []string{"eu-de-1", "ap-sa-1"}
+	return catalog.Current().Regions(OpenStackPlanName, catalog.OpenStack, false)
 }
 
 func OpenStackSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, OpenStackRegions(), update)
+	regions := OpenStackRegions()
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.Region.EnumDisplayName = catalog.Current().RegionDisplayNames(catalog.OpenStack, regions)
 	properties.AutoScalerMax.Maximum = 40
 	if !update {
 		properties.AutoScalerMax.Default = 8
@@ -124,34 +107,54 @@ func OpenStackSchema(machineTypesDisplay map[string]string, machineTypes []strin
 }
 
 func GCPSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, GCPRegions(), update)
+	regions := GCPRegions()
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.Region.EnumDisplayName = catalog.Current().RegionDisplayNames(catalog.GCP, regions)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if additionalParams {
+		properties.Networking = NewNetworkingSchema()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AWSSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AWSRegions(euAccessRestricted), update)
+	regions := AWSRegions(euAccessRestricted)
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.Region.EnumDisplayName = catalog.Current().RegionDisplayNames(catalog.AWS, regions)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if additionalParams {
+		properties.Networking = NewNetworkingSchema()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AzureSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
+	regions := AzureRegions(euAccessRestricted)
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.Region.EnumDisplayName = catalog.Current().RegionDisplayNames(catalog.Azure, regions)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if additionalParams {
+		properties.Networking = NewNetworkingSchema()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func AzureLiteSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool, euAccessRestricted bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
+	regions := AzureRegions(euAccessRestricted)
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
+	properties.Region.EnumDisplayName = catalog.Current().RegionDisplayNames(catalog.Azure, regions)
 	properties.AutoScalerMax.Maximum = 40
 
 	if !update {
 		properties.AutoScalerMax.Default = 10
 		properties.AutoScalerMin.Default = 2
 	}
+	if additionalParams {
+		properties.Networking = NewNetworkingSchema()
+	}
 
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
@@ -161,20 +164,17 @@ func FreemiumSchema(provider internal.CloudProvider, additionalParams, update bo
 		return empty()
 	}
 
-	var regions []string
-	switch provider {
-	case internal.AWS:
-		regions = AWSRegions(euAccessRestricted)
-	case internal.Azure:
-		regions = AzureRegions(euAccessRestricted)
-	default:
-		regions = AWSRegions(euAccessRestricted)
+	providerName := catalog.AWS
+	if provider == internal.Azure {
+		providerName = catalog.Azure
 	}
+	regions := catalog.Current().Regions(FreemiumPlanName, providerName, euAccessRestricted)
 	properties := ProvisioningProperties{
 		Name: NameProperty(),
 		Region: &Type{
-			Type: "string",
-			Enum: ToInterfaceSlice(regions),
+			Type:            "string",
+			Enum:            ToInterfaceSlice(regions),
+			EnumDisplayName: catalog.Current().RegionDisplayNames(providerName, regions),
 		},
 	}
 
@@ -259,60 +259,22 @@ func unmarshalSchema(schema *RootSchema) *map[string]interface{} {
 }
 
 // Plans is designed to hold plan defaulting logic
-// keep internal/hyperscaler/azure/config.go in sync with any changes to available zones
+// the regions, zones and machine types come from the provider catalog, see internal/catalog
 func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditionalParamsInSchema bool, euAccessRestricted bool) map[string]domain.ServicePlan {
-	awsMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge", "m6i.xlarge", "m6i.2xlarge", "m6i.4xlarge", "m6i.8xlarge", "m6i.12xlarge"}
-	awsMachinesDisplay := map[string]string{
-		// source: https://aws.amazon.com/ec2/instance-types/m5/
-		"m5.xlarge":   "m5.xlarge (4vCPU, 16GB RAM)",
-		"m5.2xlarge":  "m5.2xlarge (8vCPU, 32GB RAM)",
-		"m5.4xlarge":  "m5.4xlarge (16vCPU, 64GB RAM)",
-		"m5.8xlarge":  "m5.8xlarge (32vCPU, 128GB RAM)",
-		"m5.12xlarge": "m5.12xlarge (48vCPU, 192GB RAM)",
-		// source: https://aws.amazon.com/ec2/instance-types/m6i/
-		"m6i.xlarge":   "m6i.xlarge (4vCPU, 16GB RAM)",
-		"m6i.2xlarge":  "m6i.2xlarge (8vCPU, 32GB RAM)",
-		"m6i.4xlarge":  "m6i.4xlarge (16vCPU, 64GB RAM)",
-		"m6i.8xlarge":  "m6i.8xlarge (32vCPU, 128GB RAM)",
-		"m6i.12xlarge": "m6i.12xlarge (48vCPU, 192GB RAM)",
-	}
+	providerCatalog := catalog.Current()
 
-	// awsHASchema := AWSHASchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, false)
+	awsMachines, awsMachinesDisplay := providerCatalog.MachineTypes(AWSPlanName, catalog.AWS, false)
 
-	// source: https://cloud.google.com/compute/docs/general-purpose-machines#e2_limitations
-	gcpMachines := []string{"n2-standard-4", "n2-standard-8", "n2-standard-16", "n2-standard-32", "n2-standard-48"}
-	gcpMachinesDisplay := map[string]string{
-		"n2-standard-4":  "n2-standard-4 (4vCPU, 16GB RAM)",
-		"n2-standard-8":  "n2-standard-8 (8vCPU, 32GB RAM)",
-		"n2-standard-16": "n2-standard-16 (16vCPU, 64GB RAM)",
-		"n2-standard-32": "n2-standard-32 (32vCPU, 128GB RAM)",
-		"n2-standard-48": "n2-standard-48 (48vCPU, 192B RAM)",
-	}
+	gcpMachines, gcpMachinesDisplay := providerCatalog.MachineTypes(GCPPlanName, catalog.GCP, false)
 	gcpSchema := GCPSchema(gcpMachinesDisplay, gcpMachines, includeAdditionalParamsInSchema, false)
 
-	openStackMachines := []string{"g_c4_m16", "g_c8_m32"}
-	openStackMachinesDisplay := map[string]string{
-		"g_c4_m16": "g_c4_m16 (4vCPU, 16GB RAM)",
-		"g_c8_m32": "g_c8_m32 (8vCPU, 32GB RAM)",
-	}
+	openStackMachines, openStackMachinesDisplay := providerCatalog.MachineTypes(OpenStackPlanName, catalog.OpenStack, false)
 	openstackSchema := OpenStackSchema(openStackMachinesDisplay, openStackMachines, includeAdditionalParamsInSchema, false)
 
-	// source: https://docs.microsoft.com/en-us/azure/cloud-services/cloud-services-sizes-specs#dv3-series
-	azureMachines := []string{"Standard_D4_v3", "Standard_D8_v3", "Standard_D16_v3", "Standard_D32_v3", "Standard_D48_v3", "Standard_D64_v3"}
-	azureMachinesDisplay := map[string]string{
-		"Standard_D4_v3":  "Standard_D4_v3 (4vCPU, 16GB RAM)",
-		"Standard_D8_v3":  "Standard_D8_v3 (8vCPU, 32GB RAM)",
-		"Standard_D16_v3": "Standard_D16_v3 (16vCPU, 64GB RAM)",
-		"Standard_D32_v3": "Standard_D32_v3 (32vCPU, 128GB RAM)",
-		"Standard_D48_v3": "Standard_D48_v3 (48vCPU, 192GB RAM)",
-		"Standard_D64_v3": "Standard_D64_v3 (64vCPU, 256GB RAM)",
-	}
+	azureMachines, azureMachinesDisplay := providerCatalog.MachineTypes(AzurePlanName, catalog.Azure, false)
 	azureSchema := AzureSchema(azureMachinesDisplay, azureMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 
-	azureLiteMachines := []string{"Standard_D4_v3"}
-	azureLiteMachinesDisplay := map[string]string{
-		"Standard_D4_v3": azureMachinesDisplay["Standard_D4_v3"],
-	}
+	azureLiteMachines, azureLiteMachinesDisplay := providerCatalog.MachineTypes(AzureLitePlanName, catalog.Azure, false)
 	azureLiteSchema := AzureLiteSchema(azureLiteMachinesDisplay, azureLiteMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 	freemiumSchema := FreemiumSchema(provider, includeAdditionalParamsInSchema, false, euAccessRestricted)
 	trialSchema := TrialSchema(includeAdditionalParamsInSchema, false)
@@ -320,17 +282,13 @@ func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditional
 
 	// Schemas exposed on v2/catalog endpoint - different than provisioningRawSchema to allow backwards compatibility
 	// when a machine type switch is introduced
-	// switch to m6 if m6 is available in all regions
-	awsCatalogMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge"}
-	awsCatalogMachinesDisplay := map[string]string{
-		"m5.xlarge":   awsMachinesDisplay["m5.xlarge"],
-		"m5.2xlarge":  awsMachinesDisplay["m5.2xlarge"],
-		"m5.4xlarge":  awsMachinesDisplay["m5.4xlarge"],
-		"m5.8xlarge":  awsMachinesDisplay["m5.8xlarge"],
-		"m5.12xlarge": awsMachinesDisplay["m5.12xlarge"],
-	}
+	awsCatalogMachines, awsCatalogMachinesDisplay := providerCatalog.MachineTypes(AWSPlanName, catalog.AWS, true)
 	awsCatalogSchema := AWSSchema(awsCatalogMachinesDisplay, awsCatalogMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
 
+	previewMachines, previewMachinesDisplay := providerCatalog.MachineTypes(PreviewPlanName, catalog.AWS, false)
+	previewCatalogMachines, previewCatalogMachinesDisplay := providerCatalog.MachineTypes(PreviewPlanName, catalog.AWS, true)
+	previewCatalogSchema := AWSSchema(previewCatalogMachinesDisplay, previewCatalogMachines, includeAdditionalParamsInSchema, false, euAccessRestricted)
+
 	outputPlans := map[string]domain.ServicePlan{
 		AWSPlanID:        defaultServicePlan(AWSPlanID, AWSPlanName, plans, awsCatalogSchema, AWSSchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, true, euAccessRestricted)),
 		GCPPlanID:        defaultServicePlan(GCPPlanID, GCPPlanName, plans, gcpSchema, GCPSchema(gcpMachinesDisplay, gcpMachines, includeAdditionalParamsInSchema, true)),
@@ -340,7 +298,7 @@ func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditional
 		FreemiumPlanID:   defaultServicePlan(FreemiumPlanID, FreemiumPlanName, plans, freemiumSchema, FreemiumSchema(provider, includeAdditionalParamsInSchema, true, euAccessRestricted)),
 		TrialPlanID:      defaultServicePlan(TrialPlanID, TrialPlanName, plans, trialSchema, TrialSchema(includeAdditionalParamsInSchema, true)),
 		OwnClusterPlanID: defaultServicePlan(OwnClusterPlanID, OwnClusterPlanName, plans, ownClusterSchema, OwnClusterSchema(true)),
-		PreviewPlanID:    defaultServicePlan(PreviewPlanID, PreviewPlanName, plans, awsCatalogSchema, AWSSchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, true, euAccessRestricted)),
+		PreviewPlanID:    defaultServicePlan(PreviewPlanID, PreviewPlanName, plans, previewCatalogSchema, AWSSchema(previewMachinesDisplay, previewMachines, includeAdditionalParamsInSchema, true, euAccessRestricted)),
 	}
 
 	return outputPlans
@@ -363,10 +321,61 @@ func defaultServicePlan(id, name string, plans PlansConfig, createParams, update
 			},
 		},
 	}
+	if len(plans[name].PlanUpdatableTo) > 0 {
+		servicePlan.PlanUpdatable = domain.PlanUpdatableValue(true)
+	}
 
 	return servicePlan
 }
 
+// IsPlanUpdatable returns true if the instances of the plan can be moved to the target plan with an update
+func IsPlanUpdatable(plans PlansConfig, planID, targetPlanID string) bool {
+	for _, name := range plans[PlanNamesMapping[planID]].PlanUpdatableTo {
+		if PlanIDsMapping[name] == targetPlanID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPlanMigrationSupported returns false for the moves which cannot be done by updating the cluster. The own_cluster
+// plan has no cluster managed by KEB and the trial plan is reserved for the new instances with an expiration.
+func IsPlanMigrationSupported(planID, targetPlanID string) bool {
+	return !IsOwnClusterPlan(planID) && !IsOwnClusterPlan(targetPlanID) && !IsTrialPlan(targetPlanID)
+}
+
+// ValidatePlanUpdatableTo checks if the plans can be moved only to the known plans supporting the migration
+func ValidatePlanUpdatableTo(plans PlansConfig) error {
+	for name, plan := range plans {
+		for _, target := range plan.PlanUpdatableTo {
+			targetPlanID, found := PlanIDsMapping[target]
+			if !found {
+				return fmt.Errorf("plan %s is updatable to unknown plan %s", name, target)
+			}
+			if !IsPlanMigrationSupported(PlanIDsMapping[name], targetPlanID) {
+				return fmt.Errorf("plan %s cannot be updatable to plan %s", name, target)
+			}
+		}
+	}
+	return nil
+}
+
+// PlanProvider returns the hyperscaler of the plan, false is returned for the plans offered on many hyperscalers
+func PlanProvider(planID string) (internal.CloudProvider, bool) {
+	switch planID {
+	case AWSPlanID, PreviewPlanID:
+		return internal.AWS, true
+	case AzurePlanID, AzureLitePlanID:
+		return internal.Azure, true
+	case GCPPlanID:
+		return internal.GCP, true
+	case OpenStackPlanID:
+		return internal.Openstack, true
+	default:
+		return "", false
+	}
+}
+
 func defaultDescription(planName string, plans PlansConfig) string {
 	plan, ok := plans[planName]
 	if !ok || len(plan.Description) == 0 {
@@ -427,6 +436,16 @@ func IsOwnClusterPlan(planID string) bool {
 	return planID == OwnClusterPlanID
 }
 
+// IsNetworkingSupported returns true for the plans which allow to set the nodes range of the cluster
+func IsNetworkingSupported(planID string) bool {
+	switch planID {
+	case AWSPlanID, AzurePlanID, AzureLitePlanID, GCPPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
 func filter(items *[]interface{}, included map[string]interface{}) interface{} {
 	output := make([]interface{}, 0)
 	for i := 0; i < len(*items); i++ {
@@ -1,6 +1,10 @@
 package broker
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+)
 
 type RootSchema struct {
 	Schema string `json:"$schema"`
@@ -21,6 +25,8 @@ type ProvisioningProperties struct {
 	ShootName   *Type    `json:"shootName,omitempty"`
 	ShootDomain *Type    `json:"shootDomain,omitempty"`
 	Region      *Type    `json:"region,omitempty"`
+	// Networking is set only when the cluster is created, the update schema does not have it
+	Networking *NetworkingType `json:"networking,omitempty"`
 }
 
 type UpdateProperties struct {
@@ -30,11 +36,14 @@ type UpdateProperties struct {
 	OIDC           *OIDCType `json:"oidc,omitempty"`
 	Administrators *Type     `json:"administrators,omitempty"`
 	MachineType    *Type     `json:"machineType,omitempty"`
+
+	APIServerAllowedCIDRs *Type `json:"apiServerAllowedCIDRs,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
 	up.OIDC = NewOIDCSchema()
 	up.Administrators = AdministratorsProperty()
+	up.APIServerAllowedCIDRs = APIServerAllowedCIDRsProperty()
 }
 
 type OIDCProperties struct {
@@ -184,6 +193,57 @@ func NewOIDCSchema() *OIDCType {
 	}
 }
 
+func APIServerAllowedCIDRsProperty() *Type {
+	return &Type{
+		Type:        "array",
+		Title:       "API server allowed CIDRs",
+		Description: "The ranges allowed to access the Kubernetes API server. An empty list removes the restriction.",
+		UniqueItems: ptr.Bool(true),
+		Items: &Type{
+			Type: "string",
+		},
+	}
+}
+
+type NetworkingProperties struct {
+	Nodes    Type `json:"nodes"`
+	Pods     Type `json:"pods"`
+	Services Type `json:"services"`
+}
+
+type NetworkingType struct {
+	Type
+	Properties NetworkingProperties `json:"properties"`
+	Required   []string             `json:"required"`
+}
+
+func NewNetworkingSchema() *NetworkingType {
+	return &NetworkingType{
+		Type: Type{Type: "object", Description: "Networking configuration. These values are immutable and cannot be updated later."},
+		Properties: NetworkingProperties{
+			Nodes: Type{
+				Type:        "string",
+				Title:       "Node network's CIDR",
+				Description: "The range of the node IP addresses, the prefix length must be between 16 and 23.",
+				Pattern:     `^(\d{1,3}\.){3}\d{1,3}/\d{1,2}$`,
+			},
+			Pods: Type{
+				Type:        "string",
+				Title:       "Pod network's CIDR",
+				Description: "The range of the pod IP addresses, the prefix length must be at most 16. The default is 100.96.0.0/11.",
+				Pattern:     `^(\d{1,3}\.){3}\d{1,3}/\d{1,2}$`,
+			},
+			Services: Type{
+				Type:        "string",
+				Title:       "Service network's CIDR",
+				Description: "The range of the service IP addresses, the prefix length must be at most 20. The default is 100.64.0.0/13.",
+				Pattern:     `^(\d{1,3}\.){3}\d{1,3}/\d{1,2}$`,
+			},
+		},
+		Required: []string{"nodes"},
+	}
+}
+
 func NewSchemaWithOnlyNameRequired(properties interface{}, update bool) *RootSchema {
 	return NewSchemaForOwnCluster(properties, update, []string{"name"})
 }
@@ -218,7 +278,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators"}
+	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "networking", "oidc", "administrators", "apiServerAllowedCIDRs"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
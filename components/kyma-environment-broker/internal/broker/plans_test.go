@@ -218,6 +218,17 @@ func validateSchema(t *testing.T, got []byte, file string) {
 	}
 }
 
+func TestValidatePlanUpdatableTo(t *testing.T) {
+	require.NoError(t, ValidatePlanUpdatableTo(PlansConfig{
+		TrialPlanName:     PlanData{PlanUpdatableTo: []string{AzurePlanName, AWSPlanName, GCPPlanName}},
+		AzureLitePlanName: PlanData{PlanUpdatableTo: []string{AzurePlanName}},
+	}))
+	require.Error(t, ValidatePlanUpdatableTo(PlansConfig{AzurePlanName: PlanData{PlanUpdatableTo: []string{TrialPlanName}}}))
+	require.Error(t, ValidatePlanUpdatableTo(PlansConfig{AzurePlanName: PlanData{PlanUpdatableTo: []string{OwnClusterPlanName}}}))
+	require.Error(t, ValidatePlanUpdatableTo(PlansConfig{OwnClusterPlanName: PlanData{PlanUpdatableTo: []string{AzurePlanName}}}))
+	require.Error(t, ValidatePlanUpdatableTo(PlansConfig{AzurePlanName: PlanData{PlanUpdatableTo: []string{"unknown"}}}))
+}
+
 func readJsonFile(t *testing.T, file string) string {
 	t.Helper()
 
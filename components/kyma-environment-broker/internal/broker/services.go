@@ -69,7 +69,7 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 			ID:                   KymaServiceID,
 			Name:                 KymaServiceName,
 			Description:          class.Description,
-			Bindable:             false,
+			Bindable:             b.cfg.Binding.Enabled,
 			InstancesRetrievable: true,
 			Tags: []string{
 				"SAP",
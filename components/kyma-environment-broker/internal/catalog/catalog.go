@@ -0,0 +1,274 @@
+package catalog
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	AWS       = "aws"
+	Azure     = "azure"
+	GCP       = "gcp"
+	OpenStack = "openstack"
+)
+
+//go:embed default.yaml
+var defaultCatalog []byte
+
+// PlanNames are the names of the plans offered with the catalog, see the plan names in internal/broker
+var PlanNames = []string{"aws", "preview", "azure", "azure_lite", "gcp", "openstack", "trial", "free"}
+
+// Catalog describes the regions, zones and machine types offered by the providers and the plans
+type Catalog struct {
+	Providers map[string]Provider `yaml:"providers"`
+	// Plans are keyed by the plan name
+	Plans map[string]Plan `yaml:"plans"`
+}
+
+type Provider struct {
+	Regions      []Region      `yaml:"regions"`
+	MachineTypes []MachineType `yaml:"machineTypes"`
+}
+
+type Region struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"displayName"`
+	// Zones are the zone names or suffixes, depending on the provider, for example "a" for AWS or "1" for Azure
+	Zones []string `yaml:"zones"`
+	// EUAccess marks the regions offered to the EU access restricted subaccounts
+	EUAccess bool `yaml:"euAccess"`
+	// EUAccessOnly marks the regions offered only to the EU access restricted subaccounts
+	EUAccessOnly bool `yaml:"euAccessOnly"`
+}
+
+type MachineType struct {
+	Name        string `yaml:"name"`
+	DisplayName string `yaml:"displayName"`
+}
+
+type Plan struct {
+	// Regions limits the regions of the provider offered in the plan, all the regions are offered when it is empty
+	Regions      []string `yaml:"regions"`
+	MachineTypes []string `yaml:"machineTypes"`
+	// CatalogMachineTypes are the machine types exposed in the provisioning schema of the catalog endpoint,
+	// MachineTypes are used when it is empty
+	CatalogMachineTypes []string `yaml:"catalogMachineTypes"`
+	// Defaults are keyed by the provider, a plan can be offered by many providers, for example trial
+	Defaults map[string]Defaults `yaml:"defaults"`
+}
+
+type Defaults struct {
+	Region         string `yaml:"region"`
+	EUAccessRegion string `yaml:"euAccessRegion"`
+	MachineType    string `yaml:"machineType"`
+}
+
+var current atomic.Pointer[Catalog]
+
+func init() {
+	c, err := Parse(defaultCatalog)
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in provider catalog: %s", err))
+	}
+	current.Store(c)
+}
+
+// Current returns the catalog in use, it is never nil
+func Current() *Catalog {
+	return current.Load()
+}
+
+// Set replaces the catalog in use
+func Set(c *Catalog) {
+	current.Store(c)
+}
+
+// Default returns the built-in catalog
+func Default() *Catalog {
+	c, _ := Parse(defaultCatalog)
+	return c
+}
+
+// Parse reads and validates the catalog in the YAML format
+func Parse(data []byte) (*Catalog, error) {
+	c := &Catalog{}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("while unmarshalling catalog: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate checks if the catalog has all the plans, every plan offers regions and machine types
+// of its providers and refers only to the regions and machine types of the providers
+func (c *Catalog) Validate() error {
+	var errs []string
+	for name, provider := range c.Providers {
+		if len(provider.Regions) == 0 {
+			errs = append(errs, fmt.Sprintf("provider %s has no regions", name))
+		}
+		for _, region := range provider.Regions {
+			if region.Name == "" {
+				errs = append(errs, fmt.Sprintf("provider %s has a region without a name", name))
+			}
+			if region.EUAccessOnly && !region.EUAccess {
+				errs = append(errs, fmt.Sprintf("region %s of provider %s is EU access only, but not EU access", region.Name, name))
+			}
+		}
+	}
+
+	for _, name := range PlanNames {
+		if _, found := c.Plans[name]; !found {
+			errs = append(errs, fmt.Sprintf("plan %s is missing", name))
+		}
+	}
+	for name, plan := range c.Plans {
+		if !contains(PlanNames, name) {
+			errs = append(errs, fmt.Sprintf("plan %s is unknown", name))
+		}
+		if len(plan.Defaults) == 0 {
+			errs = append(errs, fmt.Sprintf("plan %s has no defaults of any provider", name))
+		}
+		for providerName, defaults := range plan.Defaults {
+			provider, found := c.Providers[providerName]
+			if !found {
+				errs = append(errs, fmt.Sprintf("plan %s refers to unknown provider %s", name, providerName))
+				continue
+			}
+			for _, region := range plan.Regions {
+				if _, found := provider.region(region); !found {
+					errs = append(errs, fmt.Sprintf("plan %s refers to unknown region %s of provider %s", name, region, providerName))
+				}
+			}
+			if len(c.Regions(name, providerName, false)) == 0 && len(c.Regions(name, providerName, true)) == 0 {
+				errs = append(errs, fmt.Sprintf("plan %s offers no regions of provider %s", name, providerName))
+			}
+			machineTypes := append(append([]string(nil), plan.MachineTypes...), plan.CatalogMachineTypes...)
+			for _, machineType := range machineTypes {
+				if !provider.hasMachineType(machineType) {
+					errs = append(errs, fmt.Sprintf("plan %s refers to unknown machine type %s of provider %s", name, machineType, providerName))
+				}
+			}
+			// The plans without the machine types offer only the default one
+			if len(plan.MachineTypes) == 0 && defaults.MachineType == "" {
+				errs = append(errs, fmt.Sprintf("plan %s offers no machine types of provider %s", name, providerName))
+			}
+			if defaults.MachineType != "" && !provider.hasMachineType(defaults.MachineType) {
+				errs = append(errs, fmt.Sprintf("plan %s refers to unknown default machine type %s of provider %s", name, defaults.MachineType, providerName))
+			}
+			if defaults.MachineType != "" && len(plan.MachineTypes) > 0 && !contains(plan.MachineTypes, defaults.MachineType) {
+				errs = append(errs, fmt.Sprintf("default machine type %s of plan %s is not offered in the plan", defaults.MachineType, name))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid catalog: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Regions returns the regions of the provider offered in the plan
+func (c *Catalog) Regions(planName, providerName string, euAccess bool) []string {
+	provider := c.Providers[providerName]
+	plan := c.Plans[planName]
+
+	var regions []string
+	for _, region := range provider.Regions {
+		if len(plan.Regions) > 0 && !contains(plan.Regions, region.Name) {
+			continue
+		}
+		if euAccess && !region.EUAccess || !euAccess && region.EUAccessOnly {
+			continue
+		}
+		regions = append(regions, region.Name)
+	}
+	return regions
+}
+
+// RegionDisplayNames returns the display names of the given regions, nil is returned if none of them has a display name
+func (c *Catalog) RegionDisplayNames(providerName string, regions []string) map[string]string {
+	provider := c.Providers[providerName]
+	var names map[string]string
+	for _, name := range regions {
+		region, found := provider.region(name)
+		if !found || region.DisplayName == "" {
+			continue
+		}
+		if names == nil {
+			names = make(map[string]string)
+		}
+		names[name] = region.DisplayName
+	}
+	return names
+}
+
+// Zones returns the zones of the provider region, false is returned if the region is not in the catalog
+func (c *Catalog) Zones(providerName, regionName string) ([]string, bool) {
+	region, found := c.Providers[providerName].region(regionName)
+	if !found || len(region.Zones) == 0 {
+		return nil, false
+	}
+	return append([]string(nil), region.Zones...), true
+}
+
+// MachineTypes returns the machine types offered in the plan and their display names, the machine types
+// exposed in the catalog endpoint are returned if catalog is true
+func (c *Catalog) MachineTypes(planName, providerName string, catalog bool) ([]string, map[string]string) {
+	plan := c.Plans[planName]
+	machineTypes := plan.MachineTypes
+	if catalog && len(plan.CatalogMachineTypes) > 0 {
+		machineTypes = plan.CatalogMachineTypes
+	}
+
+	provider := c.Providers[providerName]
+	display := make(map[string]string, len(machineTypes))
+	for _, name := range machineTypes {
+		for _, machineType := range provider.MachineTypes {
+			if machineType.Name == name && machineType.DisplayName != "" {
+				display[name] = machineType.DisplayName
+			}
+		}
+	}
+	return append([]string(nil), machineTypes...), display
+}
+
+// Defaults returns the defaults of the plan for the provider
+func (c *Catalog) Defaults(planName, providerName string) Defaults {
+	return c.Plans[planName].Defaults[providerName]
+}
+
+func (p Provider) region(name string) (Region, bool) {
+	for _, region := range p.Regions {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+func (p Provider) hasMachineType(name string) bool {
+	for _, machineType := range p.MachineTypes {
+		if machineType.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCatalog = `
+providers:
+  aws:
+    regions:
+      - name: eu-central-1
+        displayName: Europe (Frankfurt)
+        zones: [a, b, c]
+        euAccess: true
+      - name: us-east-1
+        zones: [a, b]
+      - name: eu-south-9
+        zones: [a]
+        euAccess: true
+        euAccessOnly: true
+    machineTypes:
+      - name: m5.xlarge
+        displayName: m5.xlarge (4vCPU, 16GB RAM)
+      - name: m6i.xlarge
+  azure:
+    regions:
+      - name: eastus
+        zones: ["1", "2", "3"]
+    machineTypes:
+      - name: Standard_D4_v3
+  gcp:
+    regions:
+      - name: europe-west3
+        zones: [a, b, c]
+    machineTypes:
+      - name: n2-standard-4
+  openstack:
+    regions:
+      - name: eu-de-1
+        zones: [a]
+    machineTypes:
+      - name: g_c4_m16
+plans:
+  aws:
+    regions: [eu-central-1, eu-south-9]
+    machineTypes: [m5.xlarge, m6i.xlarge]
+    catalogMachineTypes: [m5.xlarge]
+    defaults:
+      aws:
+        region: eu-central-1
+        machineType: m5.xlarge
+  preview:
+    machineTypes: [m5.xlarge]
+    defaults:
+      aws: {region: eu-central-1, machineType: m5.xlarge}
+  azure:
+    machineTypes: [Standard_D4_v3]
+    defaults:
+      azure: {region: eastus, machineType: Standard_D4_v3}
+  azure_lite:
+    machineTypes: [Standard_D4_v3]
+    defaults:
+      azure: {region: eastus, machineType: Standard_D4_v3}
+  gcp:
+    machineTypes: [n2-standard-4]
+    defaults:
+      gcp: {region: europe-west3, machineType: n2-standard-4}
+  openstack:
+    machineTypes: [g_c4_m16]
+    defaults:
+      openstack: {region: eu-de-1, machineType: g_c4_m16}
+  trial:
+    defaults:
+      aws: {region: us-east-1, machineType: m5.xlarge}
+  free:
+    defaults:
+      aws:
+        region: us-east-1
+        machineType: m5.xlarge
+`
+
+func TestCatalog(t *testing.T) {
+	c, err := Parse([]byte(testCatalog))
+	require.NoError(t, err)
+
+	t.Run("should return regions of the plan", func(t *testing.T) {
+		assert.Equal(t, []string{"eu-central-1"}, c.Regions("aws", AWS, false))
+		assert.Equal(t, []string{"eu-central-1", "eu-south-9"}, c.Regions("aws", AWS, true))
+		assert.Equal(t, []string{"eu-central-1", "us-east-1"}, c.Regions("free", AWS, false))
+		assert.Empty(t, c.Regions("aws", Azure, false))
+	})
+
+	t.Run("should return region display names", func(t *testing.T) {
+		assert.Equal(t, map[string]string{"eu-central-1": "Europe (Frankfurt)"}, c.RegionDisplayNames(AWS, []string{"eu-central-1", "us-east-1"}))
+		assert.Nil(t, c.RegionDisplayNames(AWS, []string{"us-east-1"}))
+	})
+
+	t.Run("should return zones of the region", func(t *testing.T) {
+		zones, found := c.Zones(AWS, "us-east-1")
+		assert.True(t, found)
+		assert.Equal(t, []string{"a", "b"}, zones)
+
+		_, found = c.Zones(AWS, "eu-west-1")
+		assert.False(t, found)
+	})
+
+	t.Run("should return machine types of the plan", func(t *testing.T) {
+		machineTypes, display := c.MachineTypes("aws", AWS, false)
+		assert.Equal(t, []string{"m5.xlarge", "m6i.xlarge"}, machineTypes)
+		assert.Equal(t, map[string]string{"m5.xlarge": "m5.xlarge (4vCPU, 16GB RAM)"}, display)
+
+		machineTypes, _ = c.MachineTypes("aws", AWS, true)
+		assert.Equal(t, []string{"m5.xlarge"}, machineTypes)
+	})
+
+	t.Run("should return defaults of the plan", func(t *testing.T) {
+		assert.Equal(t, Defaults{Region: "eu-central-1", MachineType: "m5.xlarge"}, c.Defaults("aws", AWS))
+		assert.Equal(t, Defaults{}, c.Defaults("trial", GCP))
+	})
+}
+
+func TestParse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		catalog string
+		err     string
+	}{
+		"unknown field": {
+			catalog: "providers:\n  aws:\n    region: []\n",
+			err:     "while unmarshalling catalog",
+		},
+		"unknown region": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    regions: [b]\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan aws refers to unknown region b of provider aws",
+		},
+		"unknown machine type": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    machineTypes: [m5]\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan aws refers to unknown machine type m5 of provider aws",
+		},
+		"unknown provider": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    defaults:\n      gcp: {region: a}\n",
+			err:     "plan aws refers to unknown provider gcp",
+		},
+		"missing plan": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan trial is missing",
+		},
+		"unknown plan": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  own_cluster:\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan own_cluster is unknown",
+		},
+		"plan without defaults": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    regions: [a]\n",
+			err:     "plan aws has no defaults of any provider",
+		},
+		"no regions": {
+			catalog: "providers:\n  aws:\n    regions: []\nplans:\n  aws:\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan aws offers no regions of provider aws",
+		},
+		"no machine types": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    defaults:\n      aws: {region: a}\n",
+			err:     "plan aws offers no machine types of provider aws",
+		},
+		"unknown default machine type": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a}]\nplans:\n  aws:\n    defaults:\n      aws: {region: a, machineType: m5}\n",
+			err:     "plan aws refers to unknown default machine type m5 of provider aws",
+		},
+		"EU access only region": {
+			catalog: "providers:\n  aws:\n    regions: [{name: a, euAccessOnly: true}]\n",
+			err:     "region a of provider aws is EU access only, but not EU access",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.catalog))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestDefaultCatalog(t *testing.T) {
+	c := Default()
+	require.NotNil(t, c)
+
+	for _, provider := range []string{AWS, Azure, GCP, OpenStack} {
+		for _, region := range c.Regions(provider, provider, false) {
+			_, found := c.Zones(provider, region)
+			assert.True(t, found, "zones of %s region %s", provider, region)
+		}
+	}
+	assert.Equal(t, []string{"switzerlandnorth"}, c.Regions("azure", Azure, true))
+	assert.Equal(t, []string{"eu-de-1", "ap-sa-1"}, c.Regions("openstack", OpenStack, false))
+}
+
+func TestWatch(t *testing.T) {
+	// given
+	defer Set(Default())
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testCatalog), 0600))
+	require.NoError(t, Load(path))
+	assert.Equal(t, []string{"eu-central-1"}, Current().Regions("aws", AWS, false))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Watch(ctx, path, 10*time.Millisecond, logrus.New())
+
+	// when
+	require.NoError(t, os.WriteFile(path, []byte(strings.ReplaceAll(testCatalog, "eu-central-1", "ap-south-1")), 0600))
+
+	// then
+	assert.Eventually(t, func() bool {
+		regions := Current().Regions("aws", AWS, false)
+		return len(regions) == 1 && regions[0] == "ap-south-1"
+	}, time.Second, 10*time.Millisecond)
+
+	// when an invalid catalog is written
+	require.NoError(t, os.WriteFile(path, []byte("providers: {aws: {regions: []}}"), 0600))
+	time.Sleep(50 * time.Millisecond)
+
+	// then the previous one stays in use
+	assert.Equal(t, []string{"ap-south-1"}, Current().Regions("aws", AWS, false))
+}
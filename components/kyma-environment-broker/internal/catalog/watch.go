@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	// FilePath is the catalog file, usually mounted from a ConfigMap, the built-in catalog is used when it is empty
+	FilePath       string        `envconfig:"optional"`
+	ReloadInterval time.Duration `envconfig:"default=1m"`
+}
+
+// Load reads the catalog from the file and sets it as the current one
+func Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("while reading catalog file %s: %w", path, err)
+	}
+	c, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	Set(c)
+	return nil
+}
+
+// Watch checks the catalog file every interval and replaces the current catalog when the file content changes.
+// An invalid catalog is logged and the previous one stays in use.
+func Watch(ctx context.Context, path string, interval time.Duration, log logrus.FieldLogger) {
+	log = log.WithField("catalog", path)
+	// the file is read on the first tick, so the changes made since it was loaded are not missed
+	var last []byte
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Errorf("unable to read catalog file: %s", err)
+			continue
+		}
+		if bytes.Equal(data, last) {
+			continue
+		}
+		last = data
+
+		c, err := Parse(data)
+		if err != nil {
+			log.Errorf("unable to reload catalog, the previous one stays in use: %s", err)
+			continue
+		}
+		Set(c)
+		log.Info("Provider catalog reloaded")
+	}
+}
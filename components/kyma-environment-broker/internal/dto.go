@@ -5,6 +5,9 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 )
 
 const (
@@ -75,6 +78,20 @@ func (o *OIDCConfigDTO) validSigningAlgsSet() map[string]bool {
 	return signingAlgsSet
 }
 
+// NetworkingDTO defines the ranges of the cluster, the Gardener defaults are used for the pods and services ranges which are not provided
+type NetworkingDTO struct {
+	NodesCidr    string  `json:"nodes"`
+	PodsCidr     *string `json:"pods,omitempty"`
+	ServicesCidr *string `json:"services,omitempty"`
+}
+
+func (n *NetworkingDTO) Validate() error {
+	if err := networking.Validate(n.NodesCidr, ptr.ToString(n.PodsCidr), ptr.ToString(n.ServicesCidr)); err != nil {
+		return fmt.Errorf("invalid networking parameters: %w", err)
+	}
+	return nil
+}
+
 type ProvisioningParameters struct {
 	PlanID     string                    `json:"plan_id"`
 	ServiceID  string                    `json:"service_id"`
@@ -181,7 +198,10 @@ type ProvisioningParametersDTO struct {
 	ShootName   string `json:"shootName,omitempty"`
 	ShootDomain string `json:"shootDomain,omitempty"`
 
-	OIDC *OIDCConfigDTO `json:"oidc,omitempty"`
+	OIDC       *OIDCConfigDTO `json:"oidc,omitempty"`
+	Networking *NetworkingDTO `json:"networking,omitempty"`
+	// APIServerAllowedCIDRs restricts the access to the Kubernetes API server to the given ranges
+	APIServerAllowedCIDRs []string `json:"apiServerAllowedCIDRs,omitempty"`
 }
 
 type UpdatingParametersDTO struct {
@@ -190,6 +210,8 @@ type UpdatingParametersDTO struct {
 	OIDC                  *OIDCConfigDTO `json:"oidc,omitempty"`
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
 	MachineType           *string        `json:"machineType,omitempty"`
+	// APIServerAllowedCIDRs is a pointer to tell the missing parameter from the empty list, which removes the restriction
+	APIServerAllowedCIDRs *[]string `json:"apiServerAllowedCIDRs,omitempty"`
 
 	// Expired - means that the trial SKR is marked as expired
 	Expired bool `json:"expired"`
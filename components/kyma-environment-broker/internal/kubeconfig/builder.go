@@ -31,6 +31,7 @@ type kubeconfigData struct {
 	ServerURL     string
 	OIDCIssuerURL string
 	OIDCClientID  string
+	Token         string
 }
 
 func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKubeconfig string) (string, error) {
@@ -55,7 +56,7 @@ func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKub
 		return "", fmt.Errorf("while validation kubeconfig fetched by provisioner: %w", err)
 	}
 
-	return b.parseTemplate(kubeconfigData{
+	return b.parseTemplate(kubeconfigTemplate, kubeconfigData{
 		ContextName:   kubeCfg.CurrentContext,
 		CAData:        kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
 		ServerURL:     kubeCfg.Clusters[0].Cluster.Server,
@@ -64,14 +65,38 @@ func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKub
 	})
 }
 
+// BuildWithToken builds a kubeconfig for the cluster described by the admin kubeconfig
+// which authenticates with the given bearer token instead of OIDC
+func (b *Builder) BuildWithToken(adminKubeconfig, token string) (string, error) {
+	var kubeCfg kubeconfig
+	err := yaml.Unmarshal([]byte(adminKubeconfig), &kubeCfg)
+	if err != nil {
+		return "", fmt.Errorf("while unmarshaling kubeconfig: %w", err)
+	}
+
+	if err := b.validKubeconfig(kubeCfg); err != nil {
+		return "", fmt.Errorf("while validation admin kubeconfig: %w", err)
+	}
+	if token == "" {
+		return "", fmt.Errorf("token is empty")
+	}
+
+	return b.parseTemplate(kubeconfigTokenTemplate, kubeconfigData{
+		ContextName: kubeCfg.CurrentContext,
+		CAData:      kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
+		ServerURL:   kubeCfg.Clusters[0].Cluster.Server,
+		Token:       token,
+	})
+}
+
 func (b *Builder) Build(instance *internal.Instance) (string, error) {
 	return b.BuildFromAdminKubeconfig(instance, "")
 }
 
-func (b *Builder) parseTemplate(payload kubeconfigData) (string, error) {
+func (b *Builder) parseTemplate(tmpl string, payload kubeconfigData) (string, error) {
 	var result bytes.Buffer
 	t := template.New("kubeconfigParser")
-	t, err := t.Parse(kubeconfigTemplate)
+	t, err := t.Parse(tmpl)
 	if err != nil {
 		return "", fmt.Errorf("while parsing kubeconfig template: %w", err)
 	}
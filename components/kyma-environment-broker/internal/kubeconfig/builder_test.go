@@ -137,6 +137,44 @@ func TestBuilder_BuildFromAdminKubeconfig(t *testing.T) {
 	})
 }
 
+func TestBuilder_BuildWithToken(t *testing.T) {
+	t.Run("new kubeconfig was build properly", func(t *testing.T) {
+		// given
+		builder := NewBuilder(&automock.Client{})
+
+		// when
+		kubeconfig, err := builder.BuildWithToken(*skrKubeconfig(), "binding-token")
+
+		//then
+		require.NoError(t, err)
+		require.Equal(t, kubeconfig, newTokenKubeconfig("binding-token"))
+	})
+
+	t.Run("token is empty", func(t *testing.T) {
+		// given
+		builder := NewBuilder(&automock.Client{})
+
+		// when
+		_, err := builder.BuildWithToken(*skrKubeconfig(), "")
+
+		//then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "token is empty")
+	})
+
+	t.Run("admin kubeconfig is wrong", func(t *testing.T) {
+		// given
+		builder := NewBuilder(&automock.Client{})
+
+		// when
+		_, err := builder.BuildWithToken(*skrWrongKubeconfig(), "binding-token")
+
+		//then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while validation admin kubeconfig")
+	})
+}
+
 func skrKubeconfig() *string {
 	kc := `
 ---
@@ -203,6 +241,30 @@ users:
 	)
 }
 
+func newTokenKubeconfig(token string) string {
+	return fmt.Sprintf(`
+---
+apiVersion: v1
+kind: Config
+current-context: shoot--kyma-dev--ac0d8d9
+clusters:
+- name: shoot--kyma-dev--ac0d8d9
+  cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURUSUZJQ0FURS0tLS0tCg==
+    server: https://api.ac0d8d9.kyma-dev.shoot.canary.k8s-hana.ondemand.com
+contexts:
+- name: shoot--kyma-dev--ac0d8d9
+  context:
+    cluster: shoot--kyma-dev--ac0d8d9
+    user: shoot--kyma-dev--ac0d8d9
+users:
+- name: shoot--kyma-dev--ac0d8d9
+  user:
+    token: %s
+`, token,
+	)
+}
+
 func newOwnClusterKubeconfig() string {
 	return fmt.Sprintf(`
 ---
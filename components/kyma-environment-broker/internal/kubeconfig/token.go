@@ -0,0 +1,180 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	BindingNamespace   = "kyma-system"
+	BindingClusterRole = "kyma-binding"
+	BindingIDLabel     = "kyma-project.io/binding-id"
+
+	// AggregateToBindingLabel adds the rules of a ClusterRole to the binding ClusterRole on top of the edit rules
+	AggregateToBindingLabel = "kyma-project.io/aggregate-to-binding"
+
+	bindingNamePrefix = "kyma-binding-"
+)
+
+// TokenProvider creates kubeconfigs with short-lived ServiceAccount tokens on the runtime.
+// Every binding gets its own ServiceAccount, so removing it revokes all tokens issued for the binding.
+type TokenProvider struct {
+	builder           *Builder
+	provisionerClient provisioner.Client
+	k8sClientProvider func(kcfg string) (client.Client, error)
+}
+
+func NewTokenProvider(provisionerClient provisioner.Client, k8sClientProvider func(kcfg string) (client.Client, error)) *TokenProvider {
+	return &TokenProvider{
+		builder:           NewBuilder(provisionerClient),
+		provisionerClient: provisionerClient,
+		k8sClientProvider: k8sClientProvider,
+	}
+}
+
+// CreateBindingKubeconfig creates the ServiceAccount with the ClusterRoleBinding for the given binding
+// and returns a kubeconfig with a token which expires after the given number of seconds
+func (p *TokenProvider) CreateBindingKubeconfig(ctx context.Context, instance *internal.Instance, bindingID string, expirationSeconds int64) (string, time.Time, error) {
+	adminKubeconfig, err := p.adminKubeconfig(instance)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	cli, err := p.k8sClientProvider(adminKubeconfig)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("while creating k8s client: %w", err)
+	}
+
+	if err := ensureBindingClusterRole(ctx, cli); err != nil {
+		return "", time.Time{}, err
+	}
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bindingName(bindingID),
+			Namespace: BindingNamespace,
+			Labels:    map[string]string{BindingIDLabel: bindingID},
+		},
+	}
+	if err := cli.Create(ctx, sa); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, fmt.Errorf("while creating service account %s: %w", sa.Name, err)
+	}
+
+	crb := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   bindingName(bindingID),
+			Labels: map[string]string{BindingIDLabel: bindingID},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     BindingClusterRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		},
+	}
+	if err := cli.Create(ctx, crb); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, fmt.Errorf("while creating cluster role binding %s: %w", crb.Name, err)
+	}
+
+	tokenRequest := &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	if err := cli.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+		return "", time.Time{}, fmt.Errorf("while requesting token for service account %s: %w", sa.Name, err)
+	}
+
+	kubeconfig, err := p.builder.BuildWithToken(adminKubeconfig, tokenRequest.Status.Token)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("while building kubeconfig: %w", err)
+	}
+
+	return kubeconfig, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// RevokeBindingKubeconfig removes the ServiceAccount and the ClusterRoleBinding created for the given binding,
+// which invalidates all tokens issued for it
+func (p *TokenProvider) RevokeBindingKubeconfig(ctx context.Context, instance *internal.Instance, bindingID string) error {
+	adminKubeconfig, err := p.adminKubeconfig(instance)
+	if err != nil {
+		return err
+	}
+	cli, err := p.k8sClientProvider(adminKubeconfig)
+	if err != nil {
+		return fmt.Errorf("while creating k8s client: %w", err)
+	}
+
+	crb := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName(bindingID),
+		},
+	}
+	if err := cli.Delete(ctx, crb); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("while deleting cluster role binding %s: %w", crb.Name, err)
+	}
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bindingName(bindingID),
+			Namespace: BindingNamespace,
+		},
+	}
+	if err := cli.Delete(ctx, sa); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("while deleting service account %s: %w", sa.Name, err)
+	}
+
+	return nil
+}
+
+func (p *TokenProvider) adminKubeconfig(instance *internal.Instance) (string, error) {
+	status, err := p.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
+	if err != nil {
+		return "", fmt.Errorf("while fetching runtime status from provisioner: %w", err)
+	}
+	if status.RuntimeConfiguration == nil || status.RuntimeConfiguration.Kubeconfig == nil {
+		return "", fmt.Errorf("kubeconfig is nil (nil response from Provisioner)")
+	}
+
+	return *status.RuntimeConfiguration.Kubeconfig, nil
+}
+
+// ensureBindingClusterRole creates the ClusterRole of the bindings, it aggregates the rules of the edit role,
+// so the bindings can manage the workloads but not the RBAC and the cluster-wide resources
+func ensureBindingClusterRole(ctx context.Context, cli client.Client) error {
+	role := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: BindingClusterRole,
+		},
+		AggregationRule: &rbacv1.AggregationRule{
+			ClusterRoleSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-edit": "true"}},
+				{MatchLabels: map[string]string{AggregateToBindingLabel: "true"}},
+			},
+		},
+	}
+	if err := cli.Create(ctx, role); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("while creating cluster role %s: %w", role.Name, err)
+	}
+	return nil
+}
+
+func bindingName(bindingID string) string {
+	return bindingNamePrefix + bindingID
+}
@@ -0,0 +1,29 @@
+package kubeconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureBindingClusterRole(t *testing.T) {
+	// given
+	cli := fake.NewClientBuilder().Build()
+
+	// when
+	require.NoError(t, ensureBindingClusterRole(context.Background(), cli))
+	require.NoError(t, ensureBindingClusterRole(context.Background(), cli))
+
+	// then
+	role := &rbacv1.ClusterRole{}
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Name: BindingClusterRole}, role))
+	assert.NotEqual(t, "cluster-admin", role.Name)
+	require.NotNil(t, role.AggregationRule)
+	assert.Equal(t, map[string]string{"rbac.authorization.k8s.io/aggregate-to-edit": "true"}, role.AggregationRule.ClusterRoleSelectors[0].MatchLabels)
+	assert.Equal(t, map[string]string{AggregateToBindingLabel: "true"}, role.AggregationRule.ClusterRoleSelectors[1].MatchLabels)
+}
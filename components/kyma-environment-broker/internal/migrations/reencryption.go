@@ -0,0 +1,267 @@
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+const (
+	progressTotal       = "total"
+	progressChecked     = "checked"
+	progressReencrypted = "reencrypted"
+	progressFailed      = "failed"
+)
+
+var reencryptionProgress = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "compass",
+	Subsystem: "keb",
+	Name:      "reencryption_rows",
+	Help:      "Progress of the re-encryption of the stored data with the primary key",
+}, []string{"column", "state"})
+
+type ReencryptionConfig struct {
+	Enabled   bool `envconfig:"default=false"`
+	BatchSize int  `envconfig:"default=100"`
+	// BatchInterval is the pause between the batches which limits the load of the database
+	BatchInterval time.Duration `envconfig:"default=1s"`
+}
+
+type EncryptedData interface {
+	List(column dbmodel.EncryptedColumn, afterKey string, limit int) ([]dbmodel.EncryptedDataDTO, error)
+	Count(column dbmodel.EncryptedColumn) (int, error)
+	Update(column dbmodel.EncryptedColumn, rowKey, oldData, newData string) error
+}
+
+type Cipher interface {
+	Encrypt(text []byte) ([]byte, error)
+	Decrypt(text []byte) ([]byte, error)
+	NeedsReencryption(text []byte) bool
+	PrimaryKeyID() string
+
+	EncryptSMCreds(pp *internal.ProvisioningParameters) error
+	DecryptSMCreds(pp *internal.ProvisioningParameters) error
+	EncryptKubeconfig(pp *internal.ProvisioningParameters) error
+	DecryptKubeconfig(pp *internal.ProvisioningParameters) error
+}
+
+// reencryptFunc returns the data encrypted with the primary key, false is returned if the data
+// is already encrypted with the primary key
+type reencryptFunc func(data string) (string, bool, error)
+
+type encryptedColumn struct {
+	dbmodel.EncryptedColumn
+	reencrypt reencryptFunc
+}
+
+// ReencryptionProgress is the result of the re-encryption of a single column
+type ReencryptionProgress struct {
+	Column      string
+	Total       int
+	Checked     int
+	Reencrypted int
+	Failed      int
+}
+
+// Reencryption rewrites the stored data still encrypted with other than the primary key, including
+// the data written before the keys had IDs. Once it finishes without failures, the old keys can be
+// removed from the keyring. The rollback is the same migration run with the old key set as primary.
+type Reencryption struct {
+	storage EncryptedData
+	cipher  Cipher
+	cfg     ReencryptionConfig
+	log     logrus.FieldLogger
+	columns []encryptedColumn
+}
+
+func NewReencryption(storage EncryptedData, cipher Cipher, cfg ReencryptionConfig, log logrus.FieldLogger) *Reencryption {
+	r := &Reencryption{
+		storage: storage,
+		cipher:  cipher,
+		cfg:     cfg,
+		log:     log.WithField("migration", "reencryption"),
+	}
+	r.columns = []encryptedColumn{
+		{
+			EncryptedColumn: dbmodel.EncryptedColumn{Table: postsql.InstancesTableName, Key: "instance_id", Column: "provisioning_parameters"},
+			reencrypt:       r.reencryptProvisioningParameters,
+		},
+		{
+			EncryptedColumn: dbmodel.EncryptedColumn{Table: postsql.OperationTableName, Key: "id", Column: "provisioning_parameters"},
+			reencrypt:       r.reencryptProvisioningParameters,
+		},
+		{
+			EncryptedColumn: dbmodel.EncryptedColumn{Table: postsql.RuntimeStateTableName, Key: "id", Column: "kyma_config"},
+			reencrypt:       r.reencryptValue,
+		},
+		{
+			EncryptedColumn: dbmodel.EncryptedColumn{Table: postsql.RuntimeStateTableName, Key: "id", Column: "cluster_setup"},
+			reencrypt:       r.reencryptValue,
+		},
+		{
+			EncryptedColumn: dbmodel.EncryptedColumn{Table: postsql.BindingsTableName, Key: "instance_id || '/' || id", Column: "kubeconfig"},
+			reencrypt:       r.reencryptValue,
+		},
+	}
+	return r
+}
+
+// Run re-encrypts all the columns one by one and returns the progress of each of them
+func (r *Reencryption) Run(ctx context.Context) ([]ReencryptionProgress, error) {
+	r.log.Infof("Starting re-encryption of the stored data with key %s", r.cipher.PrimaryKeyID())
+
+	var result []ReencryptionProgress
+	for _, column := range r.columns {
+		progress, err := r.reencryptColumn(ctx, column)
+		result = append(result, progress)
+		if err != nil {
+			return result, fmt.Errorf("while re-encrypting %s: %w", column, err)
+		}
+	}
+
+	failed := 0
+	for _, progress := range result {
+		failed += progress.Failed
+	}
+	if failed > 0 {
+		r.log.Warnf("Re-encryption finished, %d rows could not be re-encrypted and still require the old keys", failed)
+	} else {
+		r.log.Infof("Re-encryption finished, all the data is encrypted with key %s", r.cipher.PrimaryKeyID())
+	}
+	return result, nil
+}
+
+func (r *Reencryption) reencryptColumn(ctx context.Context, column encryptedColumn) (ReencryptionProgress, error) {
+	progress := ReencryptionProgress{Column: column.String()}
+	log := r.log.WithField("column", progress.Column)
+
+	total, err := r.storage.Count(column.EncryptedColumn)
+	if err != nil {
+		return progress, fmt.Errorf("while counting rows: %w", err)
+	}
+	progress.Total = total
+	r.report(progress)
+
+	afterKey := ""
+	for {
+		rows, err := r.storage.List(column.EncryptedColumn, afterKey, r.cfg.BatchSize)
+		if err != nil {
+			return progress, fmt.Errorf("while listing rows after %q: %w", afterKey, err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, row := range rows {
+			reencrypted, err := r.reencryptRow(column, row)
+			switch {
+			case err != nil:
+				progress.Failed++
+				log.Errorf("unable to re-encrypt row %s: %s", row.RowKey, err)
+			case reencrypted:
+				progress.Reencrypted++
+			}
+			progress.Checked++
+		}
+		afterKey = rows[len(rows)-1].RowKey
+		r.report(progress)
+		log.Infof("Checked %d of %d rows, re-encrypted %d, failed %d", progress.Checked, progress.Total, progress.Reencrypted, progress.Failed)
+
+		select {
+		case <-ctx.Done():
+			return progress, ctx.Err()
+		case <-time.After(r.cfg.BatchInterval):
+		}
+	}
+
+	return progress, nil
+}
+
+func (r *Reencryption) reencryptRow(column encryptedColumn, row dbmodel.EncryptedDataDTO) (bool, error) {
+	data, reencrypted, err := column.reencrypt(row.Data)
+	if err != nil || !reencrypted {
+		return false, err
+	}
+
+	err = r.storage.Update(column.EncryptedColumn, row.RowKey, row.Data, data)
+	switch {
+	case dberr.IsConflict(err):
+		// the row was written in the meantime, so it is already encrypted with the primary key
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("while updating row: %w", err)
+	}
+	return true, nil
+}
+
+func (r *Reencryption) reencryptValue(data string) (string, bool, error) {
+	if !r.cipher.NeedsReencryption([]byte(data)) {
+		return data, false, nil
+	}
+	decrypted, err := r.cipher.Decrypt([]byte(data))
+	if err != nil {
+		return "", false, fmt.Errorf("while decrypting: %w", err)
+	}
+	encrypted, err := r.cipher.Encrypt(decrypted)
+	if err != nil {
+		return "", false, fmt.Errorf("while encrypting: %w", err)
+	}
+	return string(encrypted), true, nil
+}
+
+func (r *Reencryption) reencryptProvisioningParameters(data string) (string, bool, error) {
+	var params internal.ProvisioningParameters
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		return "", false, fmt.Errorf("while unmarshalling provisioning parameters: %w", err)
+	}
+	if !r.provisioningParametersNeedReencryption(params) {
+		return data, false, nil
+	}
+
+	if err := r.cipher.DecryptSMCreds(&params); err != nil {
+		return "", false, err
+	}
+	if err := r.cipher.DecryptKubeconfig(&params); err != nil {
+		return "", false, err
+	}
+	if err := r.cipher.EncryptSMCreds(&params); err != nil {
+		return "", false, err
+	}
+	if err := r.cipher.EncryptKubeconfig(&params); err != nil {
+		return "", false, err
+	}
+
+	encrypted, err := json.Marshal(params)
+	if err != nil {
+		return "", false, fmt.Errorf("while marshalling provisioning parameters: %w", err)
+	}
+	return string(encrypted), true, nil
+}
+
+func (r *Reencryption) provisioningParametersNeedReencryption(params internal.ProvisioningParameters) bool {
+	if r.cipher.NeedsReencryption([]byte(params.Parameters.Kubeconfig)) {
+		return true
+	}
+	creds := params.ErsContext.SMOperatorCredentials
+	if creds == nil {
+		return false
+	}
+	return r.cipher.NeedsReencryption([]byte(creds.ClientID)) || r.cipher.NeedsReencryption([]byte(creds.ClientSecret))
+}
+
+func (r *Reencryption) report(progress ReencryptionProgress) {
+	reencryptionProgress.WithLabelValues(progress.Column, progressTotal).Set(float64(progress.Total))
+	reencryptionProgress.WithLabelValues(progress.Column, progressChecked).Set(float64(progress.Checked))
+	reencryptionProgress.WithLabelValues(progress.Column, progressReencrypted).Set(float64(progress.Reencrypted))
+	reencryptionProgress.WithLabelValues(progress.Column, progressFailed).Set(float64(progress.Failed))
+}
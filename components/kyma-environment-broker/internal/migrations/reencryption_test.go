@@ -0,0 +1,149 @@
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+func TestReencryption(t *testing.T) {
+	oldKey := rand.String(32)
+	newKey := rand.String(32)
+	oldCipher := storage.NewEncrypter(oldKey)
+	newCipher, err := storage.NewKeyringEncrypter(map[string]string{storage.DefaultKeyID: oldKey, "new": newKey}, "new", oldKey)
+	require.NoError(t, err)
+
+	kymaConfig := dbmodel.EncryptedColumn{Table: "runtime_states", Key: "id", Column: "kyma_config"}
+	instanceParameters := dbmodel.EncryptedColumn{Table: "instances", Key: "instance_id", Column: "provisioning_parameters"}
+
+	t.Run("should re-encrypt the data encrypted with the old key", func(t *testing.T) {
+		// given
+		data := newFakeEncryptedData()
+		data.add(kymaConfig, "rs-1", encrypt(t, oldCipher, "config-1"))
+		data.add(kymaConfig, "rs-2", encrypt(t, newCipher, "config-2"))
+		data.add(kymaConfig, "rs-3", encrypt(t, oldCipher, "config-3"))
+		data.add(kymaConfig, "rs-4", "not encrypted")
+		data.add(instanceParameters, "instance-1", provisioningParameters(t, oldCipher, "client-id", "secret", "kubeconfig"))
+		data.add(instanceParameters, "instance-2", provisioningParameters(t, newCipher, "client-id", "secret", ""))
+		svc := NewReencryption(data, newCipher, ReencryptionConfig{BatchSize: 2}, logrus.New())
+
+		// when
+		progress, err := svc.Run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, progress, ReencryptionProgress{Column: "runtime_states.kyma_config", Total: 4, Checked: 4, Reencrypted: 2, Failed: 1})
+		assert.Contains(t, progress, ReencryptionProgress{Column: "instances.provisioning_parameters", Total: 2, Checked: 2, Reencrypted: 1})
+
+		for key, expected := range map[string]string{"rs-1": "config-1", "rs-2": "config-2", "rs-3": "config-3"} {
+			value := data.rows[kymaConfig][key]
+			assert.False(t, newCipher.NeedsReencryption([]byte(value)), key)
+			decrypted, err := newCipher.Decrypt([]byte(value))
+			require.NoError(t, err)
+			assert.Equal(t, expected, string(decrypted))
+		}
+		assert.Equal(t, "not encrypted", data.rows[kymaConfig]["rs-4"])
+
+		var params internal.ProvisioningParameters
+		require.NoError(t, json.Unmarshal([]byte(data.rows[instanceParameters]["instance-1"]), &params))
+		assert.False(t, newCipher.NeedsReencryption([]byte(params.ErsContext.SMOperatorCredentials.ClientID)))
+		assert.False(t, newCipher.NeedsReencryption([]byte(params.Parameters.Kubeconfig)))
+		require.NoError(t, newCipher.DecryptSMCreds(&params))
+		require.NoError(t, newCipher.DecryptKubeconfig(&params))
+		assert.Equal(t, "client-id", params.ErsContext.SMOperatorCredentials.ClientID)
+		assert.Equal(t, "secret", params.ErsContext.SMOperatorCredentials.ClientSecret)
+		assert.Equal(t, "kubeconfig", params.Parameters.Kubeconfig)
+	})
+
+	t.Run("should skip rows changed in the meantime", func(t *testing.T) {
+		// given
+		data := newFakeEncryptedData()
+		data.add(kymaConfig, "rs-1", encrypt(t, oldCipher, "config-1"))
+		data.conflicts = map[string]bool{"rs-1": true}
+		svc := NewReencryption(data, newCipher, ReencryptionConfig{BatchSize: 10}, logrus.New())
+
+		// when
+		progress, err := svc.Run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, progress, ReencryptionProgress{Column: "runtime_states.kyma_config", Total: 1, Checked: 1})
+	})
+}
+
+func encrypt(t *testing.T, cipher *storage.Encrypter, value string) string {
+	encrypted, err := cipher.Encrypt([]byte(value))
+	require.NoError(t, err)
+	return string(encrypted)
+}
+
+func provisioningParameters(t *testing.T, cipher *storage.Encrypter, clientID, clientSecret, kubeconfig string) string {
+	params := internal.ProvisioningParameters{
+		ErsContext: internal.ERSContext{
+			SMOperatorCredentials: &internal.ServiceManagerOperatorCredentials{ClientID: clientID, ClientSecret: clientSecret},
+		},
+	}
+	params.Parameters.Kubeconfig = kubeconfig
+	require.NoError(t, cipher.EncryptSMCreds(&params))
+	require.NoError(t, cipher.EncryptKubeconfig(&params))
+	encoded, err := json.Marshal(params)
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+type fakeEncryptedData struct {
+	rows      map[dbmodel.EncryptedColumn]map[string]string
+	conflicts map[string]bool
+}
+
+func newFakeEncryptedData() *fakeEncryptedData {
+	return &fakeEncryptedData{rows: make(map[dbmodel.EncryptedColumn]map[string]string)}
+}
+
+func (f *fakeEncryptedData) add(column dbmodel.EncryptedColumn, key, data string) {
+	if f.rows[column] == nil {
+		f.rows[column] = make(map[string]string)
+	}
+	f.rows[column][key] = data
+}
+
+func (f *fakeEncryptedData) List(column dbmodel.EncryptedColumn, afterKey string, limit int) ([]dbmodel.EncryptedDataDTO, error) {
+	var keys []string
+	for key := range f.rows[column] {
+		if key > afterKey {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+	var result []dbmodel.EncryptedDataDTO
+	for _, key := range keys {
+		result = append(result, dbmodel.EncryptedDataDTO{RowKey: key, Data: f.rows[column][key]})
+	}
+	return result, nil
+}
+
+func (f *fakeEncryptedData) Count(column dbmodel.EncryptedColumn) (int, error) {
+	return len(f.rows[column]), nil
+}
+
+func (f *fakeEncryptedData) Update(column dbmodel.EncryptedColumn, rowKey, oldData, newData string) error {
+	if f.conflicts[rowKey] || f.rows[column][rowKey] != oldData {
+		return dberr.Conflict("row %s was changed", rowKey)
+	}
+	f.rows[column][rowKey] = newData
+	return nil
+}
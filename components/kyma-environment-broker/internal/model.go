@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	reconcilerApi "github.com/kyma-incubator/reconciler/pkg/keb"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
@@ -82,6 +83,12 @@ const (
 	AccountMapping RuntimeVersionOrigin = "account-mapping"
 )
 
+// PlanMigration describes the move of the instance from one plan to another
+type PlanMigration struct {
+	FromPlanID string `json:"from_plan_id"`
+	ToPlanID   string `json:"to_plan_id"`
+}
+
 // RuntimeVersionData describes the Kyma Version used for the cluster
 // provisioning or upgrade
 type RuntimeVersionData struct {
@@ -168,6 +175,23 @@ func (i *Instance) GetInstanceDetails() (InstanceDetails, error) {
 	return result, nil
 }
 
+// Binding represents a service binding created for a Kyma runtime instance
+type Binding struct {
+	ID         string
+	InstanceID string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+
+	Kubeconfig        string
+	ExpirationSeconds int64
+}
+
+func (b *Binding) IsExpired() bool {
+	return !b.ExpiresAt.IsZero() && time.Now().After(b.ExpiresAt)
+}
+
 // OperationType defines the possible types of an asynchronous operation to a broker.
 type OperationType string
 
@@ -226,6 +250,8 @@ type Operation struct {
 	UpdatingParameters    UpdatingParametersDTO `json:"updating_parameters"`
 	CheckReconcilerStatus bool                  `json:"check_reconciler_status"`
 	K8sClient             client.Client         `json:"-"`
+	// PlanMigration is set when the update moves the instance to another plan
+	PlanMigration *PlanMigration `json:"plan_migration,omitempty"`
 
 	// following fields are not stored in the storage
 
@@ -242,12 +268,71 @@ type Operation struct {
 
 	// KymaTemplate is read from the configuration then used in the apply_kyma step
 	KymaTemplate string `json:"KymaTemplate"`
+
+	// StepAttempts holds retry bookkeeping of steps executed with a retry policy, keyed by the step name
+	StepAttempts map[string]StepAttempts `json:"step_attempts,omitempty"`
+
+	// TimeoutResetAt is set when a failed operation is resumed with a new timeout budget
+	TimeoutResetAt time.Time `json:"timeout_reset_at"`
+}
+
+// OperationStep is an entry of the step journal which records every execution of an operation step
+type OperationStep struct {
+	ID          string
+	OperationID string
+	Stage       string
+	Step        string
+	Attempt     int
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	Outcome     operation.StepOutcome
+	Error       string
+}
+
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryState = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryState = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryState = "dead"
+)
+
+// WebhookDelivery is an entry of the webhook outbox, it holds a single event to be delivered to a single subscriber
+type WebhookDelivery struct {
+	ID            string
+	EventID       string
+	EventType     string
+	Subscriber    string
+	Payload       string
+	State         WebhookDeliveryState
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// StepAttempts describes executions of a step with a retry policy
+type StepAttempts struct {
+	Attempts          int       `json:"attempts"`
+	FirstAttemptAt    time.Time `json:"first_attempt_at"`
+	LastFailureReason string    `json:"last_failure_reason,omitempty"`
+	// Skipped is set when the step exhausted its retries and the operation continued without it
+	Skipped bool `json:"skipped,omitempty"`
 }
 
 func (o *Operation) IsFinished() bool {
 	return o.State != orchestration.InProgress && o.State != orchestration.Pending && o.State != orchestration.Canceling && o.State != orchestration.Retrying
 }
 
+// TimeoutStartedAt returns the time from which the operation timeout is measured
+func (o *Operation) TimeoutStartedAt() time.Time {
+	if o.TimeoutResetAt.After(o.CreatedAt) {
+		return o.TimeoutResetAt
+	}
+	return o.CreatedAt
+}
+
 func (o *Operation) EventInfof(fmt string, args ...any) {
 	events.Infof(o.InstanceID, o.ID, fmt, args...)
 }
@@ -267,6 +352,8 @@ type Orchestration struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	Parameters      orchestration.Parameters
+	// Progress is the persisted state of the strategy execution, nil if the execution did not start yet
+	Progress *orchestration.ExecutionProgress
 }
 
 func (o *Orchestration) IsFinished() bool {
@@ -550,6 +637,9 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 	if updatingParams.MachineType != nil && *updatingParams.MachineType != "" {
 		op.ProvisioningParameters.Parameters.MachineType = updatingParams.MachineType
 	}
+	if updatingParams.APIServerAllowedCIDRs != nil {
+		op.ProvisioningParameters.Parameters.APIServerAllowedCIDRs = *updatingParams.APIServerAllowedCIDRs
+	}
 
 	return op
 }
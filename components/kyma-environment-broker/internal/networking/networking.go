@@ -0,0 +1,225 @@
+package networking
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+const (
+	// DefaultNodesCIDR is the nodes range used when the networking parameters are not provided
+	DefaultNodesCIDR = "10.250.0.0/16"
+	// DefaultPodsCIDR and DefaultServicesCIDR are the Gardener defaults used when the pods and services ranges are not provided
+	DefaultPodsCIDR     = "100.96.0.0/11"
+	DefaultServicesCIDR = "100.64.0.0/13"
+
+	// the nodes range is split into subnets for every zone, the smallest nodes range must still give
+	// a useful number of nodes in every zone
+	MinNodesPrefix = 16
+	MaxNodesPrefix = 23
+	// every node gets a /24 range of the pods range, the smallest pods range must still give a useful number of nodes
+	MaxPodsPrefix = 16
+	// the smallest services range must still give a useful number of services
+	MaxServicesPrefix = 20
+
+	// MaxAllowedCIDRs limits the size of the API server allowlist
+	MaxAllowedCIDRs = 50
+)
+
+var reservedRanges = []string{
+	"0.0.0.0/8",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+}
+
+// Validate checks that the nodes, pods and services ranges are valid IPv4 networks, they do not overlap each
+// other and the reserved ranges, and they are large enough. The Gardener defaults are used for the empty pods
+// and services ranges.
+func Validate(nodes, pods, services string) error {
+	if pods == "" {
+		pods = DefaultPodsCIDR
+	}
+	if services == "" {
+		services = DefaultServicesCIDR
+	}
+
+	ranges := []struct {
+		name      string
+		cidr      string
+		minPrefix int
+		maxPrefix int
+	}{
+		{name: "pods", cidr: pods, minPrefix: 0, maxPrefix: MaxPodsPrefix},
+		{name: "services", cidr: services, minPrefix: 0, maxPrefix: MaxServicesPrefix},
+		// the nodes range is checked last, so the error points to it when it overlaps the default ranges
+		{name: "nodes", cidr: nodes, minPrefix: MinNodesPrefix, maxPrefix: MaxNodesPrefix},
+	}
+	networks := make([]*net.IPNet, len(ranges))
+	for i, r := range ranges {
+		network, err := parseNetwork(r.name, r.cidr)
+		if err != nil {
+			return err
+		}
+		prefix, _ := network.Mask.Size()
+		if prefix < r.minPrefix || prefix > r.maxPrefix {
+			return fmt.Errorf("%s %q prefix length must be between %d and %d", r.name, r.cidr, r.minPrefix, r.maxPrefix)
+		}
+		for _, reserved := range reservedRanges {
+			if overlaps(network, mustParse(reserved)) {
+				return fmt.Errorf("%s %q overlaps the reserved range %s", r.name, r.cidr, reserved)
+			}
+		}
+		for j := 0; j < i; j++ {
+			if overlaps(network, networks[j]) {
+				return fmt.Errorf("%s %q overlaps the %s range %s", r.name, r.cidr, ranges[j].name, networks[j].String())
+			}
+		}
+		networks[i] = network
+	}
+	return nil
+}
+
+func parseNetwork(name, cidr string) (*net.IPNet, error) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q is not a valid CIDR", name, cidr)
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf("%s %q must be an IPv4 range", name, cidr)
+	}
+	if !ip.Equal(network.IP) {
+		return nil, fmt.Errorf("%s %q must be a network address, for example %s", name, cidr, network.String())
+	}
+	return network, nil
+}
+
+// ValidateAllowedCIDRs checks that every entry of the API server allowlist is a network address
+// and that the list has no duplicates
+func ValidateAllowedCIDRs(cidrs []string) error {
+	if len(cidrs) > MaxAllowedCIDRs {
+		return fmt.Errorf("at most %d CIDRs are allowed, got %d", MaxAllowedCIDRs, len(cidrs))
+	}
+	seen := make(map[string]bool, len(cidrs))
+	for _, c := range cidrs {
+		ip, cidr, err := net.ParseCIDR(c)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid CIDR", c)
+		}
+		if !ip.Equal(cidr.IP) {
+			return fmt.Errorf("%q must be a network address, for example %s", c, cidr.String())
+		}
+		if seen[cidr.String()] {
+			return fmt.Errorf("%q is duplicated", c)
+		}
+		seen[cidr.String()] = true
+	}
+	return nil
+}
+
+// APIServerAllowlistConfig configures the access to the API server of the clusters
+type APIServerAllowlistConfig struct {
+	// KCPEgressCIDRs are always allowed, so that the control plane keeps the access to the restricted clusters
+	KCPEgressCIDRs []string `envconfig:"optional"`
+}
+
+// Validate checks that the control plane ranges are set, without them KEB would lose the access to the restricted clusters
+func (c APIServerAllowlistConfig) Validate() error {
+	if len(c.KCPEgressCIDRs) == 0 {
+		return fmt.Errorf("the KCP egress CIDRs of the API server allowlist are not set")
+	}
+	if err := ValidateAllowedCIDRs(c.KCPEgressCIDRs); err != nil {
+		return fmt.Errorf("invalid KCP egress CIDRs of the API server allowlist: %w", err)
+	}
+	return nil
+}
+
+// AllowedCIDRs adds the control plane ranges to the requested ones, an empty list means no restriction
+func (c APIServerAllowlistConfig) AllowedCIDRs(requested []string) []string {
+	if len(requested) == 0 {
+		return []string{}
+	}
+	cidrs := make([]string, 0, len(requested)+len(c.KCPEgressCIDRs))
+	seen := make(map[string]bool, cap(cidrs))
+	for _, cidr := range append(append([]string{}, requested...), c.KCPEgressCIDRs...) {
+		if cidr == "" || seen[cidr] {
+			continue
+		}
+		seen[cidr] = true
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs
+}
+
+// Subnet returns the index-th subnet with the given prefix length inside the range
+func Subnet(cidr string, prefix, index int) (string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("while parsing CIDR %s: %w", cidr, err)
+	}
+	ones, bits := network.Mask.Size()
+	if bits != 32 || prefix < ones || prefix > bits {
+		return "", fmt.Errorf("range %s cannot be split into /%d subnets", cidr, prefix)
+	}
+	if index < 0 || uint64(index) >= uint64(1)<<uint(prefix-ones) {
+		return "", fmt.Errorf("range %s has no /%d subnet with index %d", cidr, prefix, index)
+	}
+
+	start := binary.BigEndian.Uint32(network.IP.To4()) + uint32(index)<<uint(bits-prefix)
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, start)
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(prefix, bits)}).String(), nil
+}
+
+// AWSZoneSubnets returns the workers, public and internal subnets of the index-th zone. Every zone gets a quarter of
+// the nodes range with the workers subnet in the first half and the public and internal subnets in the second half,
+// so the range fits at most four zones.
+func AWSZoneSubnets(nodes string, index int) (workers, public, internal string, err error) {
+	prefix, err := Prefix(nodes)
+	if err != nil {
+		return "", "", "", err
+	}
+	if workers, err = Subnet(nodes, prefix+3, 2*index); err != nil {
+		return "", "", "", err
+	}
+	if public, err = Subnet(nodes, prefix+4, 4*index+2); err != nil {
+		return "", "", "", err
+	}
+	if internal, err = Subnet(nodes, prefix+4, 4*index+3); err != nil {
+		return "", "", "", err
+	}
+	return workers, public, internal, nil
+}
+
+// AzureZoneSubnet returns the subnet of the index-th zone, every zone gets an eighth of the nodes range,
+// so the range fits at most eight zones
+func AzureZoneSubnet(nodes string, index int) (string, error) {
+	prefix, err := Prefix(nodes)
+	if err != nil {
+		return "", err
+	}
+	return Subnet(nodes, prefix+3, index)
+}
+
+// Prefix returns the prefix length of the range
+func Prefix(cidr string) (int, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, fmt.Errorf("while parsing CIDR %s: %w", cidr, err)
+	}
+	ones, _ := network.Mask.Size()
+	return ones, nil
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func mustParse(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
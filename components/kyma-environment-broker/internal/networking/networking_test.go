@@ -0,0 +1,93 @@
+package networking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for _, testCase := range []struct {
+		nodes       string
+		pods        string
+		services    string
+		expectedErr string
+	}{
+		{nodes: "10.250.0.0/16"},
+		{nodes: "192.168.0.0/23"},
+		{nodes: "10.250.0.0/16", pods: "10.96.0.0/13", services: "10.104.0.0/16"},
+		{nodes: "10.250.0.0", expectedErr: "nodes \"10.250.0.0\" is not a valid CIDR"},
+		{nodes: "10.250.1.0/16", expectedErr: "must be a network address, for example 10.250.0.0/16"},
+		{nodes: "10.0.0.0/8", expectedErr: "prefix length must be between 16 and 23"},
+		{nodes: "10.250.0.0/24", expectedErr: "prefix length must be between 16 and 23"},
+		{nodes: "fd00::/16", expectedErr: "must be an IPv4 range"},
+		{nodes: "100.96.0.0/16", expectedErr: "nodes \"100.96.0.0/16\" overlaps the pods range 100.96.0.0/11"},
+		{nodes: "100.64.0.0/16", expectedErr: "nodes \"100.64.0.0/16\" overlaps the services range 100.64.0.0/13"},
+		{nodes: "169.254.0.0/16", expectedErr: "overlaps the reserved range 169.254.0.0/16"},
+		{nodes: "10.250.0.0/16", pods: "10.96.0.0/20", expectedErr: "pods \"10.96.0.0/20\" prefix length must be between 0 and 16"},
+		{nodes: "10.250.0.0/16", services: "10.104.0.0/24", expectedErr: "services \"10.104.0.0/24\" prefix length must be between 0 and 20"},
+		{nodes: "10.250.0.0/16", pods: "10.0.0.0/8", expectedErr: "nodes \"10.250.0.0/16\" overlaps the pods range 10.0.0.0/8"},
+		{nodes: "10.250.0.0/16", pods: "10.96.0.0/13", services: "10.100.0.0/16", expectedErr: "services \"10.100.0.0/16\" overlaps the pods range 10.96.0.0/13"},
+		{nodes: "10.250.0.0/16", services: "10.104.1.0/16", expectedErr: "services \"10.104.1.0/16\" must be a network address"},
+	} {
+		t.Run(testCase.nodes+" "+testCase.pods+" "+testCase.services, func(t *testing.T) {
+			err := Validate(testCase.nodes, testCase.pods, testCase.services)
+			if testCase.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.expectedErr)
+		})
+	}
+}
+
+func TestSubnet(t *testing.T) {
+	subnet, err := Subnet("10.250.0.0/16", 19, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "10.250.64.0/19", subnet)
+
+	subnet, err = Subnet("192.168.0.0/23", 27, 15)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.224/27", subnet)
+
+	_, err = Subnet("10.250.0.0/16", 18, 4)
+	assert.Error(t, err)
+
+	_, err = Subnet("10.250.0.0/16", 15, 0)
+	assert.Error(t, err)
+}
+
+func TestZoneSubnets(t *testing.T) {
+	workers, public, internal, err := AWSZoneSubnets("10.250.0.0/16", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.250.64.0/19", "10.250.96.0/20", "10.250.112.0/20"}, []string{workers, public, internal})
+
+	_, _, _, err = AWSZoneSubnets("10.250.0.0/23", 4)
+	assert.Error(t, err)
+
+	subnet, err := AzureZoneSubnet("10.250.0.0/16", 7)
+	require.NoError(t, err)
+	assert.Equal(t, "10.250.224.0/19", subnet)
+
+	_, err = AzureZoneSubnet("10.250.0.0/16", 8)
+	assert.Error(t, err)
+}
+
+func TestAPIServerAllowlistConfig(t *testing.T) {
+	t.Run("should require the KCP egress CIDRs", func(t *testing.T) {
+		assert.Error(t, APIServerAllowlistConfig{}.Validate())
+		assert.Error(t, APIServerAllowlistConfig{KCPEgressCIDRs: []string{"192.0.2.1/24"}}.Validate())
+		assert.NoError(t, APIServerAllowlistConfig{KCPEgressCIDRs: []string{"192.0.2.0/24"}}.Validate())
+	})
+
+	t.Run("should add the KCP egress CIDRs to the requested ones", func(t *testing.T) {
+		config := APIServerAllowlistConfig{KCPEgressCIDRs: []string{"192.0.2.0/24"}}
+
+		assert.Equal(t, []string{"203.0.113.0/24", "192.0.2.0/24"}, config.AllowedCIDRs([]string{"203.0.113.0/24"}))
+		assert.Equal(t, []string{"192.0.2.0/24", "203.0.113.0/24"}, config.AllowedCIDRs([]string{"192.0.2.0/24", "203.0.113.0/24"}))
+		assert.Empty(t, config.AllowedCIDRs(nil))
+		assert.Empty(t, config.AllowedCIDRs([]string{}))
+	})
+}
@@ -0,0 +1,92 @@
+package operations
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	internalError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+)
+
+// Queue processes operations of one type
+type Queue interface {
+	Add(operationID string)
+}
+
+type Handler struct {
+	operations storage.Operations
+	steps      storage.OperationSteps
+	queues     map[internal.OperationType]Queue
+
+	log logrus.FieldLogger
+}
+
+// NewHandler creates the handler of the /operations API. The queues define which operation types can be resumed.
+func NewHandler(operations storage.Operations, steps storage.OperationSteps, queues map[internal.OperationType]Queue, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		operations: operations,
+		steps:      steps,
+		queues:     queues,
+		log:        log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/operations/{operation_id}/steps", h.listSteps).Methods(http.MethodGet)
+	router.HandleFunc("/operations/{operation_id}/resume", h.resume).Methods(http.MethodPost)
+}
+
+func (h *Handler) listSteps(w http.ResponseWriter, r *http.Request) {
+	operationID := mux.Vars(r)["operation_id"]
+
+	_, err := h.operations.GetOperationByID(operationID)
+	if err != nil {
+		h.log.Errorf("while getting operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, resolveErrorStatus(err), fmt.Errorf("while getting operation %s: %w", operationID, err))
+		return
+	}
+
+	steps, err := h.steps.ListByOperationID(operationID)
+	if err != nil {
+		h.log.Errorf("while getting steps of operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting steps of operation %s: %w", operationID, err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, commonOperation.StepsResponse{Data: stepsToDTO(steps)})
+}
+
+func stepsToDTO(steps []internal.OperationStep) []commonOperation.StepDTO {
+	dtos := make([]commonOperation.StepDTO, 0, len(steps))
+	for _, s := range steps {
+		dtos = append(dtos, commonOperation.StepDTO{
+			OperationID: s.OperationID,
+			Stage:       s.Stage,
+			Step:        s.Step,
+			Attempt:     s.Attempt,
+			StartedAt:   s.StartedAt,
+			FinishedAt:  s.FinishedAt,
+			Outcome:     s.Outcome,
+			Error:       s.Error,
+		})
+	}
+	return dtos
+}
+
+func resolveErrorStatus(err error) int {
+	cause := internalError.UnwrapAll(err)
+	switch {
+	case dberr.IsNotFound(cause):
+		return http.StatusNotFound
+	case dberr.IsConflict(cause):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
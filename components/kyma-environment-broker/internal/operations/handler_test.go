@@ -0,0 +1,75 @@
+package operations_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operations"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListSteps(t *testing.T) {
+	t.Run("should return steps of the operation", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		operation := fixture.FixProvisioningOperation("op-1", "inst-1")
+		require.NoError(t, db.Operations().InsertOperation(operation))
+
+		start := time.Now()
+		require.NoError(t, db.OperationSteps().Insert(internal.OperationStep{
+			ID: "s-2", OperationID: "op-1", Stage: "create_runtime", Step: "Check_Runtime", Attempt: 1,
+			StartedAt: start.Add(time.Minute), FinishedAt: start.Add(2 * time.Minute), Outcome: commonOperation.StepRetrying,
+		}))
+		require.NoError(t, db.OperationSteps().Insert(internal.OperationStep{
+			ID: "s-1", OperationID: "op-1", Stage: "start", Step: "Starting", Attempt: 1,
+			StartedAt: start, FinishedAt: start.Add(time.Second), Outcome: commonOperation.StepSucceeded,
+		}))
+
+		router := mux.NewRouter()
+		operations.NewHandler(db.Operations(), db.OperationSteps(), nil, logrus.New()).AttachRoutes(router)
+
+		req, err := http.NewRequest(http.MethodGet, "/operations/op-1/steps", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response commonOperation.StepsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Data, 2)
+		assert.Equal(t, "Starting", response.Data[0].Step)
+		assert.Equal(t, commonOperation.StepSucceeded, response.Data[0].Outcome)
+		assert.Equal(t, "Check_Runtime", response.Data[1].Step)
+		assert.Equal(t, commonOperation.StepRetrying, response.Data[1].Outcome)
+	})
+
+	t.Run("should return not found for unknown operation", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		router := mux.NewRouter()
+		operations.NewHandler(db.Operations(), db.OperationSteps(), nil, logrus.New()).AttachRoutes(router)
+
+		req, err := http.NewRequest(http.MethodGet, "/operations/unknown/steps", nil)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,88 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+)
+
+// resume flips a failed operation back to in progress and requeues it. The staged manager skips the finished stages,
+// so the operation continues from the first unfinished stage.
+//
+//	POST /operations/{operation_id}/resume
+func (h *Handler) resume(w http.ResponseWriter, r *http.Request) {
+	operationID := mux.Vars(r)["operation_id"]
+
+	params := commonOperation.ResumeRequest{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			h.log.Errorf("while decoding resume request for operation %s: %v", operationID, err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+			return
+		}
+	}
+
+	operation, err := h.operations.GetOperationByID(operationID)
+	if err != nil {
+		h.log.Errorf("while getting operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, resolveErrorStatus(err), fmt.Errorf("while getting operation %s: %w", operationID, err))
+		return
+	}
+
+	queue, err := h.validateResume(operation)
+	if err != nil {
+		h.log.Errorf("unable to resume operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	operation.State = domain.InProgress
+	operation.Description = "Operation resumed"
+	operation.LastError = kebError.LastError{}
+	// the resumed operation gets a new retry budget for its steps
+	operation.StepAttempts = nil
+	if params.ResetTimeout {
+		operation.TimeoutResetAt = time.Now()
+	}
+	updated, err := h.operations.UpdateOperation(*operation)
+	if err != nil {
+		h.log.Errorf("while updating operation %s: %v", operationID, err)
+		httputil.WriteErrorResponse(w, resolveErrorStatus(err), fmt.Errorf("while updating operation %s: %w", operationID, err))
+		return
+	}
+
+	h.log.Infof("Resuming operation %s of type %s (reset timeout: %t), finished stages: %v", operationID, operation.Type, params.ResetTimeout, operation.FinishedStages)
+	queue.Add(operationID)
+
+	httputil.WriteResponse(w, http.StatusOK, commonOperation.ResumeResponse{
+		OperationID: updated.ID,
+		State:       string(updated.State),
+		Description: updated.Description,
+	})
+}
+
+func (h *Handler) validateResume(operation *internal.Operation) (Queue, error) {
+	if operation.State != domain.Failed {
+		return nil, fmt.Errorf("operation %s is in state %s, only failed operations can be resumed", operation.ID, operation.State)
+	}
+	queue, found := h.queues[operation.Type]
+	if !found {
+		return nil, fmt.Errorf("operations of type %s cannot be resumed", operation.Type)
+	}
+	last, err := h.operations.GetLastOperation(operation.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("while getting the last operation of instance %s: %w", operation.InstanceID, err)
+	}
+	if last.ID != operation.ID {
+		return nil, fmt.Errorf("operation %s is not the last operation of instance %s, the last one is %s", operation.ID, operation.InstanceID, last.ID)
+	}
+	return queue, nil
+}
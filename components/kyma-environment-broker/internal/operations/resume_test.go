@@ -0,0 +1,170 @@
+package operations_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operations"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Resume(t *testing.T) {
+	t.Run("should resume failed operation", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		operation := fixture.FixProvisioningOperation("op-1", "inst-1")
+		operation.State = domain.Failed
+		operation.FinishedStages = []string{"start", "create_runtime"}
+		operation.LastError = kebError.LastError{}.SetMessage("provisioner timeout")
+		operation.StepAttempts = map[string]internal.StepAttempts{"Check_Runtime": {Attempts: 3}}
+		require.NoError(t, db.Operations().InsertOperation(operation))
+		queue := &fakeQueue{}
+		router := fixResumeRouter(db, queue)
+
+		// when
+		rr := callResume(t, router, "op-1", `{"resetTimeout": true}`)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response commonOperation.ResumeResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "op-1", response.OperationID)
+		assert.Equal(t, string(domain.InProgress), response.State)
+		assert.Equal(t, []string{"op-1"}, queue.ids)
+
+		stored, err := db.Operations().GetOperationByID("op-1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, stored.State)
+		assert.Equal(t, []string{"start", "create_runtime"}, stored.FinishedStages)
+		assert.Empty(t, stored.LastError.Error())
+		assert.Empty(t, stored.StepAttempts)
+		assert.WithinDuration(t, time.Now(), stored.TimeoutStartedAt(), time.Minute)
+	})
+
+	t.Run("should keep timeout budget when not requested to reset", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		operation := fixture.FixProvisioningOperation("op-1", "inst-1")
+		operation.State = domain.Failed
+		operation.CreatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, db.Operations().InsertOperation(operation))
+		queue := &fakeQueue{}
+		router := fixResumeRouter(db, queue)
+
+		// when
+		rr := callResume(t, router, "op-1", "")
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		stored, err := db.Operations().GetOperationByID("op-1")
+		require.NoError(t, err)
+		assert.True(t, stored.TimeoutResetAt.IsZero())
+		assert.Equal(t, []string{"op-1"}, queue.ids)
+	})
+
+	t.Run("should not resume operation which is not failed", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		operation := fixture.FixProvisioningOperation("op-1", "inst-1")
+		operation.State = domain.InProgress
+		require.NoError(t, db.Operations().InsertOperation(operation))
+		queue := &fakeQueue{}
+		router := fixResumeRouter(db, queue)
+
+		// when
+		rr := callResume(t, router, "op-1", "")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, queue.ids)
+	})
+
+	t.Run("should not resume operation which is not the last one of the instance", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		failed := fixture.FixProvisioningOperation("op-1", "inst-1")
+		failed.State = domain.Failed
+		failed.CreatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, db.Operations().InsertOperation(failed))
+		deprovisioning := fixture.FixDeprovisioningOperationAsOperation("op-2", "inst-1")
+		deprovisioning.State = domain.InProgress
+		require.NoError(t, db.Operations().InsertOperation(deprovisioning))
+		queue := &fakeQueue{}
+		router := fixResumeRouter(db, queue)
+
+		// when
+		rr := callResume(t, router, "op-1", "")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, queue.ids)
+	})
+
+	t.Run("should not resume operation of unsupported type", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		operation := fixture.FixOperation("op-1", "inst-1", internal.OperationTypeUpgradeKyma)
+		operation.State = domain.Failed
+		require.NoError(t, db.Operations().InsertOperation(operation))
+		queue := &fakeQueue{}
+		router := fixResumeRouter(db, queue)
+
+		// when
+		rr := callResume(t, router, "op-1", "")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, queue.ids)
+	})
+
+	t.Run("should return not found for unknown operation", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		router := fixResumeRouter(db, &fakeQueue{})
+
+		// when
+		rr := callResume(t, router, "unknown", "")
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func fixResumeRouter(db storage.BrokerStorage, queue operations.Queue) *mux.Router {
+	router := mux.NewRouter()
+	operations.NewHandler(db.Operations(), db.OperationSteps(), map[internal.OperationType]operations.Queue{
+		internal.OperationTypeProvision:   queue,
+		internal.OperationTypeUpdate:      queue,
+		internal.OperationTypeDeprovision: queue,
+	}, logrus.New()).AttachRoutes(router)
+	return router
+}
+
+func callResume(t *testing.T, router *mux.Router, operationID, body string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(http.MethodPost, "/operations/"+operationID+"/resume", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+type fakeQueue struct {
+	ids []string
+}
+
+func (q *fakeQueue) Add(operationID string) {
+	q.ids = append(q.ids, operationID)
+}
@@ -1,8 +1,20 @@
 package orchestration
 
+import "time"
+
 type Config struct {
 	KymaVersion       string `envconfig:"-"`
 	KubernetesVersion string `envconfig:"-"`
 	Namespace         string
 	Name              string
 }
+
+// RuntimeResolverConfig selects and configures the resolver of the orchestration runtime targets
+type RuntimeResolverConfig struct {
+	// CacheEnabled switches to the resolver which watches the shoots with an informer instead of listing them on every resolve
+	CacheEnabled bool `envconfig:"default=false"`
+	// ResyncPeriod is the resync period of the shoot informer
+	ResyncPeriod time.Duration `envconfig:"default=30m"`
+	// RuntimeSyncInterval is the minimal interval between syncs of all runtimes from the database
+	RuntimeSyncInterval time.Duration `envconfig:"default=1m"`
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pkg/errors"
@@ -40,6 +41,13 @@ func validateTarget(spec orchestration.TargetSpec) error {
 	if spec.Include == nil || len(spec.Include) == 0 {
 		return errors.New("targets.include array must be not empty")
 	}
+	for _, targets := range [][]orchestration.RuntimeTarget{spec.Include, spec.Exclude} {
+		for _, target := range targets {
+			if _, err := orchestration.NewRuntimeSelectors(target); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -67,3 +75,36 @@ func ValidateScheduleParameter(params *orchestration.Parameters) error {
 	}
 	return nil
 }
+
+// ValidateStrategyParameters checks if the strategy type and its parameters are valid.
+func ValidateStrategyParameters(params orchestration.Parameters) error {
+	switch params.Strategy.Type {
+	case "", orchestration.ParallelStrategy:
+	case orchestration.CanaryStrategy:
+		canary := params.Strategy.Canary
+		if canary.CanarySize < 0 {
+			return fmt.Errorf("canary.canarySize must not be negative")
+		}
+		if canary.GrowthFactor < 0 {
+			return fmt.Errorf("canary.growthFactor must not be negative")
+		}
+		if canary.SuccessRatio < 0 || canary.SuccessRatio > 1 {
+			return fmt.Errorf("canary.successRatio must be between 0 and 1")
+		}
+		if _, err := strategies.CanarySoakTime(canary); err != nil {
+			return fmt.Errorf("invalid canary.soakTime: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported strategy type: %s", params.Strategy.Type)
+	}
+	if params.Strategy.MaxFailures < 0 {
+		return fmt.Errorf("maxFailures must not be negative")
+	}
+	if params.Strategy.MaxFailurePercentage < 0 || params.Strategy.MaxFailurePercentage > 100 {
+		return fmt.Errorf("maxFailurePercentage must be between 0 and 100")
+	}
+	if params.Strategy.MinFinishedOperations < 0 {
+		return fmt.Errorf("minFinishedOperations must not be negative")
+	}
+	return nil
+}
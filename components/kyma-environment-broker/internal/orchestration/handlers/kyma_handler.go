@@ -90,6 +90,14 @@ func (h *kymaHandler) createOrchestration(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// validate strategy
+	err = ValidateStrategyParameters(params)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating strategy: %w", err))
+		return
+	}
+
 	now := time.Now()
 	o := internal.Orchestration{
 		OrchestrationID: uuid.New().String(),
@@ -31,6 +31,7 @@ type orchestrationHandler struct {
 	log       logrus.FieldLogger
 
 	canceler       *Canceler
+	resumer        *Resumer
 	kymaRetryer    *kymaRetryer
 	clusterRetryer *clusterRetryer
 
@@ -53,6 +54,7 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 		defaultMaxPage: defaultMaxPage,
 		converter:      Converter{},
 		canceler:       NewCanceler(orchestrations, log),
+		resumer:        NewResumer(orchestrations, log),
 		kymaRetryer:    NewKymaRetryer(orchestrations, operations, kymaQueue, log),
 		clusterRetryer: NewClusterRetryer(orchestrations, operations, clusterQueue, log),
 	}
@@ -62,6 +64,7 @@ func (h *orchestrationHandler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/orchestrations", h.listOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}", h.getOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/cancel", h.cancelOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/resume", h.resumeOrchestrationByID).Methods(http.MethodPut)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations", h.listOperations).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations/{operation_id}", h.getOperation).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/retry", h.retryOrchestrationByID).Methods(http.MethodPost)
@@ -109,6 +112,21 @@ func (h *orchestrationHandler) cancelOrchestrationByID(w http.ResponseWriter, r
 	httputil.WriteResponse(w, http.StatusOK, response)
 }
 
+func (h *orchestrationHandler) resumeOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.resumer.ResumeForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while resuming orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while resuming orchestration %s: %w", orchestrationID, err))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
 func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *http.Request) {
 	contentType := r.Header.Get("Content-type")
 	if contentType != "application/x-www-form-urlencoded" {
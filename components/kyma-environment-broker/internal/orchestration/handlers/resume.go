@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type Resumer struct {
+	orchestrations storage.Orchestrations
+	log            logrus.FieldLogger
+}
+
+func NewResumer(orchestrations storage.Orchestrations, logger logrus.FieldLogger) *Resumer {
+	return &Resumer{
+		orchestrations: orchestrations,
+		log:            logger,
+	}
+}
+
+// ResumeForID resumes paused orchestration by ID, the orchestration manager continues the strategy execution
+func (r *Resumer) ResumeForID(orchestrationID string) error {
+	o, err := r.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return fmt.Errorf("while getting orchestration: %w", err)
+	}
+	if o.State == orchestrationExt.InProgress {
+		return nil
+	}
+	if o.State != orchestrationExt.Paused {
+		return apiErrors.NewBadRequest(fmt.Sprintf("orchestration is %s, only paused orchestration can be resumed", o.State))
+	}
+
+	r.log.Infof("Resuming orchestration %s paused with: %s", orchestrationID, o.Description)
+	o.UpdatedAt = time.Now()
+	o.Description = "Orchestration was resumed"
+	o.State = orchestrationExt.InProgress
+	err = r.orchestrations.Update(*o)
+	if err != nil {
+		return fmt.Errorf("while updating orchestration: %w", err)
+	}
+	return nil
+}
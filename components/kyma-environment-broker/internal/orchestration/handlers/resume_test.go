@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestResumer_ResumeForID(t *testing.T) {
+	t.Run("should resume paused orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		r := NewResumer(s.Orchestrations(), logrus.New())
+
+		err = r.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		resumed, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, resumed.State)
+	})
+	t.Run("already in progress", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		err := s.Orchestrations().Insert(fixOrchestration())
+		require.NoError(t, err)
+
+		r := NewResumer(s.Orchestrations(), logrus.New())
+
+		err = r.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+	})
+	t.Run("should not resume canceled orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Canceled
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		r := NewResumer(s.Orchestrations(), logrus.New())
+
+		err = r.ResumeForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should return error when orchestration not found", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		r := NewResumer(s.Orchestrations(), logrus.New())
+
+		err := r.ResumeForID(fixOrchestrationID)
+		assert.Error(t, err)
+	})
+}
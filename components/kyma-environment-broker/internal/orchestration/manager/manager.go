@@ -16,7 +16,9 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -50,6 +52,7 @@ type orchestrationManager struct {
 	kubernetesVersion    string
 	bundleBuilder        notification.BundleBuilder
 	speedFactor          int
+	publisher            event.Publisher
 }
 
 const maintenancePolicyKeyName = "maintenancePolicy"
@@ -83,7 +86,8 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		o.Parameters.Kubernetes = &orchestration.KubernetesParameters{KubernetesVersion: m.kubernetesVersion}
 	}
 
-	if o.State == orchestration.Pending || o.State == orchestration.Retrying {
+	started := o.State == orchestration.Pending || o.State == orchestration.Retrying
+	if started {
 		if runtimeNums != 0 {
 			o.State = orchestration.InProgress
 		} else {
@@ -96,6 +100,9 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		logger.Errorf("while updating orchestration: %v", err)
 		return m.pollingInterval, nil
 	}
+	if started {
+		m.publishStateChanged(o)
+	}
 	// do not perform any action if the orchestration is finished
 	if o.IsFinished() {
 		m.log.Infof("Orchestration was already finished, state: %s", o.State)
@@ -104,7 +111,25 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 
 	strategy := m.resolveStrategy(o.Parameters.Strategy.Type, m.executor, logger)
 
-	execID, err := strategy.Execute(operations, o.Parameters.Strategy)
+	if o.State == orchestration.Paused {
+		// the orchestration was paused before the restart, wait for the operator to resume or cancel it
+		o, err = m.waitForResume(o, logger)
+		if err != nil {
+			return 0, fmt.Errorf("while waiting for orchestration to resume: %w", err)
+		}
+		if o.State == orchestration.Canceling {
+			return m.finishOrchestration(o, strategy, logger)
+		}
+	}
+
+	var execID string
+	if waves, ok := strategy.(orchestration.WaveStrategy); ok && o.Progress != nil {
+		// the orchestration was in progress before the restart, continue with the next wave
+		logger.Infof("Continuing orchestration after wave %d", o.Progress.Wave)
+		execID, err = waves.ExecuteFrom(operations, o.Parameters.Strategy, *o.Progress)
+	} else {
+		execID, err = strategy.Execute(operations, o.Parameters.Strategy)
+	}
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute strategy: %w", err)
 	}
@@ -122,11 +147,106 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		logger.Errorf("while updating orchestration: %v", err)
 		return m.pollingInterval, nil
 	}
+	m.publishStateChanged(o)
 
 	logger.Infof("Finished processing orchestration, state: %s", o.State)
 	return 0, nil
 }
 
+// finishOrchestration resolves the final state of the orchestration which was canceled before the strategy was executed
+func (m *orchestrationManager) finishOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, logger logrus.FieldLogger) (time.Duration, error) {
+	stats, err := m.operationStorage.GetOperationStatsForOrchestration(o.OrchestrationID)
+	if err != nil {
+		logger.Errorf("while getting operations: %v", err)
+		return m.pollingInterval, nil
+	}
+	o, err = m.resolveOrchestration(o, strategy, nil, stats)
+	if err != nil && kebError.IsTemporaryError(err) {
+		return 5 * time.Second, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("while resolving orchestration: %w", err)
+	}
+
+	o.UpdatedAt = time.Now()
+	err = m.orchestrationStorage.Update(*o)
+	if err != nil {
+		logger.Errorf("while updating orchestration: %v", err)
+		return m.pollingInterval, nil
+	}
+	m.publishStateChanged(o)
+
+	logger.Infof("Finished processing orchestration, state: %s", o.State)
+	return 0, nil
+}
+
+// waitForResume waits until the paused orchestration is resumed or canceled
+func (m *orchestrationManager) waitForResume(o *internal.Orchestration, log logrus.FieldLogger) (*internal.Orchestration, error) {
+	log.Infof("Orchestration is paused, waiting for resume: %s", o.Description)
+	orchestrationID := o.OrchestrationID
+	err := wait.PollImmediateInfinite(m.pollingInterval, func() (bool, error) {
+		current, err := m.orchestrationStorage.GetByID(orchestrationID)
+		switch {
+		case err == nil:
+			o = current
+			return o.State != orchestration.Paused, nil
+		case dberr.IsNotFound(err):
+			return false, err
+		default:
+			log.Errorf("while getting orchestration: %v", err)
+			return false, nil
+		}
+	})
+	return o, err
+}
+
+// syncPause records the paused strategy executions on the orchestration and resumes the executions when the operator resumed the orchestration.
+// The recorded map holds the executions which pause was already stored.
+func (m *orchestrationManager) syncPause(o *internal.Orchestration, strategy orchestration.PausableStrategy, execIDs []string, recorded map[string]bool, log logrus.FieldLogger) error {
+	for _, execID := range execIDs {
+		paused, reason := strategy.Paused(execID)
+		switch {
+		case !paused:
+			delete(recorded, execID)
+		case !recorded[execID]:
+			if o.State != orchestration.Paused {
+				log.Warnf("Pausing orchestration: %s", reason)
+				o.UpdatedAt = time.Now()
+				o.State = orchestration.Paused
+				o.Description = reason
+				if err := m.orchestrationStorage.Update(*o); err != nil {
+					return fmt.Errorf("while updating orchestration: %w", err)
+				}
+				m.publishStateChanged(o)
+			}
+			recorded[execID] = true
+		case o.State == orchestration.InProgress:
+			log.Infof("Orchestration was resumed")
+			strategy.Resume(execID)
+			delete(recorded, execID)
+		}
+	}
+	return nil
+}
+
+// syncProgress stores the progress of the strategy execution on the orchestration if it changed
+func (m *orchestrationManager) syncProgress(o *internal.Orchestration, strategy orchestration.Strategy, execID string, acknowledgedFailures int) error {
+	progress := orchestration.ExecutionProgress{AcknowledgedFailures: acknowledgedFailures}
+	if waves, ok := strategy.(orchestration.WaveStrategy); ok {
+		current := waves.Progress(execID)
+		progress.Wave = current.Wave
+		progress.NextWaveSize = current.NextWaveSize
+	}
+	if o.Progress != nil && *o.Progress == progress {
+		return nil
+	}
+	o.Progress = &progress
+	o.UpdatedAt = time.Now()
+	if err := m.orchestrationStorage.Update(*o); err != nil {
+		return fmt.Errorf("while updating orchestration: %w", err)
+	}
+	return nil
+}
+
 func (m *orchestrationManager) getMaintenancePolicy() (orchestration.MaintenancePolicy, error) {
 	policy := orchestration.MaintenancePolicy{}
 	config := &coreV1.ConfigMap{}
@@ -323,6 +443,12 @@ func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType,
 			s.SpeedUp(m.speedFactor)
 		}
 		return s
+	case orchestration.CanaryStrategy:
+		s := strategies.NewCanaryOrchestrationStrategy(executor, &operationStates{operations: m.operationStorage}, log, 0)
+		if m.speedFactor != 0 {
+			s.SpeedUp(m.speedFactor)
+		}
+		return s
 	}
 	return nil
 }
@@ -334,6 +460,14 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 	var err error
 	var stats map[string]int
 	execIDs := []string{execID}
+	pausable, isPausable := strategy.(orchestration.PausableStrategy)
+	recordedPauses := map[string]bool{}
+	// number of failed operations when the orchestration was paused because of the failure limits,
+	// the limits are checked again only when more operations fail after resume
+	acknowledgedFailures := 0
+	if o.Progress != nil {
+		acknowledgedFailures = o.Progress.AcknowledgedFailures
+	}
 
 	err = wait.PollImmediateInfinite(m.pollingInterval, func() (bool, error) {
 		// check if orchestration wasn't canceled
@@ -351,6 +485,12 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 			log.Errorf("while getting orchestration: %v", err)
 			return false, nil
 		}
+		if isPausable && !canceled {
+			if err := m.syncPause(o, pausable, execIDs, recordedPauses, log); err != nil {
+				log.Errorf("while synchronizing orchestration pause: %v", err)
+				return false, nil
+			}
+		}
 		s, err := m.operationStorage.GetOperationStatsForOrchestration(o.OrchestrationID)
 		if err != nil {
 			log.Errorf("while getting operations: %v", err)
@@ -358,6 +498,29 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		}
 		stats = s
 
+		failed := stats[orchestration.Failed]
+		if failed < acknowledgedFailures {
+			// failed operations were retried
+			acknowledgedFailures = failed
+		}
+		if isPausable && !canceled && o.State == orchestration.InProgress && failed > acknowledgedFailures {
+			if reason := failureLimitReason(o.Parameters.Strategy, stats); reason != "" {
+				log.Warnf("Failure limit breached: %s", reason)
+				for _, id := range execIDs {
+					pausable.Pause(id, reason)
+				}
+				acknowledgedFailures = failed
+				if err := m.syncPause(o, pausable, execIDs, recordedPauses, log); err != nil {
+					log.Errorf("while synchronizing orchestration pause: %v", err)
+				}
+			}
+		}
+		if isPausable && !canceled {
+			if err := m.syncProgress(o, strategy, execIDs[0], acknowledgedFailures); err != nil {
+				log.Errorf("while storing orchestration progress: %v", err)
+			}
+		}
+
 		numberOfNotFinished := 0
 		numberOfInProgress, found := stats[orchestration.InProgress]
 		if found {
@@ -419,6 +582,42 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 	return m.resolveOrchestration(o, strategy, execIDs, stats)
 }
 
+// failureLimitReason returns the reason to pause the orchestration when the failed operations breach the limits of the strategy,
+// the empty string means the limits are not breached
+func failureLimitReason(spec orchestration.StrategySpec, stats map[string]int) string {
+	failed := stats[orchestration.Failed]
+	if failed == 0 {
+		return ""
+	}
+	if spec.MaxFailures > 0 && failed > spec.MaxFailures {
+		return fmt.Sprintf("orchestration halted: %d operations failed, the maximum number of failures is %d", failed, spec.MaxFailures)
+	}
+	if spec.MaxFailurePercentage == 0 {
+		return ""
+	}
+	// the pending and running operations have no result yet, so only the finished operations are taken into account,
+	// but not before enough operations finished, otherwise the first failure would already breach any percentage
+	finished := failed + stats[orchestration.Succeeded]
+	minFinished := spec.MinFinishedOperations
+	if minFinished == 0 {
+		minFinished = orchestration.DefaultMinFinishedOperations
+	}
+	total := 0
+	for _, count := range stats {
+		total += count
+	}
+	if minFinished > total {
+		minFinished = total
+	}
+	if finished < minFinished {
+		return ""
+	}
+	if failed*100 > spec.MaxFailurePercentage*finished {
+		return fmt.Sprintf("orchestration halted: %d of %d finished operations failed, the maximum failure percentage is %d%%", failed, finished, spec.MaxFailurePercentage)
+	}
+	return ""
+}
+
 func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execIDs []string, stats map[string]int) (*internal.Orchestration, error) {
 	if o.State == orchestration.Canceling {
 		err := m.factory.CancelOperations(o.OrchestrationID)
@@ -563,10 +762,19 @@ func (m *orchestrationManager) updateOrchestration(o *internal.Orchestration, st
 			m.log.Errorf("while updating orchestration: %v", err)
 			return time.Minute
 		}
+		return 0
 	}
+	m.publishStateChanged(o)
 	return 0
 }
 
+// publishStateChanged notifies the subscribers about the stored state of the orchestration
+func (m *orchestrationManager) publishStateChanged(o *internal.Orchestration) {
+	m.publisher.Publish(context.TODO(), process.OrchestrationStateChanged{
+		Orchestration: *o,
+	})
+}
+
 func (m *orchestrationManager) sendNotificationCreate(o *internal.Orchestration, operations []orchestration.RuntimeOperation) error {
 	eventType := ""
 	tenants := []notification.NotificationTenant{}
@@ -710,3 +918,16 @@ func (m *orchestrationManager) waitForStart(o *internal.Orchestration) ([]orches
 	}
 	return operations, len(filterRuntimes), nil
 }
+
+// operationStates provides the states of the orchestrated operations to the strategies
+type operationStates struct {
+	operations storage.Operations
+}
+
+func (s *operationStates) GetOperationState(operationID string) (string, error) {
+	op, err := s.operations.GetOperationByID(operationID)
+	if err != nil {
+		return "", err
+	}
+	return string(op.State), nil
+}
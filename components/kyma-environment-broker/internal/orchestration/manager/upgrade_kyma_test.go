@@ -2,15 +2,18 @@ package manager_test
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
 	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -79,7 +82,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -125,7 +128,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -174,7 +177,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -249,7 +252,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -307,7 +310,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CancelNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -324,6 +327,314 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		assert.Equal(t, orchestration.Canceled, string(op.State))
 	})
 
+	t.Run("Paused after failed canary wave", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+					Canary:   orchestration.CanaryStrategySpec{CanarySize: 1},
+				},
+			},
+		})
+		require.NoError(t, err)
+		for _, opID := range []string{"op-1", "op-2", "op-3"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					InstanceID:      opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					Type:            internal.OperationTypeUpgradeKyma,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		executor := &failFirstTestExecutor{store: store}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, 1000, event.NewPubSub(logrus.New()))
+
+		// when
+		finished := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			finished <- err
+		}()
+
+		// then
+		err = wait.PollImmediate(poolingInterval, 5*time.Second, func() (bool, error) {
+			o, err := store.Orchestrations().GetByID(id)
+			return err == nil && o.State == orchestration.Paused, nil
+		})
+		require.NoError(t, err)
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Contains(t, o.Description, "wave 1 breached the failure threshold")
+		assert.Equal(t, 1, executor.executions())
+		require.NotNil(t, o.Progress)
+		assert.Equal(t, 1, o.Progress.Wave)
+		assert.Equal(t, 2, o.Progress.NextWaveSize)
+
+		// when
+		o.State = orchestration.InProgress
+		require.NoError(t, store.Orchestrations().Update(*o))
+
+		// then
+		select {
+		case err := <-finished:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("orchestration was not resumed")
+		}
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.Equal(t, 3, executor.executions())
+	})
+
+	t.Run("Paused after breaching failure limit", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:                  orchestration.ParallelStrategy,
+					Schedule:              time.Now().Format(time.RFC3339),
+					Parallel:              orchestration.ParallelStrategySpec{Workers: 1},
+					MaxFailurePercentage:  10,
+					MinFinishedOperations: 1,
+				},
+			},
+		})
+		require.NoError(t, err)
+		for _, opID := range []string{"op-1", "op-2", "op-3"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					InstanceID:      opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					Type:            internal.OperationTypeUpgradeKyma,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		executor := &gatedTestExecutor{store: store, gate: make(chan struct{})}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, 1000, event.NewPubSub(logrus.New()))
+
+		// when
+		finished := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			finished <- err
+		}()
+
+		// then
+		err = wait.PollImmediate(poolingInterval, 5*time.Second, func() (bool, error) {
+			o, err := store.Orchestrations().GetByID(id)
+			return err == nil && o.State == orchestration.Paused, nil
+		})
+		require.NoError(t, err)
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, "orchestration halted: 1 of 1 finished operations failed, the maximum failure percentage is 10%", o.Description)
+
+		// when the operation in progress finishes
+		close(executor.gate)
+
+		// then the next operation is not scheduled
+		err = wait.PollImmediate(poolingInterval, 5*time.Second, func() (bool, error) {
+			op, err := store.Operations().GetUpgradeKymaOperationByID(executor.lastExecuted())
+			return err == nil && op.State == orchestration.Succeeded, nil
+		})
+		require.NoError(t, err)
+		time.Sleep(5 * poolingInterval)
+		assert.Equal(t, 2, executor.executions())
+
+		// when
+		o.State = orchestration.InProgress
+		require.NoError(t, store.Orchestrations().Update(*o))
+
+		// then
+		select {
+		case err := <-finished:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("orchestration was not resumed")
+		}
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.Equal(t, 3, executor.executions())
+	})
+
+	t.Run("Not paused when the first of many operations fails", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:                 orchestration.ParallelStrategy,
+					Schedule:             time.Now().Format(time.RFC3339),
+					Parallel:             orchestration.ParallelStrategySpec{Workers: 1},
+					MaxFailurePercentage: 50,
+				},
+			},
+		})
+		require.NoError(t, err)
+		for _, opID := range []string{"op-1", "op-2", "op-3", "op-4"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					InstanceID:      opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					Type:            internal.OperationTypeUpgradeKyma,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		executor := &gatedTestExecutor{store: store, gate: make(chan struct{})}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, 1000, event.NewPubSub(logrus.New()))
+
+		// when
+		finished := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			finished <- err
+		}()
+
+		// then the first failed operation alone does not pause the orchestration
+		err = wait.PollImmediate(poolingInterval, 5*time.Second, func() (bool, error) {
+			stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+			return err == nil && stats[orchestration.Failed] == 1, nil
+		})
+		require.NoError(t, err)
+		time.Sleep(5 * poolingInterval)
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, o.State)
+
+		// when the other operations finish
+		close(executor.gate)
+
+		// then all operations are executed, 1 of 4 failed operations does not breach 50%
+		select {
+		case err := <-finished:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("orchestration did not finish")
+		}
+		o, err = store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.NotContains(t, o.Description, "orchestration halted")
+		assert.Equal(t, 4, executor.executions())
+	})
+
+	t.Run("Continues canary waves after restart", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+					Canary:   orchestration.CanaryStrategySpec{CanarySize: 1},
+				},
+			},
+			Progress: &orchestration.ExecutionProgress{Wave: 1, NextWaveSize: 2},
+		})
+		require.NoError(t, err)
+		for _, opID := range []string{"op-2", "op-3", "op-4"} {
+			err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					InstanceID:      opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					Type:            internal.OperationTypeUpgradeKyma,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		// the executor succeeds all operations
+		executor := &failFirstTestExecutor{store: store, count: 1}
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), executor,
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, nil, 1000, event.NewPubSub(logrus.New()))
+
+		// when
+		_, err = svc.Execute(id)
+
+		// then
+		require.NoError(t, err)
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Succeeded, o.State)
+		// the second wave has 2 operations, the third one the last operation
+		require.NotNil(t, o.Progress)
+		assert.Equal(t, 3, o.Progress.Wave)
+		assert.Equal(t, 8, o.Progress.NextWaveSize)
+	})
+
 	t.Run("Retrying failed orchestration", func(t *testing.T) {
 		// given
 		store := storage.NewMemoryStorage()
@@ -392,7 +703,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -469,7 +780,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -566,7 +877,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -679,7 +990,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -791,7 +1102,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000, event.NewPubSub(logrus.New()))
 
 		// when
 		_, err = svc.Execute(id)
@@ -834,6 +1145,91 @@ func (t *testExecutor) Reschedule(operationID string, maintenanceWindowBegin, ma
 	return nil
 }
 
+// failFirstTestExecutor fails the first executed operation and succeeds all others
+type failFirstTestExecutor struct {
+	mux   sync.Mutex
+	store storage.BrokerStorage
+	count int
+}
+
+func (t *failFirstTestExecutor) Execute(opID string) (time.Duration, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	op, err := t.store.Operations().GetUpgradeKymaOperationByID(opID)
+	if err != nil {
+		return 0, err
+	}
+	op.State = orchestration.Succeeded
+	if t.count == 0 {
+		op.State = orchestration.Failed
+	}
+	t.count++
+	_, err = t.store.Operations().UpdateUpgradeKymaOperation(*op)
+
+	return 0, err
+}
+
+func (t *failFirstTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (t *failFirstTestExecutor) executions() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return t.count
+}
+
+// gatedTestExecutor fails the first executed operation, the other operations succeed once the gate is closed
+type gatedTestExecutor struct {
+	mux      sync.Mutex
+	store    storage.BrokerStorage
+	gate     chan struct{}
+	executed []string
+}
+
+func (t *gatedTestExecutor) Execute(opID string) (time.Duration, error) {
+	t.mux.Lock()
+	first := len(t.executed) == 0
+	t.executed = append(t.executed, opID)
+	t.mux.Unlock()
+
+	if !first {
+		<-t.gate
+	}
+
+	op, err := t.store.Operations().GetUpgradeKymaOperationByID(opID)
+	if err != nil {
+		return 0, err
+	}
+	op.State = orchestration.Succeeded
+	if first {
+		op.State = orchestration.Failed
+	}
+	_, err = t.store.Operations().UpdateUpgradeKymaOperation(*op)
+
+	return 0, err
+}
+
+func (t *gatedTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (t *gatedTestExecutor) executions() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return len(t.executed)
+}
+
+func (t *gatedTestExecutor) lastExecuted() string {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return t.executed[len(t.executed)-1]
+}
+
 type retryTestExecutor struct {
 	store       storage.BrokerStorage
 	upgradeType orchestration.Type
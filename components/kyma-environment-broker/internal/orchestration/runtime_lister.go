@@ -12,23 +12,37 @@ import (
 )
 
 type RuntimeLister struct {
-	instancesDb  storage.Instances
-	operationsDb storage.Operations
-	converter    runtimeInt.Converter
-	log          logrus.FieldLogger
+	instancesDb     storage.Instances
+	operationsDb    storage.Operations
+	runtimeStatesDb storage.RuntimeStates
+	converter       runtimeInt.Converter
+	log             logrus.FieldLogger
 }
 
-func NewRuntimeLister(instancesDb storage.Instances, operationsDb storage.Operations, converter runtimeInt.Converter, log logrus.FieldLogger) *RuntimeLister {
+func NewRuntimeLister(instancesDb storage.Instances, operationsDb storage.Operations, runtimeStatesDb storage.RuntimeStates, converter runtimeInt.Converter, log logrus.FieldLogger) *RuntimeLister {
 	return &RuntimeLister{
-		instancesDb:  instancesDb,
-		operationsDb: operationsDb,
-		converter:    converter,
-		log:          log,
+		instancesDb:     instancesDb,
+		operationsDb:    operationsDb,
+		runtimeStatesDb: runtimeStatesDb,
+		converter:       converter,
+		log:             log,
 	}
 }
 
 func (rl RuntimeLister) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
-	instances, _, _, err := rl.instancesDb.List(dbmodel.InstanceFilter{})
+	return rl.listRuntimes(dbmodel.InstanceFilter{})
+}
+
+// ListRuntimes returns the runtimes with the given IDs
+func (rl RuntimeLister) ListRuntimes(runtimeIDs []string) ([]runtime.RuntimeDTO, error) {
+	if len(runtimeIDs) == 0 {
+		return []runtime.RuntimeDTO{}, nil
+	}
+	return rl.listRuntimes(dbmodel.InstanceFilter{RuntimeIDs: runtimeIDs})
+}
+
+func (rl RuntimeLister) listRuntimes(filter dbmodel.InstanceFilter) ([]runtime.RuntimeDTO, error) {
+	instances, _, _, err := rl.instancesDb.List(filter)
 	if err != nil {
 		return nil, fmt.Errorf("while listing instances from DB: %w", err)
 	}
@@ -66,6 +80,25 @@ func (rl RuntimeLister) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
 
 		runtimes = append(runtimes, dto)
 	}
+	rl.applyKymaVersions(runtimes)
 
 	return runtimes, nil
 }
+
+// applyKymaVersions sets the Kyma versions of all runtimes with a single query, the runtimes stay without a version if it cannot be read
+func (rl RuntimeLister) applyKymaVersions(runtimes []runtime.RuntimeDTO) {
+	runtimeIDs := make([]string, 0, len(runtimes))
+	for _, dto := range runtimes {
+		if dto.RuntimeID != "" {
+			runtimeIDs = append(runtimeIDs, dto.RuntimeID)
+		}
+	}
+	versions, err := rl.runtimeStatesDb.ListLatestKymaVersions(runtimeIDs)
+	if err != nil {
+		rl.log.Errorf("while listing kyma versions of runtimes: %s", err.Error())
+		return
+	}
+	for i := range runtimes {
+		runtimes[i].KymaVersion = versions[runtimes[i].RuntimeID]
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/sirupsen/logrus"
@@ -276,7 +277,7 @@ func newEmptyProvisionerClient() fakeProvisionerClient {
 	return fakeProvisionerClient{true}
 }
 
-func (f fakeProvisionerClient) ProvisionRuntime(accountID, subAccountID string, config gqlschema.ProvisionRuntimeInput) (gqlschema.OperationStatus, error) {
+func (f fakeProvisionerClient) ProvisionRuntime(accountID, subAccountID string, config gqlschema.ProvisionRuntimeInput, extension provisioner.GardenerConfigExtension) (gqlschema.OperationStatus, error) {
 	panic("not implemented")
 }
 
@@ -288,7 +289,7 @@ func (f fakeProvisionerClient) UpgradeRuntime(accountID, runtimeID string, confi
 	panic("not implemented")
 }
 
-func (f fakeProvisionerClient) UpgradeShoot(accountID, runtimeID string, config gqlschema.UpgradeShootInput) (gqlschema.OperationStatus, error) {
+func (f fakeProvisionerClient) UpgradeShoot(accountID, runtimeID string, config gqlschema.UpgradeShootInput, extension provisioner.GardenerUpgradeExtension) (gqlschema.OperationStatus, error) {
 	panic("not implemented")
 }
 
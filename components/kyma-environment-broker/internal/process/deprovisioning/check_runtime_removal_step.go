@@ -14,6 +14,8 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// CheckRuntimeRemovalStep waits until the provisioner removes the runtime. The step is registered with a retry policy,
+// which limits the time the staged manager waits for the provisioner.
 type CheckRuntimeRemovalStep struct {
 	operationManager  *process.OperationManager
 	provisionerClient provisioner.Client
@@ -35,10 +37,6 @@ func (s *CheckRuntimeRemovalStep) Name() string {
 }
 
 func (s *CheckRuntimeRemovalStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > CheckStatusTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CheckStatusTimeout), nil, log)
-	}
 	if operation.ProvisionerOperationID == "" {
 		log.Infof("ProvisionerOperationID is empty, skipping (there is no runtime)")
 		return operation, 0, nil
@@ -71,8 +69,11 @@ func (s *CheckRuntimeRemovalStep) Run(operation internal.Operation, log logrus.F
 		return operation, 0, nil
 	case gqlschema.OperationStateInProgress:
 		return operation, 30 * time.Second, nil
-	case gqlschema.OperationStatePending:
+	case gqlschema.OperationStatePending, provisioner.OperationStateCanceling:
 		return operation, 30 * time.Second, nil
+	case provisioner.OperationStateCanceled:
+		lastErr := provisioner.OperationStatusLastError(status.LastError)
+		return s.operationManager.OperationFailed(operation, "provisioner operation was canceled", lastErr, log)
 	case gqlschema.OperationStateFailed:
 		lastErr := provisioner.OperationStatusLastError(status.LastError)
 		return s.operationManager.OperationFailed(operation, "provisioner client returns failed status", lastErr, log)
@@ -1,7 +1,6 @@
 package deprovisioning
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
@@ -16,19 +15,19 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 )
 
+// RemoveRuntimeStep requests the runtime deprovisioning from the provisioner. The step is registered with a retry policy,
+// which limits the time the staged manager retries the request.
 type RemoveRuntimeStep struct {
-	operationManager   *process.OperationManager
-	instanceStorage    storage.Instances
-	provisionerClient  provisioner.Client
-	provisionerTimeout time.Duration
+	operationManager  *process.OperationManager
+	instanceStorage   storage.Instances
+	provisionerClient provisioner.Client
 }
 
-func NewRemoveRuntimeStep(os storage.Operations, is storage.Instances, cli provisioner.Client, provisionerTimeout time.Duration) *RemoveRuntimeStep {
+func NewRemoveRuntimeStep(os storage.Operations, is storage.Instances, cli provisioner.Client) *RemoveRuntimeStep {
 	return &RemoveRuntimeStep{
-		operationManager:   process.NewOperationManager(os),
-		instanceStorage:    is,
-		provisionerClient:  cli,
-		provisionerTimeout: provisionerTimeout,
+		operationManager:  process.NewOperationManager(os),
+		instanceStorage:   is,
+		provisionerClient: cli,
 	}
 }
 
@@ -37,11 +36,6 @@ func (s *RemoveRuntimeStep) Name() string {
 }
 
 func (s *RemoveRuntimeStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > s.provisionerTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.provisionerTimeout), nil, log)
-	}
-
 	instance, err := s.instanceStorage.GetByID(operation.InstanceID)
 	switch {
 	case err == nil:
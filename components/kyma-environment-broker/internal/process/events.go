@@ -55,3 +55,7 @@ type OperationStepProcessed struct {
 type OperationSucceeded struct {
 	Operation internal.Operation
 }
+
+type OrchestrationStateChanged struct {
+	Orchestration internal.Orchestration
+}
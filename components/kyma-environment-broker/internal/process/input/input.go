@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	cloudProvider "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
@@ -426,7 +427,7 @@ func falseIfNil(p *bool) bool {
 func (r *RuntimeInput) CreateProvisionClusterInput() (gqlschema.ProvisionRuntimeInput, error) {
 	result, err := r.CreateProvisionRuntimeInput()
 	if err != nil {
-		return gqlschema.ProvisionRuntimeInput{}, nil
+		return gqlschema.ProvisionRuntimeInput{}, err
 	}
 	result.KymaConfig = nil
 	return result, nil
@@ -471,7 +472,7 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 
 	r.hyperscalerInputProvider.ApplyParameters(r.provisionRuntimeInput.ClusterConfig, r.provisioningParameters)
 
-	return nil
+	return cloudProvider.ApplyNetworking(r.provisionRuntimeInput.ClusterConfig, params.Networking)
 }
 
 func (r *RuntimeInput) applyProvisioningParametersForUpgradeShoot() error {
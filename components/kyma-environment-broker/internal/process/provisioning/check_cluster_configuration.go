@@ -14,34 +14,28 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-// CheckClusterConfigurationStep checks if the SKR configuration is applied (by reconciler)
+// CheckClusterConfigurationStep checks if the SKR configuration is applied (by reconciler).
+// The step is registered with a retry policy, which limits the time the staged manager waits for the reconciler.
 type CheckClusterConfigurationStep struct {
-	reconcilerClient    reconciler.Client
-	operationManager    *process.OperationManager
-	provisioningTimeout time.Duration
+	reconcilerClient reconciler.Client
+	operationManager *process.OperationManager
 }
 
-func NewCheckClusterConfigurationStep(os storage.Operations,
-	reconcilerClient reconciler.Client,
-	provisioningTimeout time.Duration) *CheckClusterConfigurationStep {
+func NewCheckClusterConfigurationStep(os storage.Operations, reconcilerClient reconciler.Client) *CheckClusterConfigurationStep {
 	return &CheckClusterConfigurationStep{
-		reconcilerClient:    reconcilerClient,
-		operationManager:    process.NewOperationManager(os),
-		provisioningTimeout: provisioningTimeout,
+		reconcilerClient: reconcilerClient,
+		operationManager: process.NewOperationManager(os),
 	}
 }
 
 var _ process.Step = (*CheckClusterConfigurationStep)(nil)
+var _ process.RetriesExhaustedHandler = (*CheckClusterConfigurationStep)(nil)
 
 func (s *CheckClusterConfigurationStep) Name() string {
 	return "Check_Cluster_Configuration"
 }
 
 func (s *CheckClusterConfigurationStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > s.provisioningTimeout {
-		return s.handleTimeout(operation, log)
-	}
-
 	state, err := s.reconcilerClient.GetCluster(operation.RuntimeID, operation.ClusterConfigurationVersion)
 	if kebError.IsTemporaryError(err) {
 		log.Errorf("Reconciler GetCluster method failed (temporary error, retrying): %s", err.Error())
@@ -72,8 +66,8 @@ func (s *CheckClusterConfigurationStep) Run(operation internal.Operation, log lo
 	}
 }
 
-func (s *CheckClusterConfigurationStep) handleTimeout(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	log.Warnf("Operation has reached the time limit (%v): updated operation time: %s", s.provisioningTimeout, operation.UpdatedAt)
+// OnRetriesExhausted deletes the cluster configuration when the reconciliation does not finish in time
+func (s *CheckClusterConfigurationStep) OnRetriesExhausted(operation internal.Operation, description string, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
 	log.Infof("Deleting cluster %s", operation.RuntimeID)
 	operation.EventInfof("Deleting cluster configuration due to check cluster configuration timeout")
 	/*
@@ -90,5 +84,5 @@ func (s *CheckClusterConfigurationStep) handleTimeout(operation internal.Operati
 	if err != nil {
 		log.Errorf("Unable to delete cluster: %s", err.Error())
 	}
-	return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.provisioningTimeout), err, log)
+	return s.operationManager.OperationFailed(operation, description, err, log)
 }
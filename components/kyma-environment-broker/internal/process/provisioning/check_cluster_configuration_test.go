@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/euaccess"
 
@@ -62,7 +61,7 @@ func TestCheckClusterConfigurationStep_ClusterReady(t *testing.T) {
 	})
 	recClient.ChangeClusterState(operation.RuntimeID, 1, reconcilerApi.StatusReady)
 
-	step := NewCheckClusterConfigurationStep(st.Operations(), recClient, time.Minute)
+	step := NewCheckClusterConfigurationStep(st.Operations(), recClient)
 	st.Operations().InsertOperation(operation)
 
 	// when
@@ -89,7 +88,7 @@ func TestCheckClusterConfigurationStep_InProgress(t *testing.T) {
 			})
 			recClient.ChangeClusterState(operation.RuntimeID, 1, state)
 
-			step := NewCheckClusterConfigurationStep(st.Operations(), recClient, time.Minute)
+			step := NewCheckClusterConfigurationStep(st.Operations(), recClient)
 			st.Operations().InsertOperation(operation)
 
 			// when
@@ -116,7 +115,7 @@ func TestCheckClusterConfigurationStep_ClusterFailed(t *testing.T) {
 	})
 	recClient.ChangeClusterState(operation.RuntimeID, 1, reconcilerApi.StatusError)
 
-	step := NewCheckClusterConfigurationStep(st.Operations(), recClient, time.Minute)
+	step := NewCheckClusterConfigurationStep(st.Operations(), recClient)
 	st.Operations().InsertOperation(operation)
 
 	// when
@@ -127,6 +126,32 @@ func TestCheckClusterConfigurationStep_ClusterFailed(t *testing.T) {
 	assert.Zero(t, d)
 }
 
+func TestCheckClusterConfigurationStep_RetriesExhausted(t *testing.T) {
+	st := storage.NewMemoryStorage()
+	operation := fixture.FixProvisioningOperation("op-id", "inst-id")
+	operation.ClusterConfigurationVersion = 1
+	recClient := reconciler.NewFakeClient()
+	recClient.ApplyClusterConfig(reconcilerApi.Cluster{
+		RuntimeID:    operation.RuntimeID,
+		RuntimeInput: reconcilerApi.RuntimeInput{},
+		KymaConfig:   reconcilerApi.KymaConfig{},
+		Metadata:     reconcilerApi.Metadata{},
+		Kubeconfig:   "kubeconfig",
+	})
+	recClient.ChangeClusterState(operation.RuntimeID, 1, reconcilerApi.StatusReconciling)
+
+	step := NewCheckClusterConfigurationStep(st.Operations(), recClient)
+	st.Operations().InsertOperation(operation)
+
+	// when
+	op, d, _ := step.OnRetriesExhausted(operation, "step exhausted its retries", logger.NewLogSpy().Logger)
+
+	// then
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Zero(t, d)
+	assert.True(t, recClient.IsBeingDeleted(operation.RuntimeID))
+}
+
 func fixOperationCreateRuntime(t *testing.T, planID, region string) internal.Operation {
 	return fixOperationCreateRuntimeWithPlatformRegion(t, planID, region, "")
 }
@@ -13,20 +13,17 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
-// CheckRuntimeStep checks if the SKR is provisioned
+// CheckRuntimeStep checks if the SKR is provisioned. The step is registered with a retry policy,
+// which limits the time the staged manager waits for the provisioner.
 type CheckRuntimeStep struct {
-	provisionerClient   provisioner.Client
-	operationManager    *process.OperationManager
-	provisioningTimeout time.Duration
+	provisionerClient provisioner.Client
+	operationManager  *process.OperationManager
 }
 
-func NewCheckRuntimeStep(os storage.Operations,
-	provisionerClient provisioner.Client,
-	provisioningTimeout time.Duration) *CheckRuntimeStep {
+func NewCheckRuntimeStep(os storage.Operations, provisionerClient provisioner.Client) *CheckRuntimeStep {
 	return &CheckRuntimeStep{
-		provisionerClient:   provisionerClient,
-		operationManager:    process.NewOperationManager(os),
-		provisioningTimeout: provisioningTimeout,
+		provisionerClient: provisionerClient,
+		operationManager:  process.NewOperationManager(os),
 	}
 }
 
@@ -45,11 +42,6 @@ func (s *CheckRuntimeStep) Run(operation internal.Operation, log logrus.FieldLog
 }
 
 func (s *CheckRuntimeStep) checkRuntimeStatus(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > s.provisioningTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.provisioningTimeout), nil, log)
-	}
-
 	if operation.ProvisionerOperationID == "" {
 		msg := "Operation does not contain Provisioner Operation ID"
 		log.Error(msg)
@@ -68,8 +60,11 @@ func (s *CheckRuntimeStep) checkRuntimeStatus(operation internal.Operation, log
 		return operation, 0, nil
 	case gqlschema.OperationStateInProgress:
 		return operation, 2 * time.Minute, nil
-	case gqlschema.OperationStatePending:
+	case gqlschema.OperationStatePending, provisioner.OperationStateCanceling:
 		return operation, 2 * time.Minute, nil
+	case provisioner.OperationStateCanceled:
+		lastErr := provisioner.OperationStatusLastError(status.LastError)
+		return s.operationManager.OperationFailed(operation, "provisioner operation was canceled", lastErr, log)
 	case gqlschema.OperationStateFailed:
 		lastErr := provisioner.OperationStatusLastError(status.LastError)
 		return s.operationManager.OperationFailed(operation, "provisioner client returns failed status", lastErr, log)
@@ -2,7 +2,6 @@ package provisioning
 
 import (
 	"testing"
-	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
@@ -26,6 +25,11 @@ func TestCheckRuntimeStep_RunProvisioningSucceeded(t *testing.T) {
 			provisionerStatus: gqlschema.OperationStateInProgress,
 			expectedRepeat:    true,
 		},
+		{
+			name:              "Canceling",
+			provisionerStatus: provisioner.OperationStateCanceling,
+			expectedRepeat:    true,
+		},
 		{
 			name:              "Succeeded",
 			provisionerStatus: gqlschema.OperationStateSucceeded,
@@ -49,7 +53,7 @@ func TestCheckRuntimeStep_RunProvisioningSucceeded(t *testing.T) {
 			err := st.Operations().InsertOperation(operation)
 			assert.NoError(t, err)
 
-			step := NewCheckRuntimeStep(st.Operations(), provisionerClient, time.Second)
+			step := NewCheckRuntimeStep(st.Operations(), provisionerClient)
 
 			// when
 			operation, repeat, err := step.Run(operation, logrus.New())
@@ -61,3 +65,30 @@ func TestCheckRuntimeStep_RunProvisioningSucceeded(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRuntimeStep_RunProvisioningCanceled(t *testing.T) {
+	// given
+	provisionerClient := provisioner.NewFakeClient()
+	provisionerClient.SetOperation(statusProvisionerOperationID, gqlschema.OperationStatus{
+		ID:        ptr.String(statusProvisionerOperationID),
+		Operation: gqlschema.OperationTypeProvision,
+		State:     provisioner.OperationStateCanceled,
+		Message:   nil,
+		RuntimeID: ptr.String(statusRuntimeID),
+	})
+	st := storage.NewMemoryStorage()
+	operation := fixOperationRuntimeStatus(broker.GCPPlanID, internal.GCP)
+	operation.RuntimeID = statusRuntimeID
+	err := st.Operations().InsertOperation(operation)
+	assert.NoError(t, err)
+
+	step := NewCheckRuntimeStep(st.Operations(), provisionerClient)
+
+	// when
+	operation, repeat, err := step.Run(operation, logrus.New())
+
+	// then
+	assert.Error(t, err)
+	assert.Zero(t, repeat)
+	assert.Equal(t, domain.Failed, operation.State)
+}
@@ -34,11 +34,6 @@ func (s *CreateRuntimeForOwnCluster) Run(operation internal.Operation, log logru
 		log.Infof("RuntimeID already set %s, skipping", operation.RuntimeID)
 		return operation, 0, nil
 	}
-	if time.Since(operation.UpdatedAt) > CreateRuntimeTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CreateRuntimeTimeout), nil, log)
-	}
-
 	runtimeID := uuid.New().String()
 
 	operation, repeat, _ := s.operationManager.UpdateOperation(operation, func(operation *internal.Operation) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -15,7 +16,7 @@ import (
 )
 
 const (
-	// the time after which the operation is marked as expired
+	// CreateRuntimeTimeout limits the retries of the runtime creation steps, it is applied by their retry policy
 	CreateRuntimeTimeout = 1 * time.Hour
 
 	brokerKeyPrefix = "broker_"
@@ -27,14 +28,17 @@ type CreateRuntimeWithoutKymaStep struct {
 	instanceStorage     storage.Instances
 	runtimeStateStorage storage.RuntimeStates
 	provisionerClient   provisioner.Client
+	allowlistConfig     networking.APIServerAllowlistConfig
 }
 
-func NewCreateRuntimeWithoutKymaStep(os storage.Operations, runtimeStorage storage.RuntimeStates, is storage.Instances, cli provisioner.Client) *CreateRuntimeWithoutKymaStep {
+func NewCreateRuntimeWithoutKymaStep(os storage.Operations, runtimeStorage storage.RuntimeStates, is storage.Instances, cli provisioner.Client,
+	allowlistConfig networking.APIServerAllowlistConfig) *CreateRuntimeWithoutKymaStep {
 	return &CreateRuntimeWithoutKymaStep{
 		operationManager:    process.NewOperationManager(os),
 		instanceStorage:     is,
 		provisionerClient:   cli,
 		runtimeStateStorage: runtimeStorage,
+		allowlistConfig:     allowlistConfig,
 	}
 }
 
@@ -47,11 +51,6 @@ func (s *CreateRuntimeWithoutKymaStep) Run(operation internal.Operation, log log
 		log.Infof("RuntimeID already set %s, skipping", operation.RuntimeID)
 		return operation, 0, nil
 	}
-	if time.Since(operation.UpdatedAt) > CreateRuntimeTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CreateRuntimeTimeout), nil, log)
-	}
-
 	requestInput, err := s.createProvisionInput(operation)
 	if err != nil {
 		log.Errorf("Unable to create provisioning input: %s", err.Error())
@@ -64,7 +63,7 @@ func (s *CreateRuntimeWithoutKymaStep) Run(operation internal.Operation, log log
 		requestInput.ClusterConfig.GardenerConfig.Provider,
 		requestInput.ClusterConfig.GardenerConfig.Name)
 
-	provisionerResponse, err := s.provisionerClient.ProvisionRuntime(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.ProvisioningParameters.ErsContext.SubAccountID, requestInput)
+	provisionerResponse, err := s.provisionerClient.ProvisionRuntime(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.ProvisioningParameters.ErsContext.SubAccountID, requestInput, s.createGardenerConfigExtension(operation))
 	switch {
 	case kebError.IsTemporaryError(err):
 		log.Errorf("call to provisioner failed (temporary error): %s", err)
@@ -146,3 +145,15 @@ func (s *CreateRuntimeWithoutKymaStep) createProvisionInput(operation internal.O
 
 	return request, nil
 }
+
+func (s *CreateRuntimeWithoutKymaStep) createGardenerConfigExtension(operation internal.Operation) provisioner.GardenerConfigExtension {
+	extension := provisioner.GardenerConfigExtension{}
+	if params := operation.ProvisioningParameters.Parameters.Networking; params != nil {
+		extension.PodsCidr = params.PodsCidr
+		extension.ServicesCidr = params.ServicesCidr
+	}
+	if requested := operation.ProvisioningParameters.Parameters.APIServerAllowedCIDRs; len(requested) > 0 {
+		extension.APIServerAllowedCidrs = s.allowlistConfig.AllowedCIDRs(requested)
+	}
+	return extension
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	provisionerAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -38,7 +40,7 @@ func TestCreateRuntimeWithoutKyma_Run(t *testing.T) {
 			return reflect.DeepEqual(input.RuntimeInput.Labels, provisionerInput.RuntimeInput.Labels) &&
 				input.KymaConfig == nil && reflect.DeepEqual(input.ClusterConfig, provisionerInput.ClusterConfig)
 		},
-	)).Return(gqlschema.OperationStatus{
+	), provisioner.GardenerConfigExtension{}).Return(gqlschema.OperationStatus{
 		ID:        ptr.String(provisionerOperationID),
 		Operation: "",
 		State:     "",
@@ -46,7 +48,7 @@ func TestCreateRuntimeWithoutKyma_Run(t *testing.T) {
 		RuntimeID: ptr.String(runtimeID),
 	}, nil)
 
-	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient)
+	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient, networking.APIServerAllowlistConfig{})
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -84,7 +86,7 @@ func TestCreateRuntimeWithoutKyma_RunWithEuAccess(t *testing.T) {
 			return reflect.DeepEqual(input.RuntimeInput.Labels, provisionerInput.RuntimeInput.Labels) &&
 				input.KymaConfig == nil && reflect.DeepEqual(input.ClusterConfig, provisionerInput.ClusterConfig)
 		},
-	)).Return(gqlschema.OperationStatus{
+	), provisioner.GardenerConfigExtension{}).Return(gqlschema.OperationStatus{
 		ID:        ptr.String(provisionerOperationID),
 		Operation: "",
 		State:     "",
@@ -92,7 +94,7 @@ func TestCreateRuntimeWithoutKyma_RunWithEuAccess(t *testing.T) {
 		RuntimeID: ptr.String(runtimeID),
 	}, nil)
 
-	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient)
+	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient, networking.APIServerAllowlistConfig{})
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -187,9 +189,9 @@ func TestCreateRuntimeWithoutKymaStep_RunWithBadRequestError(t *testing.T) {
 	assert.NoError(t, err)
 
 	provisionerClient := &provisionerAutomock.Client{}
-	provisionerClient.On("ProvisionRuntime", globalAccountID, subAccountID, mock.Anything).Return(gqlschema.OperationStatus{}, fmt.Errorf("some permanent error"))
+	provisionerClient.On("ProvisionRuntime", globalAccountID, subAccountID, mock.Anything, provisioner.GardenerConfigExtension{}).Return(gqlschema.OperationStatus{}, fmt.Errorf("some permanent error"))
 
-	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient)
+	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient, networking.APIServerAllowlistConfig{})
 
 	// when
 	entry := log.WithFields(logrus.Fields{"step": "TEST"})
@@ -198,3 +200,38 @@ func TestCreateRuntimeWithoutKymaStep_RunWithBadRequestError(t *testing.T) {
 	// then
 	assert.Equal(t, domain.Failed, operation.State)
 }
+
+func TestCreateRuntimeWithoutKyma_SendsAPIServerAllowlist(t *testing.T) {
+	// given
+	log := logrus.New()
+	memoryStorage := storage.NewMemoryStorage()
+
+	operation := fixOperationCreateRuntime(t, broker.AzurePlanID, "westeurope")
+	operation.ProvisioningParameters.Parameters.APIServerAllowedCIDRs = []string{"203.0.113.0/24"}
+	err := memoryStorage.Operations().InsertOperation(operation)
+	assert.NoError(t, err)
+
+	err = memoryStorage.Instances().Insert(fixInstance())
+	assert.NoError(t, err)
+
+	provisionerClient := &provisionerAutomock.Client{}
+	provisionerClient.On("ProvisionRuntime", globalAccountID, subAccountID, mock.Anything, provisioner.GardenerConfigExtension{
+		APIServerAllowedCidrs: []string{"203.0.113.0/24", "192.0.2.0/24"},
+	}).Return(gqlschema.OperationStatus{
+		ID:        ptr.String(provisionerOperationID),
+		RuntimeID: ptr.String(runtimeID),
+	}, nil)
+
+	step := NewCreateRuntimeWithoutKymaStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Instances(), provisionerClient,
+		networking.APIServerAllowlistConfig{KCPEgressCIDRs: []string{"192.0.2.0/24"}})
+
+	// when
+	entry := log.WithFields(logrus.Fields{"step": "TEST"})
+	operation, repeat, err := step.Run(operation, entry)
+
+	// then
+	assert.NoError(t, err)
+	assert.Zero(t, repeat)
+	assert.Equal(t, provisionerOperationID, operation.ProvisionerOperationID)
+	provisionerClient.AssertExpectations(t)
+}
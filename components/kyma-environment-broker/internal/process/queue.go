@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/workqueue"
@@ -14,12 +15,31 @@ type Executor interface {
 	Execute(operationID string) (time.Duration, error)
 }
 
+// PersistentQueueConfig holds configuration of queues which store their schedule in the database
+type PersistentQueueConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// LeaseDuration defines how long an operation is owned by a broker replica before other replicas can pick it up
+	LeaseDuration time.Duration `envconfig:"default=10m"`
+	// PollingInterval defines how often the database is checked for operations which are due
+	PollingInterval time.Duration `envconfig:"default=5s"`
+}
+
 type Queue struct {
 	queue     workqueue.RateLimitingInterface
 	executor  Executor
 	waitGroup sync.WaitGroup
 	log       logrus.FieldLogger
 
+	// the following fields are set only for the persistent queue
+	name        string
+	owner       string
+	store       storage.OperationsQueue
+	cfg         PersistentQueueConfig
+	leasedMu    sync.Mutex
+	leased      map[string]struct{}
+	wakeUp      chan struct{}
+	workerCount int
+
 	speedFactor int64
 }
 
@@ -34,12 +54,50 @@ func NewQueue(executor Executor, log logrus.FieldLogger) *Queue {
 	}
 }
 
+// NewPersistentQueue creates a queue which stores the next run time and the lease owner of every operation
+// in the database. Pending retries survive restarts and operations are shared between all broker replicas
+// which use the same queue name - every replica leases only the operations which are due.
+func NewPersistentQueue(name, owner string, executor Executor, store storage.OperationsQueue, cfg PersistentQueueConfig, log logrus.FieldLogger) *Queue {
+	q := NewQueue(executor, log.WithField("queue", name))
+	q.name = name
+	q.owner = owner
+	q.store = store
+	q.cfg = cfg
+	q.leased = make(map[string]struct{})
+	q.wakeUp = make(chan struct{}, 1)
+	return q
+}
+
 func (q *Queue) Add(processId string) {
-	q.queue.Add(processId)
+	if q.store == nil {
+		q.queue.Add(processId)
+		return
+	}
+	q.schedule(processId, time.Now())
+	q.notify()
+}
+
+// Resume adds the not finished operation found at startup. The persistent queue schedules it only if it is
+// neither leased by a replica nor waiting for a retry, so operations added while the queue was in memory are not lost.
+func (q *Queue) Resume(processId string) {
+	if q.store == nil {
+		q.queue.Add(processId)
+		return
+	}
+	if err := q.store.ScheduleIfIdle(q.name, processId, time.Now()); err != nil {
+		q.log.Errorf("unable to resume operation %s, falling back to in-memory queue: %s", processId, err)
+		q.queue.Add(processId)
+		return
+	}
+	q.notify()
 }
 
 func (q *Queue) AddAfter(processId string, duration time.Duration) {
-	q.queue.AddAfter(processId, duration)
+	if q.store == nil {
+		q.queue.AddAfter(processId, duration)
+		return
+	}
+	q.schedule(processId, time.Now().Add(duration))
 }
 
 func (q *Queue) ShutDown() {
@@ -51,6 +109,11 @@ func (q *Queue) Run(stop <-chan struct{}, workersAmount int) {
 		q.waitGroup.Add(1)
 		q.createWorker(q.queue, q.executor.Execute, stop, &q.waitGroup, q.log)
 	}
+	if q.store != nil {
+		q.workerCount = workersAmount
+		go q.poll(stop)
+		go q.heartbeat(stop)
+	}
 }
 
 // SpeedUp changes speedFactor parameter to reduce time between processing operations.
@@ -88,6 +151,9 @@ func (q *Queue) worker(queue workqueue.RateLimitingInterface, process func(key s
 				if err == nil && when != 0 {
 					log.Infof("Adding %q item after %s", id, when)
 					afterDuration := time.Duration(int64(when) / q.speedFactor)
+					if q.finish(id, &afterDuration) {
+						return false
+					}
 					queue.AddAfter(key, afterDuration)
 					return false
 				}
@@ -95,9 +161,120 @@ func (q *Queue) worker(queue workqueue.RateLimitingInterface, process func(key s
 					log.Errorf("Error from process: %v", err)
 				}
 
+				q.finish(id, nil)
 				queue.Forget(key)
 				return false
 			}()
 		}
 	}
 }
+
+// poll periodically leases operations which are due and passes them to the workers
+func (q *Queue) poll(stop <-chan struct{}) {
+	interval := time.Duration(int64(q.cfg.PollingInterval) / q.speedFactor)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		q.lease()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		case <-q.wakeUp:
+		}
+	}
+}
+
+// heartbeat prolongs the leases of the operations which are still processed, so a step running longer
+// than the lease duration is not picked up by another replica
+func (q *Queue) heartbeat(stop <-chan struct{}) {
+	interval := time.Duration(int64(q.cfg.LeaseDuration) / 3 / q.speedFactor)
+	if interval <= 0 {
+		interval = time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			q.extendLeases()
+		}
+	}
+}
+
+func (q *Queue) extendLeases() {
+	q.leasedMu.Lock()
+	ids := make([]string, 0, len(q.leased))
+	for id := range q.leased {
+		ids = append(ids, id)
+	}
+	q.leasedMu.Unlock()
+
+	if err := q.store.ExtendLeases(q.owner, ids, q.cfg.LeaseDuration); err != nil {
+		q.log.Errorf("unable to extend leases: %s", err)
+	}
+}
+
+func (q *Queue) lease() {
+	q.leasedMu.Lock()
+	defer q.leasedMu.Unlock()
+
+	free := q.workerCount - len(q.leased)
+	if free <= 0 {
+		return
+	}
+	ids, err := q.store.Lease(q.name, q.owner, q.cfg.LeaseDuration, free)
+	if err != nil {
+		q.log.Errorf("unable to lease operations: %s", err)
+		return
+	}
+	for _, id := range ids {
+		q.leased[id] = struct{}{}
+		q.queue.Add(id)
+	}
+}
+
+func (q *Queue) schedule(id string, runAt time.Time) {
+	if err := q.store.Schedule(q.name, id, runAt); err != nil {
+		q.log.Errorf("unable to schedule operation %s, falling back to in-memory queue: %s", id, err)
+		q.queue.AddAfter(id, time.Until(runAt))
+	}
+}
+
+// finish releases the lease of the processed operation and schedules its next run, if needed.
+// It returns false if the operation was not leased from the database.
+func (q *Queue) finish(id string, after *time.Duration) bool {
+	if q.store == nil {
+		return false
+	}
+	q.leasedMu.Lock()
+	_, leased := q.leased[id]
+	delete(q.leased, id)
+	q.leasedMu.Unlock()
+	if !leased {
+		return false
+	}
+	defer q.notify()
+
+	var err error
+	if after != nil {
+		err = q.store.Reschedule(id, q.owner, time.Now().Add(*after))
+	} else {
+		err = q.store.Release(id, q.owner)
+	}
+	if err != nil {
+		q.log.Errorf("unable to release operation %s: %s", id, err)
+	}
+	return true
+}
+
+func (q *Queue) notify() {
+	select {
+	case q.wakeUp <- struct{}{}:
+	default:
+	}
+}
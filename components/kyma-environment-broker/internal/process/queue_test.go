@@ -0,0 +1,170 @@
+package process_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestPersistentQueue_RetriesOperation(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := storage.NewMemoryStorage()
+	executor := &countingExecutor{retries: map[string]int{"op-1": 2}}
+	queue := process.NewPersistentQueue("provisioning", "owner-1", executor, db.OperationsQueue(), fixPersistentQueueConfig(), logrus.New())
+	queue.Run(ctx.Done(), 2)
+
+	// when
+	queue.Add("op-1")
+
+	// then
+	err := wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return executor.count("op-1") == 3, nil
+	})
+	require.NoError(t, err)
+
+	ids, err := db.OperationsQueue().Lease("provisioning", "owner-2", time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestPersistentQueue_SharesOperationsBetweenReplicas(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := storage.NewMemoryStorage()
+	executor := &countingExecutor{}
+	first := process.NewPersistentQueue("provisioning", "owner-1", executor, db.OperationsQueue(), fixPersistentQueueConfig(), logrus.New())
+	second := process.NewPersistentQueue("provisioning", "owner-2", executor, db.OperationsQueue(), fixPersistentQueueConfig(), logrus.New())
+	first.Run(ctx.Done(), 1)
+	second.Run(ctx.Done(), 1)
+
+	// when
+	for _, id := range []string{"op-1", "op-2", "op-3", "op-4"} {
+		first.Add(id)
+	}
+
+	// then
+	err := wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return executor.count("op-1") == 1 && executor.count("op-2") == 1 && executor.count("op-3") == 1 && executor.count("op-4") == 1, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPersistentQueue_ResumesLeaseOfStoppedReplica(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := storage.NewMemoryStorage()
+	require.NoError(t, db.OperationsQueue().Schedule("provisioning", "op-1", time.Now()))
+	ids, err := db.OperationsQueue().Lease("provisioning", "stopped-owner", time.Millisecond, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"op-1"}, ids)
+
+	executor := &countingExecutor{}
+	queue := process.NewPersistentQueue("provisioning", "owner-1", executor, db.OperationsQueue(), fixPersistentQueueConfig(), logrus.New())
+
+	// when
+	queue.Run(ctx.Done(), 1)
+
+	// then
+	err = wait.PollImmediate(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return executor.count("op-1") == 1, nil
+	})
+	require.NoError(t, err)
+}
+
+func fixPersistentQueueConfig() process.PersistentQueueConfig {
+	return process.PersistentQueueConfig{
+		Enabled:         true,
+		LeaseDuration:   time.Minute,
+		PollingInterval: 10 * time.Millisecond,
+	}
+}
+
+type countingExecutor struct {
+	mu      sync.Mutex
+	calls   map[string]int
+	retries map[string]int
+}
+
+func (e *countingExecutor) Execute(operationID string) (time.Duration, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.calls == nil {
+		e.calls = make(map[string]int)
+	}
+	e.calls[operationID]++
+	if e.calls[operationID] <= e.retries[operationID] {
+		return 10 * time.Millisecond, nil
+	}
+	return 0, nil
+}
+
+func (e *countingExecutor) count(operationID string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.calls[operationID]
+}
+
+func TestPersistentQueue_ResumeKeepsScheduledOperations(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	queue := process.NewPersistentQueue("provisioning", "owner-1", &countingExecutor{}, db.OperationsQueue(), fixPersistentQueueConfig(), logrus.New())
+	require.NoError(t, db.OperationsQueue().Schedule("provisioning", "op-retry", time.Now().Add(time.Hour)))
+
+	// when
+	queue.Resume("op-retry")
+	queue.Resume("op-not-queued")
+
+	// then
+	ids, err := db.OperationsQueue().Lease("provisioning", "owner-2", time.Minute, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"op-not-queued"}, ids)
+}
+
+func TestPersistentQueue_ExtendsLeaseOfLongRunningOperation(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := storage.NewMemoryStorage()
+	cfg := fixPersistentQueueConfig()
+	cfg.LeaseDuration = 60 * time.Millisecond
+	executor := &blockingExecutor{release: make(chan struct{})}
+	queue := process.NewPersistentQueue("provisioning", "owner-1", executor, db.OperationsQueue(), cfg, logrus.New())
+	queue.Run(ctx.Done(), 1)
+
+	// when
+	queue.Add("op-1")
+	time.Sleep(5 * cfg.LeaseDuration)
+
+	// then
+	ids, err := db.OperationsQueue().Lease("provisioning", "owner-2", time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	close(executor.release)
+}
+
+type blockingExecutor struct {
+	release chan struct{}
+}
+
+func (e *blockingExecutor) Execute(operationID string) (time.Duration, error) {
+	<-e.release
+	return 0, nil
+}
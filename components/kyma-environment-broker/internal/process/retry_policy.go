@@ -0,0 +1,193 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// ExhaustionAction defines what the staged manager does when a step runs out of retries
+type ExhaustionAction string
+
+const (
+	// FailOperation marks the whole operation as failed
+	FailOperation ExhaustionAction = "fail"
+	// SkipStep records the step as executed but not completed and continues with the next step
+	SkipStep ExhaustionAction = "skip"
+)
+
+// Backoff returns the delay before the next execution of a step which failed the given attempt (starting from 1)
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff retries the step always after the same delay
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff doubles the delay after every failed attempt, up to the max delay
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := initial
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			return max
+		}
+		return delay
+	}
+}
+
+// RetryPolicy declares how the staged manager retries a step.
+// A step with a policy only reports that it needs a retry (returns a non-zero duration) or that it failed
+// (returns an error without marking the operation as failed). The manager counts the attempts, computes the delay
+// and decides when to give up, so the step does not need to compare the operation's UpdatedAt against a timeout.
+type RetryPolicy struct {
+	// MaxAttempts limits the number of executions of the step, 0 means no limit
+	MaxAttempts int
+	// Backoff computes the delay between attempts, the delay returned by the step is used if not set
+	Backoff Backoff
+	// Timeout limits the time since the first attempt of the step, 0 means no limit
+	Timeout time.Duration
+	// OnExhausted defines what happens when the attempts or the timeout are exhausted, FailOperation by default
+	OnExhausted ExhaustionAction
+}
+
+type StepOption func(step *StepWithCondition)
+
+// WithRetryPolicy makes the staged manager retry the step according to the given policy
+func WithRetryPolicy(policy RetryPolicy) StepOption {
+	return func(step *StepWithCondition) {
+		step.policy = &policy
+	}
+}
+
+// RetriesExhaustedHandler is implemented by steps which must clean up when they run out of retries,
+// for example to delete the resources created by the step. The handler is called instead of failing the operation
+// and is responsible for marking the operation as failed.
+type RetriesExhaustedHandler interface {
+	OnRetriesExhausted(operation internal.Operation, description string, log logrus.FieldLogger) (internal.Operation, time.Duration, error)
+}
+
+// RetryDecision describes how the step which needs a retry or failed is processed further
+type RetryDecision struct {
+	// Attempts contains the new attempt of the step and must be stored on the operation
+	Attempts map[string]internal.StepAttempts
+	// Attempt is the number of the recorded attempt
+	Attempt int
+	// Delay defines when the step is executed again if the retries are not exhausted
+	Delay time.Duration
+	// Exhausted is set when the step ran out of attempts or time
+	Exhausted bool
+	// Reason describes why the step needs a retry
+	Reason string
+}
+
+// Description returns the description of the operation which failed because the step exhausted its retries
+func (d RetryDecision) Description(stepName string) string {
+	return fmt.Sprintf("step %s exhausted its retries after %d attempts: %s", stepName, d.Attempt, d.Reason)
+}
+
+// Decide records the attempt of the step which requested a retry (when) or failed (stepErr) and decides
+// if and when the step is executed again. It is used by all managers which support retry policies.
+func (p RetryPolicy) Decide(attempts map[string]internal.StepAttempts, stepName string, when time.Duration, stepErr error) RetryDecision {
+	reason := fmt.Sprintf("step requested a retry in %s", when)
+	if stepErr != nil {
+		reason = stepErr.Error()
+	}
+	attempts = recordAttempt(attempts, stepName, reason, false)
+	current := attempts[stepName]
+
+	decision := RetryDecision{
+		Attempts: attempts,
+		Attempt:  current.Attempts,
+		Delay:    when,
+		Reason:   reason,
+		Exhausted: (p.MaxAttempts > 0 && current.Attempts >= p.MaxAttempts) ||
+			(p.Timeout > 0 && time.Since(current.FirstAttemptAt) > p.Timeout),
+	}
+	if p.Backoff != nil {
+		decision.Delay = p.Backoff(current.Attempts)
+	}
+	if decision.Delay == 0 {
+		decision.Delay = time.Second
+	}
+	return decision
+}
+
+// SkippedAttempts returns a copy of the attempts with the step marked as skipped
+func SkippedAttempts(attempts map[string]internal.StepAttempts, stepName string) map[string]internal.StepAttempts {
+	return recordAttempt(attempts, stepName, "", true)
+}
+
+// applyRetryPolicy records the attempt of the step on the operation and decides if and when the step is retried
+func (m *StagedManager) applyRetryPolicy(step StepWithCondition, operation internal.Operation, when time.Duration, stepErr error, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	policy := step.policy
+	if operation.State == domain.Failed || (stepErr == nil && when == 0) {
+		operation.StepAttempts = recordAttempt(operation.StepAttempts, step.Name(), "", false)
+		return operation, 0, stepErr
+	}
+
+	decision := policy.Decide(operation.StepAttempts, step.Name(), when, stepErr)
+	if !decision.Exhausted {
+		log.Warnf("attempt %d of the step failed: %s", decision.Attempt, decision.Reason)
+		op, repeat, err := m.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+			op.StepAttempts = decision.Attempts
+		}, log)
+		if repeat != 0 {
+			return op, repeat, nil
+		}
+		return op, decision.Delay, err
+	}
+
+	description := decision.Description(step.Name())
+	if policy.OnExhausted == SkipStep {
+		log.Errorf("%s, skipping the step", description)
+		attempts := SkippedAttempts(decision.Attempts, step.Name())
+		op, repeat, err := m.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+			op.StepAttempts = attempts
+			op.ExcutedButNotCompleted = append(op.ExcutedButNotCompleted, step.Name())
+		}, log)
+		if repeat != 0 {
+			return op, repeat, nil
+		}
+		op.EventErrorf(errors.New(decision.Reason), "step %s exhausted its retries: operation continues", step.Name())
+		return op, 0, err
+	}
+
+	log.Errorf(description)
+	operation.StepAttempts = decision.Attempts
+	if handler, ok := step.Step.(RetriesExhaustedHandler); ok {
+		return handler.OnRetriesExhausted(operation, description, log)
+	}
+	return m.operationManager.OperationFailed(operation, description, stepErr, log)
+}
+
+// recordAttempt returns a copy of the attempts with a new attempt of the given step
+func recordAttempt(attempts map[string]internal.StepAttempts, stepName, failureReason string, skipped bool) map[string]internal.StepAttempts {
+	result := make(map[string]internal.StepAttempts, len(attempts)+1)
+	for name, a := range attempts {
+		result[name] = a
+	}
+	current := result[stepName]
+	if skipped {
+		current.Skipped = true
+		result[stepName] = current
+		return result
+	}
+	if current.Attempts == 0 {
+		current.FirstAttemptAt = time.Now()
+	}
+	current.Attempts++
+	if failureReason != "" {
+		current.LastFailureReason = failureReason
+	}
+	result[stepName] = current
+	return result
+}
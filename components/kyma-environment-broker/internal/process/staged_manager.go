@@ -7,12 +7,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
@@ -21,6 +24,8 @@ import (
 type StagedManager struct {
 	log              logrus.FieldLogger
 	operationStorage storage.Operations
+	operationManager *OperationManager
+	stepJournal      storage.OperationSteps
 	publisher        event.Publisher
 
 	stages           []*stage
@@ -41,6 +46,7 @@ type StepCondition func(operation internal.Operation) bool
 type StepWithCondition struct {
 	Step
 	condition StepCondition
+	policy    *RetryPolicy
 }
 
 type stage struct {
@@ -48,17 +54,23 @@ type stage struct {
 	steps []StepWithCondition
 }
 
-func (s *stage) AddStep(step Step, cnd StepCondition) {
-	s.steps = append(s.steps, StepWithCondition{
+func (s *stage) AddStep(step Step, cnd StepCondition, opts ...StepOption) {
+	stepWithCondition := StepWithCondition{
 		Step:      step,
 		condition: cnd,
-	})
+	}
+	for _, opt := range opts {
+		opt(&stepWithCondition)
+	}
+	s.steps = append(s.steps, stepWithCondition)
 }
 
-func NewStagedManager(storage storage.Operations, pub event.Publisher, operationTimeout time.Duration, logger logrus.FieldLogger) *StagedManager {
+func NewStagedManager(storage storage.Operations, stepJournal storage.OperationSteps, pub event.Publisher, operationTimeout time.Duration, logger logrus.FieldLogger) *StagedManager {
 	return &StagedManager{
 		log:              logger,
 		operationStorage: storage,
+		operationManager: NewOperationManager(storage),
+		stepJournal:      stepJournal,
 		publisher:        pub,
 		operationTimeout: operationTimeout,
 		speedFactor:      1,
@@ -78,10 +90,11 @@ func (m *StagedManager) DefineStages(names []string) {
 	}
 }
 
-func (m *StagedManager) AddStep(stageName string, step Step, cnd StepCondition) error {
+// AddStep adds the step to the given stage. Options like WithRetryPolicy define how the step is retried.
+func (m *StagedManager) AddStep(stageName string, step Step, cnd StepCondition, opts ...StepOption) error {
 	for _, s := range m.stages {
 		if s.name == stageName {
-			s.AddStep(step, cnd)
+			s.AddStep(step, cnd, opts...)
 			return nil
 		}
 	}
@@ -106,7 +119,7 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID, "planID": operation.ProvisioningParameters.PlanID})
 	logOperation.Infof("Start process operation steps for GlobalAccount=%s, ", operation.ProvisioningParameters.ErsContext.GlobalAccountID)
-	if time.Since(operation.CreatedAt) > m.operationTimeout {
+	if time.Since(operation.TimeoutStartedAt()) > m.operationTimeout {
 		timeoutErr := kebError.TimeoutError("operation has reached the time limit")
 		operation.LastError = timeoutErr
 		defer m.callPubSubOutsideSteps(operation, timeoutErr)
@@ -141,7 +154,7 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 			}
 			operation.EventInfof("processing step: %v", step.Name())
 
-			processedOperation, when, err = m.runStep(step, processedOperation, logStep)
+			processedOperation, when, err = m.runStep(stage.name, step, processedOperation, logStep)
 			if err != nil {
 				logStep.Errorf("Process operation failed: %s", err)
 				operation.EventErrorf(err, "step %v processing returned error", step.Name())
@@ -170,15 +183,16 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 
 	processedOperation.State = domain.Succeeded
 	processedOperation.Description = "Processing finished"
-	m.publisher.Publish(context.TODO(), OperationSucceeded{
-		Operation: processedOperation,
-	})
 
 	_, err = m.operationStorage.UpdateOperation(processedOperation)
 	if err != nil {
 		logOperation.Infof("Unable to save operation with finished the provisioning process")
 		return time.Second, err
 	}
+	// published only when stored, the operation is processed again if the update fails
+	m.publisher.Publish(context.TODO(), OperationSucceeded{
+		Operation: processedOperation,
+	})
 
 	return 0, nil
 }
@@ -194,12 +208,17 @@ func (m *StagedManager) saveFinishedStage(operation internal.Operation, s *stage
 	return *op, nil
 }
 
-func (m *StagedManager) runStep(step Step, operation internal.Operation, logger logrus.FieldLogger) (processedOperation internal.Operation, backoff time.Duration, err error) {
+func (m *StagedManager) runStep(stageName string, step StepWithCondition, operation internal.Operation, logger logrus.FieldLogger) (processedOperation internal.Operation, backoff time.Duration, err error) {
 	var start time.Time
+	attempt := 0
+	if last := m.lastStep(operation.ID, stageName, step.Name(), logger); last != nil {
+		attempt = last.Attempt
+	}
 	defer func() {
 		if pErr := recover(); pErr != nil {
 			log.Println("panic in RunStep in staged manager: ", pErr)
 			err = errors.New(fmt.Sprintf("%v", pErr))
+			m.recordStep(operation.ID, stageName, step.Name(), attempt, start, commonOperation.StepFailed, err.Error(), logger)
 			om := NewOperationManager(m.operationStorage)
 			processedOperation, _, _ = om.OperationFailed(operation, "recovered from panic", err, m.log)
 		}
@@ -208,8 +227,18 @@ func (m *StagedManager) runStep(step Step, operation internal.Operation, logger
 	begin := time.Now()
 	for {
 		start = time.Now()
+		attempt++
 		logger.Infof("Start step")
 		processedOperation, backoff, err = step.Run(operation, logger)
+		if step.policy != nil {
+			processedOperation, backoff, err = m.applyRetryPolicy(step, processedOperation, backoff, err, logger)
+			// the next attempt must see the recorded attempts
+			operation.StepAttempts = processedOperation.StepAttempts
+			operation.Version = processedOperation.Version
+		}
+		outcome, reason := stepOutcome(step.Name(), processedOperation, backoff, err)
+		m.recordStep(operation.ID, stageName, step.Name(), attempt, start, outcome, reason, logger)
+
 		if err != nil {
 			processedOperation.LastError = kebError.ReasonForError(err)
 			logOperation := m.log.WithFields(logrus.Fields{"operation": processedOperation.ID, "error_component": processedOperation.LastError.Component(), "error_reason": processedOperation.LastError.Reason()})
@@ -244,6 +273,58 @@ func (m *StagedManager) runStep(step Step, operation internal.Operation, logger
 	}
 }
 
+// lastStep returns the journal entry of the latest execution of the step, nil if the step was not executed yet
+func (m *StagedManager) lastStep(operationID, stageName, stepName string, log logrus.FieldLogger) *internal.OperationStep {
+	if m.stepJournal == nil {
+		return nil
+	}
+	step, err := m.stepJournal.GetLast(operationID, stageName, stepName)
+	switch {
+	case err == nil:
+		return &step
+	case dberr.IsNotFound(err):
+	default:
+		log.Warnf("unable to read step journal: %s", err)
+	}
+	return nil
+}
+
+// recordStep writes the step execution to the step journal, the operation is processed even if the journal is not available.
+// Every attempt is stored as a separate entry, so the journal shows when each retry started and finished.
+func (m *StagedManager) recordStep(operationID, stageName, stepName string, attempt int, start time.Time, outcome commonOperation.StepOutcome, reason string, log logrus.FieldLogger) {
+	if m.stepJournal == nil {
+		return
+	}
+	err := m.stepJournal.Insert(internal.OperationStep{
+		ID:          uuid.New().String(),
+		OperationID: operationID,
+		Stage:       stageName,
+		Step:        stepName,
+		Attempt:     attempt,
+		StartedAt:   start,
+		FinishedAt:  time.Now(),
+		Outcome:     outcome,
+		Error:       reason,
+	})
+	if err != nil {
+		log.Warnf("unable to write step journal: %s", err)
+	}
+}
+
+func stepOutcome(stepName string, operation internal.Operation, backoff time.Duration, err error) (commonOperation.StepOutcome, string) {
+	switch {
+	case err != nil:
+		return commonOperation.StepFailed, err.Error()
+	case operation.State == domain.Failed:
+		return commonOperation.StepFailed, operation.Description
+	case backoff > 0:
+		return commonOperation.StepRetrying, ""
+	case operation.StepAttempts[stepName].Skipped:
+		return commonOperation.StepSkipped, operation.StepAttempts[stepName].LastFailureReason
+	}
+	return commonOperation.StepSucceeded, ""
+}
+
 func (m *StagedManager) callPubSubOutsideSteps(operation *internal.Operation, err error) {
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operation.ID, "error_component": operation.LastError.Component(), "error_reason": operation.LastError.Reason()})
 	logOperation.Errorf("Last error: %s", operation.LastError.Error())
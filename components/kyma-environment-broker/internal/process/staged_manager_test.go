@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	commonOperation "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
@@ -138,6 +139,188 @@ func TestSkipFinishedStage(t *testing.T) {
 	assert.True(t, op.IsStageFinished("stage-2"))
 }
 
+func TestRetryPolicy_FailsOperationWhenAttemptsExhausted(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &failingStep{name: "failing", eventPublisher: eventCollector}, nil, process.WithRetryPolicy(process.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     process.ConstantBackoff(time.Second),
+	}))
+	mgr.AddStep("stage-2", &testingStep{name: "first-2", eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, _ := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	eventCollector.AssertProcessedSteps(t, []string{"failing", "failing", "failing"})
+	op, _ := operationStorage.GetOperationByID(operation.ID)
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Equal(t, 3, op.StepAttempts["failing"].Attempts)
+	assert.Equal(t, "service unavailable", op.StepAttempts["failing"].LastFailureReason)
+	assert.False(t, op.StepAttempts["failing"].Skipped)
+}
+
+func TestRetryPolicy_SkipsStepWhenAttemptsExhausted(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &failingStep{name: "failing", eventPublisher: eventCollector}, nil, process.WithRetryPolicy(process.RetryPolicy{
+		MaxAttempts: 2,
+		Backoff:     process.ConstantBackoff(time.Second),
+		OnExhausted: process.SkipStep,
+	}))
+	mgr.AddStep("stage-2", &testingStep{name: "first-2", eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, err := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	assert.NoError(t, err)
+	eventCollector.AssertProcessedSteps(t, []string{"failing", "failing", "first-2"})
+	op, _ := operationStorage.GetOperationByID(operation.ID)
+	assert.Equal(t, domain.Succeeded, op.State)
+	assert.Equal(t, 2, op.StepAttempts["failing"].Attempts)
+	assert.True(t, op.StepAttempts["failing"].Skipped)
+	assert.Contains(t, op.ExcutedButNotCompleted, "failing")
+}
+
+func TestRetryPolicy_FailsOperationWhenTimeoutExceeded(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	operation.StepAttempts = map[string]internal.StepAttempts{
+		"retrying": {Attempts: 10, FirstAttemptAt: time.Now().Add(-2 * time.Hour)},
+	}
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &onceRetryingStep{name: "retrying", eventPublisher: eventCollector}, nil, process.WithRetryPolicy(process.RetryPolicy{
+		Timeout: time.Hour,
+	}))
+	mgr.AddStep("stage-2", &testingStep{name: "first-2", eventPublisher: eventCollector}, nil)
+
+	// when
+	retry, _ := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	eventCollector.AssertProcessedSteps(t, []string{"retrying"})
+	op, _ := operationStorage.GetOperationByID(operation.ID)
+	assert.Equal(t, domain.Failed, op.State)
+	assert.Equal(t, 11, op.StepAttempts["retrying"].Attempts)
+}
+
+func TestRetryPolicy_RecordsAttemptsOfRetriedStep(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+	mgr.AddStep("stage-1", &onceRetryingStep{name: "retrying", eventPublisher: eventCollector}, nil, process.WithRetryPolicy(process.RetryPolicy{
+		MaxAttempts: 5,
+	}))
+
+	// when
+	retry, err := mgr.Execute(operation.ID)
+
+	// then
+	assert.Zero(t, retry)
+	assert.NoError(t, err)
+	op, _ := operationStorage.GetOperationByID(operation.ID)
+	assert.Equal(t, domain.Succeeded, op.State)
+	assert.Equal(t, 2, op.StepAttempts["retrying"].Attempts)
+	assert.Equal(t, "step requested a retry in 1ms", op.StepAttempts["retrying"].LastFailureReason)
+}
+
+func TestStepJournal(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	memoryStorage := storage.NewMemoryStorage()
+	memoryStorage.Operations().InsertOperation(operation)
+	eventCollector := &CollectingEventHandler{}
+	mgr := process.NewStagedManager(memoryStorage.Operations(), memoryStorage.OperationSteps(), eventCollector, 3*time.Second, logrus.New())
+	mgr.SpeedUp(100000)
+	mgr.DefineStages([]string{"stage-1", "stage-2"})
+	mgr.AddStep("stage-1", &testingStep{name: "first", eventPublisher: eventCollector}, nil)
+	mgr.AddStep("stage-2", &onceRetryingStep{name: "first-2", eventPublisher: eventCollector}, nil)
+	mgr.AddStep("stage-2", &failingStep{name: "failing", eventPublisher: eventCollector}, nil, process.WithRetryPolicy(process.RetryPolicy{
+		MaxAttempts: 1,
+		OnExhausted: process.SkipStep,
+	}))
+
+	// when
+	mgr.Execute(operation.ID)
+
+	// then
+	steps, err := memoryStorage.OperationSteps().ListByOperationID(operation.ID)
+	assert.NoError(t, err)
+	assert.Len(t, steps, 4)
+	expected := []struct {
+		stage   string
+		step    string
+		attempt int
+		outcome commonOperation.StepOutcome
+	}{
+		{"stage-1", "first", 1, commonOperation.StepSucceeded},
+		{"stage-2", "first-2", 1, commonOperation.StepRetrying},
+		{"stage-2", "first-2", 2, commonOperation.StepSucceeded},
+		{"stage-2", "failing", 1, commonOperation.StepSkipped},
+	}
+	for i, e := range expected {
+		assert.Equal(t, operation.ID, steps[i].OperationID)
+		assert.Equal(t, e.stage, steps[i].Stage)
+		assert.Equal(t, e.step, steps[i].Step)
+		assert.Equal(t, e.attempt, steps[i].Attempt)
+		assert.Equal(t, e.outcome, steps[i].Outcome)
+		assert.False(t, steps[i].FinishedAt.Before(steps[i].StartedAt))
+	}
+	assert.Equal(t, "service unavailable", steps[3].Error)
+}
+
+func TestStepJournal_RecordsEveryRetry(t *testing.T) {
+	// given
+	operation := FixOperation("op-0001234")
+	memoryStorage := storage.NewMemoryStorage()
+	memoryStorage.Operations().InsertOperation(operation)
+	eventCollector := &CollectingEventHandler{}
+	mgr := process.NewStagedManager(memoryStorage.Operations(), memoryStorage.OperationSteps(), eventCollector, 3*time.Second, logrus.New())
+	mgr.SpeedUp(100000)
+	mgr.DefineStages([]string{"stage-1"})
+	mgr.AddStep("stage-1", &retryingStep{name: "retrying", retries: 3, eventPublisher: eventCollector}, nil)
+
+	// when
+	mgr.Execute(operation.ID)
+
+	// then
+	eventCollector.AssertProcessedSteps(t, []string{"retrying", "retrying", "retrying", "retrying"})
+	steps, err := memoryStorage.OperationSteps().ListByOperationID(operation.ID)
+	assert.NoError(t, err)
+	assert.Len(t, steps, 4)
+	for i, step := range steps {
+		assert.Equal(t, i+1, step.Attempt)
+		assert.False(t, step.FinishedAt.Before(step.StartedAt))
+		if i > 0 {
+			assert.False(t, step.StartedAt.Before(steps[i-1].FinishedAt))
+		}
+	}
+	assert.Equal(t, commonOperation.StepRetrying, steps[2].Outcome)
+	assert.Equal(t, commonOperation.StepSucceeded, steps[3].Outcome)
+
+	last, err := memoryStorage.OperationSteps().GetLast(operation.ID, "stage-1", "retrying")
+	assert.NoError(t, err)
+	assert.Equal(t, steps[3], last)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	// given
+	backoff := process.ExponentialBackoff(time.Second, 10*time.Second)
+
+	// then
+	assert.Equal(t, time.Second, backoff(1))
+	assert.Equal(t, 2*time.Second, backoff(2))
+	assert.Equal(t, 8*time.Second, backoff(4))
+	assert.Equal(t, 10*time.Second, backoff(5))
+	assert.Equal(t, 10*time.Second, backoff(100))
+}
+
 func SetupStagedManager(op internal.Operation) (*process.StagedManager, storage.Operations, *CollectingEventHandler) {
 	memoryStorage := storage.NewMemoryStorage()
 	memoryStorage.Operations().InsertOperation(op)
@@ -145,7 +328,7 @@ func SetupStagedManager(op internal.Operation) (*process.StagedManager, storage.
 	eventCollector := &CollectingEventHandler{}
 	l := logrus.New()
 	l.SetLevel(logrus.DebugLevel)
-	mgr := process.NewStagedManager(memoryStorage.Operations(), eventCollector, 3*time.Second, l)
+	mgr := process.NewStagedManager(memoryStorage.Operations(), memoryStorage.OperationSteps(), eventCollector, 3*time.Second, l)
 	mgr.SpeedUp(100000)
 	mgr.DefineStages([]string{"stage-1", "stage-2"})
 
@@ -185,6 +368,39 @@ func (s *onceRetryingStep) Run(operation internal.Operation, logger logrus.Field
 	return operation, 0, nil
 }
 
+type retryingStep struct {
+	name           string
+	retries        int
+	eventPublisher event.Publisher
+}
+
+func (s *retryingStep) Name() string {
+	return s.name
+}
+
+func (s *retryingStep) Run(operation internal.Operation, logger logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	s.eventPublisher.Publish(context.Background(), s.name)
+	if s.retries > 0 {
+		s.retries--
+		return operation, time.Millisecond, nil
+	}
+	return operation, 0, nil
+}
+
+type failingStep struct {
+	name           string
+	eventPublisher event.Publisher
+}
+
+func (s *failingStep) Name() string {
+	return s.name
+}
+
+func (s *failingStep) Run(operation internal.Operation, logger logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	s.eventPublisher.Publish(context.Background(), s.name)
+	return operation, 0, fmt.Errorf("service unavailable")
+}
+
 type panicStep struct {
 	name           string
 	processed      bool
@@ -316,7 +532,7 @@ func SetupStagedManager2(op internal.Operation) (*process.StagedManager, storage
 	l := logrus.New()
 	l.SetLevel(logrus.DebugLevel)
 	pubSub := event.NewPubSub(nil)
-	mgr := process.NewStagedManager(memoryStorage.Operations(), pubSub, 3*time.Second, l)
+	mgr := process.NewStagedManager(memoryStorage.Operations(), memoryStorage.OperationSteps(), pubSub, 3*time.Second, l)
 	mgr.SpeedUp(100000)
 	mgr.DefineStages([]string{"stage-1", "stage-2"})
 
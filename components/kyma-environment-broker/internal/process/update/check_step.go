@@ -13,20 +13,17 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
-// CheckStep checks if the SKR is updated
+// CheckStep checks if the SKR is updated. The step is registered with a retry policy,
+// which limits the time the staged manager waits for the provisioner.
 type CheckStep struct {
-	provisionerClient   provisioner.Client
-	operationManager    *process.OperationManager
-	provisioningTimeout time.Duration
+	provisionerClient provisioner.Client
+	operationManager  *process.OperationManager
 }
 
-func NewCheckStep(os storage.Operations,
-	provisionerClient provisioner.Client,
-	provisioningTimeout time.Duration) *CheckStep {
+func NewCheckStep(os storage.Operations, provisionerClient provisioner.Client) *CheckStep {
 	return &CheckStep{
-		provisionerClient:   provisionerClient,
-		operationManager:    process.NewOperationManager(os),
-		provisioningTimeout: provisioningTimeout,
+		provisionerClient: provisionerClient,
+		operationManager:  process.NewOperationManager(os),
 	}
 }
 
@@ -45,11 +42,6 @@ func (s *CheckStep) Run(operation internal.Operation, log logrus.FieldLogger) (i
 }
 
 func (s *CheckStep) checkRuntimeStatus(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > s.provisioningTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.provisioningTimeout), nil, log)
-	}
-
 	if operation.ProvisionerOperationID == "" {
 		msg := "Operation does not contain Provisioner Operation ID"
 		log.Error(msg)
@@ -73,8 +65,10 @@ func (s *CheckStep) checkRuntimeStatus(operation internal.Operation, log logrus.
 		return operation, 0, nil
 	case gqlschema.OperationStateInProgress:
 		return operation, time.Minute, nil
-	case gqlschema.OperationStatePending:
+	case gqlschema.OperationStatePending, provisioner.OperationStateCanceling:
 		return operation, time.Minute, nil
+	case provisioner.OperationStateCanceled:
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("provisioner operation was canceled: %s", msg), nil, log)
 	case gqlschema.OperationStateFailed:
 		return s.operationManager.OperationFailed(operation, fmt.Sprintf("provisioner client returns failed status: %s", msg), nil, log)
 	}
@@ -53,7 +53,7 @@ func TestCheckRuntimeStep_RunProvisioningSucceeded(t *testing.T) {
 			err := st.Operations().InsertOperation(operation)
 			assert.NoError(t, err)
 
-			step := NewCheckStep(st.Operations(), provisionerClient, 1*time.Second)
+			step := NewCheckStep(st.Operations(), provisionerClient)
 
 			// when
 			operation, repeat, err := step.Run(operation, logrus.New())
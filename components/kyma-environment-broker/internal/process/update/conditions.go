@@ -25,3 +25,7 @@ func SkipForOwnClusterPlan(op internal.Operation) bool {
 func RequiresBTPOperatorCredentials(op internal.Operation) bool {
 	return ForBTPOperatorCredentialsProvided(op) && !broker.IsPreviewPlan(op.ProvisioningParameters.PlanID)
 }
+
+func ForPlanMigration(op internal.Operation) bool {
+	return op.PlanMigration != nil && !broker.IsOwnClusterPlan(op.ProvisioningParameters.PlanID)
+}
@@ -103,7 +103,7 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 
 	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID, "planID": operation.ProvisioningParameters.PlanID})
 	logOperation.Infof("Start process operation steps for GlobalAccount=%s, ", operation.ProvisioningParameters.ErsContext.GlobalAccountID)
-	if time.Since(operation.CreatedAt) > m.operationTimeout {
+	if time.Since(operation.TimeoutStartedAt()) > m.operationTimeout {
 		logOperation.Infof("operation has reached the time limit: operation was created at: %s", operation.CreatedAt)
 		operation.State = domain.Failed
 		_, err = m.operationStorage.UpdateUpdatingOperation(*operation)
@@ -0,0 +1,119 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// PlanMigrationCredentialsStep resolves the hyperscaler account of the new plan, for example a trial instance moves from
+// the shared account to the account of the global account, and switches the shoot to it
+type PlanMigrationCredentialsStep struct {
+	operationManager  *process.OperationManager
+	accountProvider   hyperscaler.AccountProvider
+	gardenerClient    dynamic.Interface
+	gardenerNamespace string
+}
+
+func NewPlanMigrationCredentialsStep(os storage.Operations, accountProvider hyperscaler.AccountProvider, gardenerClient dynamic.Interface, gardenerNamespace string) *PlanMigrationCredentialsStep {
+	return &PlanMigrationCredentialsStep{
+		operationManager:  process.NewOperationManager(os),
+		accountProvider:   accountProvider,
+		gardenerClient:    gardenerClient,
+		gardenerNamespace: gardenerNamespace,
+	}
+}
+
+func (s *PlanMigrationCredentialsStep) Name() string {
+	return "Plan_Migration_Resolve_Credentials"
+}
+
+func (s *PlanMigrationCredentialsStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	hypType, err := hyperscaler.FromCloudProvider(operation.InputCreator.Provider())
+	if err != nil {
+		return s.operationManager.OperationFailed(operation, "unable to determine the hyperscaler of the new plan", err, log)
+	}
+
+	globalAccountID := operation.ProvisioningParameters.ErsContext.GlobalAccountID
+	euAccess := internal.IsEuAccess(operation.ProvisioningParameters.PlatformRegion)
+	// the instances cannot be moved to the trial plan, the new plan always uses the account of the global account
+	secretName, err := s.accountProvider.GardenerSecretName(hypType, globalAccountID, euAccess)
+	if err != nil {
+		msg := fmt.Sprintf("HAP lookup for secret binding of global account ID %s on Hyperscaler %s has failed", globalAccountID, hypType)
+		return s.operationManager.RetryOperation(operation, msg, err, 10*time.Second, 10*time.Minute, log)
+	}
+
+	shoots := s.gardenerClient.Resource(gardener.ShootResource).Namespace(s.gardenerNamespace)
+	obj, err := shoots.Get(context.Background(), operation.ShootName, metav1.GetOptions{})
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get the shoot", err, 10*time.Second, 5*time.Minute, log)
+	}
+	shoot := gardener.Shoot{Unstructured: *obj}
+	if current := shoot.GetSpecSecretBindingName(); current != secretName {
+		log.Infof("Switching shoot %s from secret binding %s to %s", operation.ShootName, current, secretName)
+		patch, err := json.Marshal(map[string]interface{}{
+			"spec": map[string]interface{}{"secretBindingName": secretName},
+		})
+		if err != nil {
+			return s.operationManager.OperationFailed(operation, "unable to create the shoot patch", err, log)
+		}
+		if _, err := shoots.Patch(context.Background(), operation.ShootName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return s.operationManager.RetryOperation(operation, "unable to switch the secret binding of the shoot", err, 10*time.Second, 5*time.Minute, log)
+		}
+	}
+
+	return s.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+		op.ProvisioningParameters.Parameters.TargetSecret = &secretName
+	}, log)
+}
+
+// MigratePlanStep moves the instance to the new plan once the cluster is updated
+type MigratePlanStep struct {
+	operationManager *process.OperationManager
+	instanceStorage  storage.Instances
+}
+
+func NewMigratePlanStep(os storage.Operations, is storage.Instances) *MigratePlanStep {
+	return &MigratePlanStep{
+		operationManager: process.NewOperationManager(os),
+		instanceStorage:  is,
+	}
+}
+
+func (s *MigratePlanStep) Name() string {
+	return "Plan_Migration_Update_Instance"
+}
+
+func (s *MigratePlanStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	instance, err := s.instanceStorage.GetByID(operation.InstanceID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get the instance", err, 5*time.Second, 1*time.Minute, log)
+	}
+
+	planID := operation.PlanMigration.ToPlanID
+	instance.ServicePlanID = planID
+	instance.ServicePlanName = broker.PlanNamesMapping[planID]
+	instance.Parameters.PlanID = planID
+	if operation.ProvisioningParameters.Parameters.TargetSecret != nil {
+		instance.Parameters.Parameters.TargetSecret = operation.ProvisioningParameters.Parameters.TargetSecret
+	}
+	if _, err := s.instanceStorage.Update(*instance); err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to update the instance", err, 5*time.Second, 1*time.Minute, log)
+	}
+	log.Infof("Instance moved from plan %s to plan %s", broker.PlanNamesMapping[operation.PlanMigration.FromPlanID], instance.ServicePlanName)
+
+	return operation, 0, nil
+}
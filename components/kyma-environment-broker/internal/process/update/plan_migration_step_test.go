@@ -0,0 +1,129 @@
+package update
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	hyperscalerMocks "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const migrationGardenerNamespace = "garden-test"
+
+func TestPlanMigrationCredentialsStep_Run(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	operation := fixPlanMigrationOperation(broker.TrialPlanID, broker.AzurePlanID)
+	require.NoError(t, memoryStorage.Operations().InsertOperation(operation))
+
+	accountProvider := &hyperscalerMocks.AccountProvider{}
+	accountProvider.On("GardenerSecretName", hyperscaler.Azure, fixture.GlobalAccountId, false).Return("azure-secret", nil)
+	gardenerClient := gardener.NewDynamicFakeClient(fixMigrationShoot("shoot-1", "trial-secret"))
+
+	step := NewPlanMigrationCredentialsStep(memoryStorage.Operations(), accountProvider, gardenerClient, migrationGardenerNamespace)
+
+	// when
+	operation, repeat, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, repeat)
+	require.NotNil(t, operation.ProvisioningParameters.Parameters.TargetSecret)
+	assert.Equal(t, "azure-secret", *operation.ProvisioningParameters.Parameters.TargetSecret)
+
+	obj, err := gardenerClient.Resource(gardener.ShootResource).Namespace(migrationGardenerNamespace).Get(context.Background(), "shoot-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	shoot := gardener.Shoot{Unstructured: *obj}
+	assert.Equal(t, "azure-secret", shoot.GetSpecSecretBindingName())
+}
+
+func TestPlanMigrationCredentialsStep_RunWithSameSecretBinding(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	operation := fixPlanMigrationOperation(broker.AzureLitePlanID, broker.AzurePlanID)
+	require.NoError(t, memoryStorage.Operations().InsertOperation(operation))
+
+	accountProvider := &hyperscalerMocks.AccountProvider{}
+	accountProvider.On("GardenerSecretName", hyperscaler.Azure, fixture.GlobalAccountId, false).Return("azure-secret", nil)
+	shoot := fixMigrationShoot("shoot-1", "azure-secret")
+	gardenerClient := gardener.NewDynamicFakeClient(shoot)
+
+	step := NewPlanMigrationCredentialsStep(memoryStorage.Operations(), accountProvider, gardenerClient, migrationGardenerNamespace)
+
+	// when
+	operation, repeat, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, repeat)
+	require.NotNil(t, operation.ProvisioningParameters.Parameters.TargetSecret)
+	assert.Equal(t, "azure-secret", *operation.ProvisioningParameters.Parameters.TargetSecret)
+	for _, action := range gardenerClient.Actions() {
+		assert.NotEqual(t, "patch", action.GetVerb())
+	}
+}
+
+func TestMigratePlanStep_Run(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	instance := fixture.FixInstance("inst-id")
+	instance.ServicePlanID = broker.TrialPlanID
+	instance.ServicePlanName = broker.TrialPlanName
+	instance.Parameters.PlanID = broker.TrialPlanID
+	require.NoError(t, memoryStorage.Instances().Insert(instance))
+
+	operation := fixPlanMigrationOperation(broker.TrialPlanID, broker.AzurePlanID)
+	secret := "azure-secret"
+	operation.ProvisioningParameters.Parameters.TargetSecret = &secret
+	require.NoError(t, memoryStorage.Operations().InsertOperation(operation))
+
+	step := NewMigratePlanStep(memoryStorage.Operations(), memoryStorage.Instances())
+
+	// when
+	_, repeat, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, repeat)
+	got, err := memoryStorage.Instances().GetByID("inst-id")
+	require.NoError(t, err)
+	assert.Equal(t, broker.AzurePlanID, got.ServicePlanID)
+	assert.Equal(t, broker.AzurePlanName, got.ServicePlanName)
+	assert.Equal(t, broker.AzurePlanID, got.Parameters.PlanID)
+	require.NotNil(t, got.Parameters.Parameters.TargetSecret)
+	assert.Equal(t, "azure-secret", *got.Parameters.Parameters.TargetSecret)
+}
+
+func fixPlanMigrationOperation(fromPlanID, toPlanID string) internal.Operation {
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id").Operation
+	operation.ShootName = "shoot-1"
+	operation.ProvisioningParameters.PlanID = toPlanID
+	operation.ProvisioningParameters.PlatformRegion = ""
+	operation.ProvisioningParameters.ErsContext.GlobalAccountID = fixture.GlobalAccountId
+	operation.PlanMigration = &internal.PlanMigration{FromPlanID: fromPlanID, ToPlanID: toPlanID}
+	return operation
+}
+
+func fixMigrationShoot(name, secretBindingName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "core.gardener.cloud/v1beta1",
+		"kind":       "Shoot",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": migrationGardenerNamespace,
+		},
+		"spec": map[string]interface{}{
+			"secretBindingName": secretBindingName,
+		},
+	}}
+}
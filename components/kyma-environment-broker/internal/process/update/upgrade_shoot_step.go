@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -19,17 +20,20 @@ type UpgradeShootStep struct {
 	operationManager    *process.OperationManager
 	provisionerClient   provisioner.Client
 	runtimeStateStorage storage.RuntimeStates
+	allowlistConfig     networking.APIServerAllowlistConfig
 }
 
 func NewUpgradeShootStep(
 	os storage.Operations,
 	runtimeStorage storage.RuntimeStates,
-	cli provisioner.Client) *UpgradeShootStep {
+	cli provisioner.Client,
+	allowlistConfig networking.APIServerAllowlistConfig) *UpgradeShootStep {
 
 	return &UpgradeShootStep{
 		operationManager:    process.NewOperationManager(os),
 		provisionerClient:   cli,
 		runtimeStateStorage: runtimeStorage,
+		allowlistConfig:     allowlistConfig,
 	}
 }
 
@@ -58,7 +62,7 @@ func (s *UpgradeShootStep) Run(operation internal.Operation, log logrus.FieldLog
 	var provisionerResponse gqlschema.OperationStatus
 	if operation.ProvisionerOperationID == "" {
 		// trigger upgradeRuntime mutation
-		provisionerResponse, err = s.provisionerClient.UpgradeShoot(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.RuntimeID, input)
+		provisionerResponse, err = s.provisionerClient.UpgradeShoot(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.RuntimeID, input, s.createGardenerUpgradeExtension(operation))
 		if err != nil {
 			log.Errorf("call to provisioner failed: %s", err)
 			return operation, retryDuration, nil
@@ -118,6 +122,16 @@ func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.Operation)
 	return result, nil
 }
 
+// createGardenerUpgradeExtension sends the API server allowlist only when the update provides it, so the current one is kept otherwise
+func (s *UpgradeShootStep) createGardenerUpgradeExtension(operation internal.Operation) provisioner.GardenerUpgradeExtension {
+	extension := provisioner.GardenerUpgradeExtension{}
+	if requested := operation.UpdatingParameters.APIServerAllowedCIDRs; requested != nil {
+		allowed := s.allowlistConfig.AllowedCIDRs(*requested)
+		extension.APIServerAllowedCidrs = &allowed
+	}
+	return extension
+}
+
 func gardenerUpgradeInputToConfigInput(input gqlschema.UpgradeShootInput) *gqlschema.GardenerConfigInput {
 	result := &gqlschema.GardenerConfigInput{
 		MachineImage:        input.GardenerConfig.MachineImage,
@@ -6,6 +6,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	inputAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
@@ -24,7 +25,7 @@ func TestUpgradeShootStep_Run(t *testing.T) {
 	os := memoryStorage.Operations()
 	rs := memoryStorage.RuntimeStates()
 	cli := provisioner.NewFakeClient()
-	step := NewUpgradeShootStep(os, rs, cli)
+	step := NewUpgradeShootStep(os, rs, cli, networking.APIServerAllowlistConfig{})
 	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
 	operation.RuntimeID = "runtime-id"
 	operation.ProvisionerOperationID = ""
@@ -76,6 +77,45 @@ func TestUpgradeShootStep_Run(t *testing.T) {
 	assert.NotEmpty(t, newOperation.ProvisionerOperationID)
 }
 
+func TestUpgradeShootStep_RunWithAPIServerAllowlist(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		requested *[]string
+		expected  *[]string
+	}{
+		"keep the ranges":    {requested: nil, expected: nil},
+		"replace the ranges": {requested: &[]string{"203.0.113.0/24"}, expected: &[]string{"203.0.113.0/24", "192.0.2.0/24"}},
+		"remove the ranges":  {requested: &[]string{}, expected: &[]string{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			os := memoryStorage.Operations()
+			rs := memoryStorage.RuntimeStates()
+			cli := provisioner.NewFakeClient()
+			step := NewUpgradeShootStep(os, rs, cli, networking.APIServerAllowlistConfig{KCPEgressCIDRs: []string{"192.0.2.0/24"}})
+			operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+			operation.RuntimeID = "runtime-id"
+			operation.ProvisionerOperationID = ""
+			operation.UpdatingParameters.APIServerAllowedCIDRs = testCase.requested
+			operation.InputCreator = fixInputCreator(t)
+			os.InsertOperation(operation.Operation)
+			runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+			runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "clientID"}
+			rs.Insert(runtimeState)
+
+			// when
+			_, d, err := step.Run(operation.Operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, d)
+			extension, found := cli.LastShootUpgradeExtension("runtime-id")
+			require.True(t, found)
+			assert.Equal(t, testCase.expected, extension.APIServerAllowedCidrs)
+		})
+	}
+}
+
 func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 	optComponentsSvc := &inputAutomock.OptionalComponentService{}
 
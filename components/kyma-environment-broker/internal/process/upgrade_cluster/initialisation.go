@@ -24,7 +24,7 @@ const (
 )
 
 const (
-	// the time after which the operation is marked as expired
+	// CheckStatusTimeout limits the retries of the initialisation step, it is applied by the step retry policy
 	CheckStatusTimeout = 3 * time.Hour
 )
 
@@ -182,19 +182,6 @@ func (s *InitialisationStep) performRuntimeTasks(step int, operation internal.Up
 // It will also trigger performRuntimeTasks upgrade steps to ensure
 // all the required dependencies have been fulfilled for upgrade operation.
 func (s *InitialisationStep) checkRuntimeStatus(operation internal.UpgradeClusterOperation, log logrus.FieldLogger) (internal.UpgradeClusterOperation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > CheckStatusTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		//send cunstomer notification
-		if operation.RuntimeOperation.Notification {
-			err := s.sendNotificationComplete(operation, log)
-			//currently notification error can only be temporary error
-			if err != nil && kebError.IsTemporaryError(err) {
-				return operation, 5 * time.Second, nil
-			}
-		}
-		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CheckStatusTimeout), nil, log)
-	}
-
 	status, err := s.provisionerClient.RuntimeOperationStatus(operation.RuntimeOperation.GlobalAccountID, operation.ProvisionerOperationID)
 	if err != nil {
 		return operation, s.timeSchedule.StatusCheck, nil
@@ -214,9 +201,9 @@ func (s *InitialisationStep) checkRuntimeStatus(operation internal.UpgradeCluste
 
 	// wait for operation completion
 	switch status.State {
-	case gqlschema.OperationStateInProgress, gqlschema.OperationStatePending:
+	case gqlschema.OperationStateInProgress, gqlschema.OperationStatePending, provisioner.OperationStateCanceling:
 		return operation, s.timeSchedule.StatusCheck, nil
-	case gqlschema.OperationStateSucceeded, gqlschema.OperationStateFailed:
+	case gqlschema.OperationStateSucceeded, gqlschema.OperationStateFailed, provisioner.OperationStateCanceled:
 		//send cunstomer notification
 		if operation.RuntimeOperation.Notification {
 			err := s.sendNotificationComplete(operation, log)
@@ -248,11 +235,25 @@ func (s *InitialisationStep) checkRuntimeStatus(operation internal.UpgradeCluste
 		return s.operationManager.OperationSucceeded(operation, msg, log)
 	case gqlschema.OperationStateFailed:
 		return s.operationManager.OperationFailed(operation, fmt.Sprintf("provisioner client returns failed status: %s", msg), nil, log)
+	case provisioner.OperationStateCanceled:
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("provisioner operation was canceled: %s", msg), nil, log)
 	}
 
 	return s.operationManager.OperationFailed(operation, fmt.Sprintf("unsupported provisioner client status: %s", status.State.String()), nil, log)
 }
 
+// OnRetriesExhausted notifies the customer that the upgrade is completed before the operation fails
+func (s *InitialisationStep) OnRetriesExhausted(operation internal.UpgradeClusterOperation, description string, log logrus.FieldLogger) (internal.UpgradeClusterOperation, time.Duration, error) {
+	if operation.RuntimeOperation.Notification {
+		err := s.sendNotificationComplete(operation, log)
+		//currently notification error can only be temporary error
+		if err != nil && kebError.IsTemporaryError(err) {
+			return operation, 5 * time.Second, nil
+		}
+	}
+	return s.operationManager.OperationFailed(operation, description, nil, log)
+}
+
 func (s *InitialisationStep) sendNotificationComplete(operation internal.UpgradeClusterOperation, log logrus.FieldLogger) error {
 	tenants := []notification.NotificationTenant{
 		{
@@ -25,6 +25,7 @@ type StepCondition func(operation internal.Operation) bool
 type StepWithCondition struct {
 	Step
 	condition StepCondition
+	policy    *process.RetryPolicy
 }
 
 type Manager struct {
@@ -44,15 +45,20 @@ func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.F
 	}
 }
 
-func (m *Manager) InitStep(step Step) {
-	m.AddStep(0, step, nil)
+func (m *Manager) InitStep(step Step, opts ...StepOption) {
+	m.AddStep(0, step, nil, opts...)
 }
 
-func (m *Manager) AddStep(weight int, step Step, condition StepCondition) {
+// AddStep adds the step with the given weight. Options like WithRetryPolicy define how the step is retried.
+func (m *Manager) AddStep(weight int, step Step, condition StepCondition, opts ...StepOption) {
 	if weight <= 0 {
 		weight = 1
 	}
-	m.steps[weight] = append(m.steps[weight], StepWithCondition{Step: step, condition: condition})
+	stepWithCondition := StepWithCondition{Step: step, condition: condition}
+	for _, opt := range opts {
+		opt(&stepWithCondition)
+	}
+	m.steps[weight] = append(m.steps[weight], stepWithCondition)
 }
 
 func (m *Manager) runStep(step Step, operation internal.UpgradeClusterOperation, logger logrus.FieldLogger) (processedOperation internal.UpgradeClusterOperation, when time.Duration, err error) {
@@ -117,6 +123,9 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 			logStep.Infof("Start step")
 
 			operation, when, err = m.runStep(step, operation, logStep)
+			if step.policy != nil {
+				operation, when, err = m.applyRetryPolicy(step, operation, when, err, logStep)
+			}
 			if err != nil {
 				logStep.Errorf("Process operation failed: %s", err)
 				return 0, err
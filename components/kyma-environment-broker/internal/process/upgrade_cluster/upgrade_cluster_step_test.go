@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	provisionerAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -79,7 +80,7 @@ func TestUpgradeClusterStep_Run(t *testing.T) {
 			},
 		},
 		Administrators: []string{provisioningOperation.ProvisioningParameters.ErsContext.UserID},
-	}).Return(gqlschema.OperationStatus{
+	}, provisioner.GardenerUpgradeExtension{}).Return(gqlschema.OperationStatus{
 		ID:        StringPtr(fixProvisionerOperationID),
 		Operation: "",
 		State:     "",
@@ -14,38 +14,32 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// CheckClusterConfigurationStep checks if the SKR configuration is applied (by reconciler)
+// CheckClusterConfigurationStep checks if the SKR configuration is applied (by reconciler).
+// The step is registered with a retry policy, which limits the time the manager waits for the reconciler.
 type CheckClusterConfigurationStep struct {
-	reconcilerClient      reconciler.Client
-	operationManager      *process.UpgradeKymaOperationManager
-	evaluationManager     *avs.EvaluationManager
-	reconciliationTimeout time.Duration
+	reconcilerClient  reconciler.Client
+	operationManager  *process.UpgradeKymaOperationManager
+	evaluationManager *avs.EvaluationManager
 }
 
 func NewCheckClusterConfigurationStep(os storage.Operations,
 	reconcilerClient reconciler.Client,
-	evaluationManager *avs.EvaluationManager,
-	provisioningTimeout time.Duration) *CheckClusterConfigurationStep {
+	evaluationManager *avs.EvaluationManager) *CheckClusterConfigurationStep {
 	return &CheckClusterConfigurationStep{
-		reconcilerClient:      reconcilerClient,
-		operationManager:      process.NewUpgradeKymaOperationManager(os),
-		evaluationManager:     evaluationManager,
-		reconciliationTimeout: provisioningTimeout,
+		reconcilerClient:  reconcilerClient,
+		operationManager:  process.NewUpgradeKymaOperationManager(os),
+		evaluationManager: evaluationManager,
 	}
 }
 
 var _ Step = (*CheckClusterConfigurationStep)(nil)
+var _ RetriesExhaustedHandler = (*CheckClusterConfigurationStep)(nil)
 
 func (s *CheckClusterConfigurationStep) Name() string {
 	return "Check_Cluster_Configuration"
 }
 
 func (s *CheckClusterConfigurationStep) Run(operation internal.UpgradeKymaOperation, log logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error) {
-	if time.Since(operation.UpdatedAt) > s.reconciliationTimeout {
-		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
-		return s.restoreAvsFailOperation(operation, fmt.Sprintf("operation has reached the time limit: %s", s.reconciliationTimeout), log)
-	}
-
 	if operation.ClusterConfigurationVersion == 0 || !operation.ClusterConfigurationApplied {
 		// upgrade was trigerred in reconciler, no need to call provisioner and create UpgradeRuntimeInput
 		// TODO: deal with skipping steps in case of calling reconciler for Kyma 2.0 upgrade - introduce stages
@@ -97,6 +91,11 @@ func (s *CheckClusterConfigurationStep) Run(operation internal.UpgradeKymaOperat
 	}
 }
 
+// OnRetriesExhausted restores the AVS evaluations before the operation fails
+func (s *CheckClusterConfigurationStep) OnRetriesExhausted(operation internal.UpgradeKymaOperation, description string, log logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error) {
+	return s.restoreAvsFailOperation(operation, description, log)
+}
+
 func (s *CheckClusterConfigurationStep) restoreAvsFailOperation(operation internal.UpgradeKymaOperation, description string, log logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error) {
 	operation, err := RestoreAvsStatus(s.evaluationManager, s.operationManager, operation, log)
 	if kebError.IsTemporaryError(err) {
@@ -25,6 +25,7 @@ type StepCondition func(operation internal.UpgradeKymaOperation) bool
 type StepWithCondition struct {
 	Step
 	condition StepCondition
+	policy    *process.RetryPolicy
 }
 
 type Manager struct {
@@ -44,18 +45,23 @@ func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.F
 	}
 }
 
-func (m *Manager) InitStep(step Step) {
-	m.AddStep(0, step, nil)
+func (m *Manager) InitStep(step Step, opts ...StepOption) {
+	m.AddStep(0, step, nil, opts...)
 }
 
-func (m *Manager) AddStep(weight int, step Step, cnd StepCondition) {
+// AddStep adds the step with the given weight. Options like WithRetryPolicy define how the step is retried.
+func (m *Manager) AddStep(weight int, step Step, cnd StepCondition, opts ...StepOption) {
 	if weight <= 0 {
 		weight = 1
 	}
-	m.steps[weight] = append(m.steps[weight], StepWithCondition{
+	stepWithCondition := StepWithCondition{
 		Step:      step,
 		condition: cnd,
-	})
+	}
+	for _, opt := range opts {
+		opt(&stepWithCondition)
+	}
+	m.steps[weight] = append(m.steps[weight], stepWithCondition)
 }
 
 func (m *Manager) runStep(step Step, operation internal.UpgradeKymaOperation, logger logrus.FieldLogger) (processedOperation internal.UpgradeKymaOperation, when time.Duration, err error) {
@@ -110,6 +116,9 @@ func (m *Manager) Execute(operationID string) (time.Duration, error) {
 			logStep.Infof("Start step")
 
 			operation, when, err = m.runStep(step, operation, logStep)
+			if step.policy != nil {
+				operation, when, err = m.applyRetryPolicy(step, operation, when, err, logStep)
+			}
 			if err != nil {
 				logStep.Errorf("Process operation failed: %s", err)
 				return 0, err
@@ -104,6 +104,40 @@ func TestManager_Execute(t *testing.T) {
 	}
 }
 
+func TestManager_ExecuteWithRetryPolicy(t *testing.T) {
+	// given
+	log := logrus.New()
+	memoryStorage := storage.NewMemoryStorage()
+	operations := memoryStorage.Operations()
+	err := operations.InsertUpgradeKymaOperation(fixOperation(operationIDRepeat))
+	assert.NoError(t, err)
+
+	sInit := testStep{t: t, name: "init", storage: operations}
+	manager := NewManager(operations, event.NewPubSub(log), log)
+	manager.InitStep(&sInit, WithRetryPolicy(process.RetryPolicy{MaxAttempts: 2, Backoff: process.ConstantBackoff(time.Minute)}))
+
+	// when
+	repeat, err := manager.Execute(operationIDRepeat)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, repeat)
+	operation, err := operations.GetUpgradeKymaOperationByID(operationIDRepeat)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, operation.StepAttempts["init"].Attempts)
+
+	// when
+	repeat, err = manager.Execute(operationIDRepeat)
+
+	// then
+	assert.Error(t, err)
+	assert.Zero(t, repeat)
+	operation, err = operations.GetUpgradeKymaOperationByID(operationIDRepeat)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Failed, operation.State)
+	assert.Contains(t, operation.Description, "step init exhausted its retries after 2 attempts")
+}
+
 func fixOperation(ID string) internal.UpgradeKymaOperation {
 	upgradeOperation := fixture.FixUpgradeKymaOperation(ID, "fea2c1a1-139d-43f6-910a-a618828a79d5")
 	upgradeOperation.State = domain.InProgress
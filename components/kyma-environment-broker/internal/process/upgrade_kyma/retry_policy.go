@@ -0,0 +1,64 @@
+package upgrade_kyma
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/sirupsen/logrus"
+)
+
+type StepOption func(step *StepWithCondition)
+
+// WithRetryPolicy makes the manager retry the step according to the given policy
+func WithRetryPolicy(policy process.RetryPolicy) StepOption {
+	return func(step *StepWithCondition) {
+		step.policy = &policy
+	}
+}
+
+// RetriesExhaustedHandler is implemented by steps which must clean up when they run out of retries.
+// The handler is called instead of failing the operation and is responsible for marking the operation as failed.
+type RetriesExhaustedHandler interface {
+	OnRetriesExhausted(operation internal.UpgradeKymaOperation, description string, log logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error)
+}
+
+// applyRetryPolicy records the attempt of the step on the operation and decides if and when the step is retried
+func (m *Manager) applyRetryPolicy(step StepWithCondition, operation internal.UpgradeKymaOperation, when time.Duration, stepErr error, log logrus.FieldLogger) (internal.UpgradeKymaOperation, time.Duration, error) {
+	if operation.IsFinished() || (stepErr == nil && when == 0) {
+		return operation, 0, stepErr
+	}
+	operationManager := process.NewUpgradeKymaOperationManager(m.operationStorage)
+
+	decision := step.policy.Decide(operation.StepAttempts, step.Name(), when, stepErr)
+	if !decision.Exhausted {
+		log.Warnf("attempt %d of the step failed: %s", decision.Attempt, decision.Reason)
+		op, repeat, err := operationManager.UpdateOperation(operation, func(op *internal.UpgradeKymaOperation) {
+			op.StepAttempts = decision.Attempts
+		}, log)
+		if repeat != 0 {
+			return op, repeat, nil
+		}
+		return op, decision.Delay, err
+	}
+
+	description := decision.Description(step.Name())
+	if step.policy.OnExhausted == process.SkipStep {
+		log.Errorf("%s, skipping the step", description)
+		attempts := process.SkippedAttempts(decision.Attempts, step.Name())
+		op, repeat, err := operationManager.UpdateOperation(operation, func(op *internal.UpgradeKymaOperation) {
+			op.StepAttempts = attempts
+		}, log)
+		if repeat != 0 {
+			return op, repeat, nil
+		}
+		return op, 0, err
+	}
+
+	log.Errorf(description)
+	operation.StepAttempts = decision.Attempts
+	if handler, ok := step.Step.(RetriesExhaustedHandler); ok {
+		return handler.OnRetriesExhausted(operation, description, log)
+	}
+	return operationManager.OperationFailed(operation, description, stepErr, log)
+}
@@ -3,22 +3,38 @@ package provider
 import (
 	"fmt"
 	"math/rand"
-	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
+// the defaults below are used only if they are missing in the provider catalog
 const (
 	DefaultAWSRegion         = "eu-central-1"
 	DefaultAWSTrialRegion    = "eu-west-1"
 	DefaultEuAccessAWSRegion = "eu-central-1"
+	DefaultAWSMachineType    = "m5.xlarge"
 	DefaultAWSMultiZoneCount = 3
 )
 
+var (
+	awsDefaults = catalog.Defaults{
+		Region:         DefaultAWSRegion,
+		EUAccessRegion: DefaultEuAccessAWSRegion,
+		MachineType:    DefaultAWSMachineType,
+	}
+	awsTrialDefaults = catalog.Defaults{
+		Region:         DefaultAWSTrialRegion,
+		EUAccessRegion: DefaultEuAccessAWSRegion,
+		MachineType:    DefaultAWSMachineType,
+	}
+)
+
 var europeAWS = "eu-west-1"
 var usAWS = "us-east-1"
 var asiaAWS = "ap-southeast-1"
@@ -49,12 +65,13 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	defaults := catalogDefaults(broker.AWSPlanName, catalog.AWS, awsDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("gp2"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    "m5.xlarge",
-			Region:         DefaultAWSRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "aws",
 			WorkerCidr:     "10.250.0.0/16",
 			AutoScalerMin:  3,
@@ -64,7 +81,7 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AwsConfig: &gqlschema.AWSProviderConfigInput{
 					VpcCidr:  "10.250.0.0/16",
-					AwsZones: generateMultipleAWSZones(MultipleZonesForAWSRegion(DefaultAWSRegion, zonesCount)),
+					AwsZones: generateMultipleAWSZones(MultipleZonesForAWSRegion(defaults.Region, zonesCount)),
 				},
 			},
 			ControlPlaneFailureTolerance: controlPlaneFailureTolerance,
@@ -72,40 +89,20 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 	}
 }
 
-// awsZones defines a possible suffixes for given AWS regions
-// The table is tested in a unit test to check if all necessary regions are covered
-var awsZones = map[string]string{
-	"eu-central-1":   "abc",
-	"eu-west-2":      "abc",
-	"ca-central-1":   "abd",
-	"sa-east-1":      "abc",
-	"us-east-1":      "abcdf",
-	"us-west-1":      "ab",
-	"ap-northeast-1": "acd",
-	"ap-northeast-2": "abc",
-	"ap-south-1":     "abc",
-	"ap-southeast-1": "abc",
-	"ap-southeast-2": "abc",
-}
-
+// ZoneForAWSRegion returns a random zone of the region, the zones are defined in the provider catalog
 func ZoneForAWSRegion(region string) string {
-	zones, found := awsZones[region]
-	if !found {
-		zones = "a"
-	}
+	zones, _ := catalogZones(catalog.AWS, region, "a")
 
-	zone := string(zones[rand.Intn(len(zones))])
+	zone := zones[rand.Intn(len(zones))]
 	return fmt.Sprintf("%s%s", region, zone)
 }
 
 func MultipleZonesForAWSRegion(region string, zonesCount int) []string {
-	zones, found := awsZones[region]
+	availableZones, found := catalogZones(catalog.AWS, region, "a")
 	if !found {
-		zones = "a"
 		zonesCount = 1
 	}
 
-	availableZones := strings.Split(zones, "")
 	rand.Shuffle(len(availableZones), func(i, j int) { availableZones[i], availableZones[j] = availableZones[j], availableZones[i] })
 	if zonesCount > len(availableZones) {
 		// get maximum number of zones for region
@@ -154,6 +151,42 @@ func generateMultipleAWSZones(zoneNames []string) []*gqlschema.AWSZoneInput {
 	return zones
 }
 
+// generateAWSZones splits the nodes range between the zones the same way as the default range above,
+// see networking.AWSZoneSubnets
+func generateAWSZones(nodes string, zoneNames []string) ([]*gqlschema.AWSZoneInput, error) {
+	zones := make([]*gqlschema.AWSZoneInput, 0, len(zoneNames))
+	for i, name := range zoneNames {
+		workers, public, internal, err := networking.AWSZoneSubnets(nodes, i)
+		if err != nil {
+			return nil, fmt.Errorf("nodes range %s cannot be split between %d zones: %w", nodes, len(zoneNames), err)
+		}
+		zones = append(zones, &gqlschema.AWSZoneInput{
+			Name:         name,
+			WorkerCidr:   workers,
+			PublicCidr:   public,
+			InternalCidr: internal,
+		})
+	}
+
+	return zones, nil
+}
+
+// applyAWSNetworking uses the nodes range as the VPC range and splits it between the zones
+func applyAWSNetworking(awsConfig *gqlschema.AWSProviderConfigInput, nodes string) error {
+	zoneNames := make([]string, 0, len(awsConfig.AwsZones))
+	for _, zone := range awsConfig.AwsZones {
+		zoneNames = append(zoneNames, zone.Name)
+	}
+	zones, err := generateAWSZones(nodes, zoneNames)
+	if err != nil {
+		return err
+	}
+
+	awsConfig.VpcCidr = nodes
+	awsConfig.AwsZones = zones
+	return nil
+}
+
 func (p *AWSInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp internal.ProvisioningParameters) {
 	switch {
 	// explicit zones list is provided
@@ -167,7 +200,7 @@ func (p *AWSInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp inter
 		}
 		input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones = generateMultipleAWSZones(MultipleZonesForAWSRegion(*pp.Parameters.Region, zonesCount))
 	case internal.IsEuAccess(pp.PlatformRegion):
-		updateRegionWithZones(input, DefaultEuAccessAWSRegion)
+		updateRegionWithZones(input, catalogDefaults(broker.AWSPlanName, catalog.AWS, awsDefaults).EUAccessRegion)
 	}
 }
 
@@ -180,15 +213,16 @@ func (p *AWSInput) Provider() internal.CloudProvider {
 }
 
 func (p *AWSTrialInput) Defaults() *gqlschema.ClusterConfigInput {
-	return awsLiteDefaults(DefaultAWSTrialRegion)
+	return awsLiteDefaults(catalogDefaults(broker.TrialPlanName, catalog.AWS, awsTrialDefaults))
 }
 
-func awsLiteDefaults(region string) *gqlschema.ClusterConfigInput {
+func awsLiteDefaults(defaults catalog.Defaults) *gqlschema.ClusterConfigInput {
+	region := defaults.Region
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("gp2"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    "m5.xlarge",
+			MachineType:    defaults.MachineType,
 			Region:         region,
 			Provider:       "aws",
 			WorkerCidr:     "10.250.0.0/19",
@@ -218,7 +252,7 @@ func (p *AWSTrialInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp
 	params := pp.Parameters
 
 	if internal.IsEuAccess(pp.PlatformRegion) {
-		updateRegionWithZones(input, DefaultEuAccessAWSRegion)
+		updateRegionWithZones(input, catalogDefaults(broker.TrialPlanName, catalog.AWS, awsTrialDefaults).EUAccessRegion)
 		return
 	}
 
@@ -252,7 +286,7 @@ func (p *AWSTrialInput) Provider() internal.CloudProvider {
 
 func (p *AWSFreemiumInput) Defaults() *gqlschema.ClusterConfigInput {
 	// Lite (freemium) must have the same defaults as Trial plan, but there was a requirement to change a region only for Trial.
-	defaults := awsLiteDefaults(DefaultAWSRegion)
+	defaults := awsLiteDefaults(catalogDefaults(broker.FreemiumPlanName, catalog.AWS, awsDefaults))
 
 	return defaults
 }
@@ -5,27 +5,30 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAWSZones(t *testing.T) {
 	regions := broker.AWSRegions(false)
 	for _, region := range regions {
-		_, exists := awsZones[region]
+		_, exists := catalog.Current().Zones(catalog.AWS, region)
 		assert.True(t, exists)
 	}
-	_, exists := awsZones[DefaultAWSRegion]
+	_, exists := catalog.Current().Zones(catalog.AWS, DefaultAWSRegion)
 	assert.True(t, exists)
 }
 
 func TestAWSZonesForEuAccess(t *testing.T) {
 	regions := broker.AWSRegions(true)
 	for _, region := range regions {
-		_, exists := awsZones[region]
+		_, exists := catalog.Current().Zones(catalog.AWS, region)
 		assert.True(t, exists)
 	}
-	_, exists := awsZones[DefaultEuAccessAWSRegion]
+	_, exists := catalog.Current().Zones(catalog.AWS, DefaultEuAccessAWSRegion)
 	assert.True(t, exists)
 }
 
@@ -62,7 +65,8 @@ func TestMultipleZonesForAWSRegion(t *testing.T) {
 		// given
 		region := "us-east-1"
 		zonesCountExceedingMaximum := 20
-		maximumZonesForRegion := len(awsZones[region])
+		zones, _ := catalog.Current().Zones(catalog.AWS, region)
+		maximumZonesForRegion := len(zones)
 		// "us-east-1" region has maximum 6 zones, user request 20
 
 		// when
@@ -230,6 +234,49 @@ func TestAWSInput_MultiZone_ApplyParameters(t *testing.T) {
 		}
 		assert.Equal(t, "zone", *input.GardenerConfig.ControlPlaneFailureTolerance)
 	})
+
+	// when
+	t.Run("use networking input parameter", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+		zones := []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}
+		params := internal.ProvisioningParametersDTO{
+			Zones:      zones,
+			Networking: &internal.NetworkingDTO{NodesCidr: "192.168.0.0/20"},
+		}
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{Parameters: params})
+		err := ApplyNetworking(input, params.Networking)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.0.0/20", input.GardenerConfig.WorkerCidr)
+		assert.Equal(t, "192.168.0.0/20", input.GardenerConfig.ProviderSpecificConfig.AwsConfig.VpcCidr)
+		assert.Equal(t, []*gqlschema.AWSZoneInput{
+			{Name: "eu-central-1a", WorkerCidr: "192.168.0.0/23", PublicCidr: "192.168.2.0/24", InternalCidr: "192.168.3.0/24"},
+			{Name: "eu-central-1b", WorkerCidr: "192.168.4.0/23", PublicCidr: "192.168.6.0/24", InternalCidr: "192.168.7.0/24"},
+			{Name: "eu-central-1c", WorkerCidr: "192.168.8.0/23", PublicCidr: "192.168.10.0/24", InternalCidr: "192.168.11.0/24"},
+		}, input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones)
+	})
+
+	// when
+	t.Run("fail when the nodes range is too small for the zones", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+		params := internal.ProvisioningParametersDTO{
+			Zones:      []string{"eu-central-1a", "eu-central-1b", "eu-central-1c", "eu-central-1d", "eu-central-1e"},
+			Networking: &internal.NetworkingDTO{NodesCidr: "192.168.0.0/20"},
+		}
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{Parameters: params})
+		err := ApplyNetworking(input, params.Networking)
+
+		//then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nodes range 192.168.0.0/20 cannot be split between 5 zones")
+	})
 }
 
 func TestAWSTrialInput_ApplyParameters(t *testing.T) {
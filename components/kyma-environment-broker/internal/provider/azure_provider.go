@@ -7,16 +7,26 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
+// the defaults below are used only if they are missing in the provider catalog
 const (
 	DefaultAzureRegion         = "eastus"
 	DefaultEuAccessAzureRegion = "switzerlandnorth"
+	DefaultAzureMachineType    = "Standard_D4_v3"
 	DefaultAzureMultiZoneCount = 3
 )
 
+var azureDefaults = catalog.Defaults{
+	Region:         DefaultAzureRegion,
+	EUAccessRegion: DefaultEuAccessAzureRegion,
+	MachineType:    DefaultAzureMachineType,
+}
+
 var europeAzure = "westeurope"
 var usAzure = "eastus"
 var asiaAzure = "southeastasia"
@@ -50,12 +60,13 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	defaults := catalogDefaults(broker.AzurePlanName, catalog.Azure, azureDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("Standard_LRS"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    "Standard_D4_v3",
-			Region:         DefaultAzureRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "azure",
 			WorkerCidr:     "10.250.0.0/16",
 			AutoScalerMin:  3,
@@ -65,7 +76,7 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
 					VnetCidr:         "10.250.0.0/16",
-					AzureZones:       generateMultipleAzureZones(generateRandomAzureZones(zonesCount)),
+					AzureZones:       generateMultipleAzureZones(generateRandomAzureZones(defaults.Region, zonesCount)),
 					EnableNatGateway: ptr.Bool(true),
 				},
 			},
@@ -76,12 +87,9 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 
 func (p *AzureInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp internal.ProvisioningParameters) {
 	if internal.IsEuAccess(pp.PlatformRegion) {
-		updateString(&input.GardenerConfig.Region, ptr.String(DefaultEuAccessAzureRegion))
-		return
-	}
-
-	// explicit zones list is provided
-	if len(pp.Parameters.Zones) > 0 {
+		updateString(&input.GardenerConfig.Region, ptr.String(catalogDefaults(broker.AzurePlanName, catalog.Azure, azureDefaults).EUAccessRegion))
+	} else if len(pp.Parameters.Zones) > 0 {
+		// explicit zones list is provided
 		zones := []int{}
 		for _, inputZone := range pp.Parameters.Zones {
 			zone, err := strconv.Atoi(inputZone)
@@ -103,12 +111,13 @@ func (p *AzureInput) Provider() internal.CloudProvider {
 }
 
 func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
+	defaults := catalogDefaults(broker.AzureLitePlanName, catalog.Azure, azureDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("Standard_LRS"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    "Standard_D4_v3",
-			Region:         DefaultAzureRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "azure",
 			WorkerCidr:     "10.250.0.0/19",
 			AutoScalerMin:  2,
@@ -120,7 +129,7 @@ func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
 					VnetCidr: "10.250.0.0/19",
 					AzureZones: []*gqlschema.AzureZoneInput{
 						{
-							Name: generateRandomAzureZone(),
+							Name: generateRandomAzureZone(defaults.Region),
 							Cidr: "10.250.0.0/19",
 						},
 					},
@@ -133,7 +142,7 @@ func (p *AzureLiteInput) Defaults() *gqlschema.ClusterConfigInput {
 
 func (p *AzureLiteInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp internal.ProvisioningParameters) {
 	if internal.IsEuAccess(pp.PlatformRegion) {
-		updateString(&input.GardenerConfig.Region, ptr.String(DefaultEuAccessAzureRegion))
+		updateString(&input.GardenerConfig.Region, ptr.String(catalogDefaults(broker.AzureLitePlanName, catalog.Azure, azureDefaults).EUAccessRegion))
 	}
 }
 
@@ -146,16 +155,16 @@ func (p *AzureLiteInput) Provider() internal.CloudProvider {
 }
 
 func (p *AzureTrialInput) Defaults() *gqlschema.ClusterConfigInput {
-	return azureTrialDefaults()
+	return azureTrialDefaults(catalogDefaults(broker.TrialPlanName, catalog.Azure, azureDefaults))
 }
 
-func azureTrialDefaults() *gqlschema.ClusterConfigInput {
+func azureTrialDefaults(defaults catalog.Defaults) *gqlschema.ClusterConfigInput {
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("Standard_LRS"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    "Standard_D4_v3",
-			Region:         DefaultAzureRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "azure",
 			WorkerCidr:     "10.250.0.0/19",
 			AutoScalerMin:  1,
@@ -168,7 +177,7 @@ func azureTrialDefaults() *gqlschema.ClusterConfigInput {
 					VnetCidr: "10.250.0.0/19",
 					AzureZones: []*gqlschema.AzureZoneInput{
 						{
-							Name: generateRandomAzureZone(),
+							Name: generateRandomAzureZone(defaults.Region),
 							Cidr: "10.250.0.0/19",
 						},
 					},
@@ -183,7 +192,7 @@ func (p *AzureTrialInput) ApplyParameters(input *gqlschema.ClusterConfigInput, p
 	params := pp.Parameters
 
 	if internal.IsEuAccess(pp.PlatformRegion) {
-		updateString(&input.GardenerConfig.Region, ptr.String(DefaultEuAccessAzureRegion))
+		updateString(&input.GardenerConfig.Region, ptr.String(catalogDefaults(broker.TrialPlanName, catalog.Azure, azureDefaults).EUAccessRegion))
 		return
 	}
 
@@ -210,7 +219,7 @@ func (p *AzureTrialInput) Profile() gqlschema.KymaProfile {
 }
 
 func (p *AzureFreemiumInput) Defaults() *gqlschema.ClusterConfigInput {
-	return azureTrialDefaults()
+	return azureTrialDefaults(catalogDefaults(broker.FreemiumPlanName, catalog.Azure, azureDefaults))
 }
 
 func (p *AzureFreemiumInput) ApplyParameters(input *gqlschema.ClusterConfigInput, params internal.ProvisioningParameters) {
@@ -225,24 +234,26 @@ func (p *AzureFreemiumInput) Provider() internal.CloudProvider {
 	return internal.Azure
 }
 
-func generateRandomAzureZone() int {
-	const (
-		min = 1
-		max = 3
-	)
-
-	// generates random number from 1-3 range
-	getRandomNumber := func() int {
-		return rand.Intn(max-min+1) + min
-	}
-
-	return getRandomNumber()
+func generateRandomAzureZone(region string) int {
+	return generateRandomAzureZones(region, 1)[0]
 }
 
-func generateRandomAzureZones(zonesCount int) []int {
-	zones := []int{1, 2, 3}
-	if zonesCount > 3 {
-		zonesCount = 3
+// generateRandomAzureZones returns random zones of the region, the zones are defined in the provider catalog
+func generateRandomAzureZones(region string, zonesCount int) []int {
+	zoneNames, _ := catalogZones(catalog.Azure, region, "1", "2", "3")
+	zones := []int{}
+	for _, name := range zoneNames {
+		zone, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+		zones = append(zones, zone)
+	}
+	if len(zones) == 0 {
+		zones = []int{1, 2, 3}
+	}
+	if zonesCount > len(zones) {
+		zonesCount = len(zones)
 	}
 
 	rand.Shuffle(len(zones), func(i, j int) { zones[i], zones[j] = zones[j], zones[i] })
@@ -261,3 +272,37 @@ func generateMultipleAzureZones(zoneNames []int) []*gqlschema.AzureZoneInput {
 
 	return zones
 }
+
+// generateAzureZones splits the nodes range between the zones the same way as the default range above,
+// see networking.AzureZoneSubnet
+func generateAzureZones(nodes string, zoneNames []int) ([]*gqlschema.AzureZoneInput, error) {
+	zones := []*gqlschema.AzureZoneInput{}
+	for i, zone := range zoneNames {
+		cidr, err := networking.AzureZoneSubnet(nodes, i)
+		if err != nil {
+			return nil, fmt.Errorf("nodes range %s cannot be split between %d zones: %w", nodes, len(zoneNames), err)
+		}
+		zones = append(zones, &gqlschema.AzureZoneInput{
+			Name: zone,
+			Cidr: cidr,
+		})
+	}
+
+	return zones, nil
+}
+
+// applyAzureNetworking uses the nodes range as the VNet range and splits it between the zones
+func applyAzureNetworking(azureConfig *gqlschema.AzureProviderConfigInput, nodes string) error {
+	zoneNames := make([]int, 0, len(azureConfig.AzureZones))
+	for _, zone := range azureConfig.AzureZones {
+		zoneNames = append(zoneNames, zone.Name)
+	}
+	zones, err := generateAzureZones(nodes, zoneNames)
+	if err != nil {
+		return err
+	}
+
+	azureConfig.VnetCidr = nodes
+	azureConfig.AzureZones = zones
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAzureTrialInput_ApplyParametersWithRegion(t *testing.T) { //TODO apply EU Access for trials
@@ -238,6 +239,40 @@ func TestAzureInput_MultiZone_ApplyParameters(t *testing.T) {
 		}
 		assert.Equal(t, "zone", *input.GardenerConfig.ControlPlaneFailureTolerance)
 	})
+
+	// when
+	t.Run("use networking parameter", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+
+		params := internal.ProvisioningParametersDTO{
+			Zones:      []string{"1", "2", "3"},
+			Networking: &internal.NetworkingDTO{NodesCidr: "10.10.0.0/18"},
+		}
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{Parameters: params})
+		err := ApplyNetworking(input, params.Networking)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, "10.10.0.0/18", input.GardenerConfig.WorkerCidr)
+		assert.Equal(t, "10.10.0.0/18", input.GardenerConfig.ProviderSpecificConfig.AzureConfig.VnetCidr)
+		assert.Equal(t, []*gqlschema.AzureZoneInput{
+			{Name: 1, Cidr: "10.10.0.0/21"},
+			{Name: 2, Cidr: "10.10.8.0/21"},
+			{Name: 3, Cidr: "10.10.16.0/21"},
+		}, input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones)
+	})
+}
+
+func TestGenerateAzureZones(t *testing.T) {
+	// when
+	_, err := generateAzureZones("10.10.0.0/18", []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nodes range 10.10.0.0/18 cannot be split between 9 zones")
 }
 
 func azureZoneNames(zones []*gqlschema.AzureZoneInput) []int {
@@ -1,5 +1,7 @@
 package provider
 
+import "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
+
 func updateString(toUpdate *string, value *string) {
 	if value != nil {
 		*toUpdate = *value
@@ -11,3 +13,29 @@ func updateSlice(toUpdate *[]string, value []string) {
 		*toUpdate = value
 	}
 }
+
+// catalogDefaults returns the defaults of the plan for the provider taken from the provider catalog,
+// the values missing in the catalog are taken from the fallback
+func catalogDefaults(planName, providerName string, fallback catalog.Defaults) catalog.Defaults {
+	defaults := catalog.Current().Defaults(planName, providerName)
+	if defaults.Region == "" {
+		defaults.Region = fallback.Region
+	}
+	if defaults.EUAccessRegion == "" {
+		defaults.EUAccessRegion = fallback.EUAccessRegion
+	}
+	if defaults.MachineType == "" {
+		defaults.MachineType = fallback.MachineType
+	}
+	return defaults
+}
+
+// catalogZones returns the zones of the region taken from the provider catalog or the fallback zones
+// if the region is not in the catalog
+func catalogZones(providerName, region string, fallback ...string) ([]string, bool) {
+	zones, found := catalog.Current().Zones(providerName, region)
+	if !found {
+		return fallback, false
+	}
+	return zones, true
+}
@@ -7,17 +7,24 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
+// the defaults below are used only if they are missing in the provider catalog
 const (
 	DefaultGCPRegion         = "europe-west3"
 	DefaultGCPMachineType    = "n2-standard-4"
 	DefaultGCPMultiZoneCount = 3
 )
 
+var gcpDefaults = catalog.Defaults{
+	Region:      DefaultGCPRegion,
+	MachineType: DefaultGCPMachineType,
+}
+
 var europeGcp = "europe-west3"
 var usGcp = "us-central1"
 var asiaGcp = "asia-south1"
@@ -47,12 +54,13 @@ func (p *GcpInput) Defaults() *gqlschema.ClusterConfigInput {
 	if p.ControlPlaneFailureTolerance != "" {
 		controlPlaneFailureTolerance = &p.ControlPlaneFailureTolerance
 	}
+	defaults := catalogDefaults(broker.GCPPlanName, catalog.GCP, gcpDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("pd-standard"),
 			VolumeSizeGb:   ptr.Integer(50),
-			MachineType:    DefaultGCPMachineType,
-			Region:         DefaultGCPRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "gcp",
 			WorkerCidr:     "10.250.0.0/19",
 			AutoScalerMin:  3,
@@ -61,7 +69,7 @@ func (p *GcpInput) Defaults() *gqlschema.ClusterConfigInput {
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				GcpConfig: &gqlschema.GCPProviderConfigInput{
-					Zones: ZonesForGCPRegion(DefaultGCPRegion, zonesCount),
+					Zones: ZonesForGCPRegion(defaults.Region, zonesCount),
 				},
 			},
 			ControlPlaneFailureTolerance: controlPlaneFailureTolerance,
@@ -93,12 +101,13 @@ func (p *GcpInput) Provider() internal.CloudProvider {
 }
 
 func (p *GcpTrialInput) Defaults() *gqlschema.ClusterConfigInput {
+	defaults := catalogDefaults(broker.TrialPlanName, catalog.GCP, gcpDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:       ptr.String("pd-standard"),
 			VolumeSizeGb:   ptr.Integer(30),
-			MachineType:    "n2-standard-4",
-			Region:         DefaultGCPRegion,
+			MachineType:    defaults.MachineType,
+			Region:         defaults.Region,
 			Provider:       "gcp",
 			WorkerCidr:     "10.250.0.0/19",
 			AutoScalerMin:  1,
@@ -107,7 +116,7 @@ func (p *GcpTrialInput) Defaults() *gqlschema.ClusterConfigInput {
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				GcpConfig: &gqlschema.GCPProviderConfigInput{
-					Zones: ZonesForGCPRegion(DefaultGCPRegion, 1),
+					Zones: ZonesForGCPRegion(defaults.Region, 1),
 				},
 			},
 		},
@@ -146,8 +155,9 @@ func (p *GcpTrialInput) Provider() internal.CloudProvider {
 	return internal.GCP
 }
 
+// ZonesForGCPRegion returns random zones of the region, the zones are defined in the provider catalog
 func ZonesForGCPRegion(region string, zonesCount int) []string {
-	zoneCodes := []string{"a", "b", "c"}
+	zoneCodes, _ := catalogZones(catalog.GCP, region, "a", "b", "c")
 	var zones []string
 	rand.Shuffle(len(zoneCodes), func(i, j int) { zoneCodes[i], zoneCodes[j] = zoneCodes[j], zoneCodes[i] })
 
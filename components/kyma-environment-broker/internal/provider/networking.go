@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// ApplyNetworking replaces the default nodes range with the one from the networking parameters and splits it
+// between the zones of the cluster. It must be called after ApplyParameters, which sets the zones.
+func ApplyNetworking(input *gqlschema.ClusterConfigInput, params *internal.NetworkingDTO) error {
+	if params == nil {
+		return nil
+	}
+	input.GardenerConfig.WorkerCidr = params.NodesCidr
+
+	providerConfig := input.GardenerConfig.ProviderSpecificConfig
+	switch {
+	case providerConfig == nil:
+		return nil
+	case providerConfig.AwsConfig != nil:
+		if err := applyAWSNetworking(providerConfig.AwsConfig, params.NodesCidr); err != nil {
+			return fmt.Errorf("while applying networking parameters: %w", err)
+		}
+	case providerConfig.AzureConfig != nil:
+		if err := applyAzureNetworking(providerConfig.AzureConfig, params.NodesCidr); err != nil {
+			return fmt.Errorf("while applying networking parameters: %w", err)
+		}
+	}
+	// GCP subnets are regional, the nodes range is not split between the zones
+
+	return nil
+}
@@ -7,24 +7,34 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 const (
-	DefaultOpenStackRegion = "eu-de-2"
-	DefaultExposureClass   = "converged-cloud-internet"
+	// DefaultOpenStackRegion and DefaultOpenStackMachineType are used only if they are missing in the provider catalog
+	DefaultOpenStackRegion      = "eu-de-2"
+	DefaultOpenStackMachineType = "g_c4_m16"
+	DefaultExposureClass        = "converged-cloud-internet"
 )
 
+var openStackDefaults = catalog.Defaults{
+	Region:      DefaultOpenStackRegion,
+	MachineType: DefaultOpenStackMachineType,
+}
+
 type OpenStackInput struct {
 	FloatingPoolName string
 }
 
 func (p *OpenStackInput) Defaults() *gqlschema.ClusterConfigInput {
+	defaults := catalogDefaults(broker.OpenStackPlanName, catalog.OpenStack, openStackDefaults)
 	return &gqlschema.ClusterConfigInput{
 		GardenerConfig: &gqlschema.GardenerConfigInput{
 			DiskType:          nil,
-			MachineType:       "g_c4_m16",
-			Region:            DefaultOpenStackRegion,
+			MachineType:       defaults.MachineType,
+			Region:            defaults.Region,
 			Provider:          "openstack",
 			WorkerCidr:        "10.250.0.0/19",
 			AutoScalerMin:     4,
@@ -34,7 +44,7 @@ func (p *OpenStackInput) Defaults() *gqlschema.ClusterConfigInput {
 			ExposureClassName: ptr.String(DefaultExposureClass),
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				OpenStackConfig: &gqlschema.OpenStackProviderConfigInput{
-					Zones:                ZonesForOpenStack(DefaultOpenStackRegion),
+					Zones:                ZonesForOpenStack(defaults.Region),
 					FloatingPoolName:     p.FloatingPoolName,
 					CloudProfileName:     "converged-cloud-cp",
 					LoadBalancerProvider: "f5",
@@ -58,19 +68,9 @@ func (p *OpenStackInput) Provider() internal.CloudProvider {
 	return internal.Openstack
 }
 
-// openstackZones defines a possible suffixes for given OpenStack regions
-// The table is tested in a unit test to check if all necessary regions are covered
-var openstackZones = map[string]string{
-	"eu-de-1": "abd",
-	"eu-de-2": "abd",
-	"ap-sa-1": "a",
-}
-
+// ZonesForOpenStack returns a random zone of the region, the zones are defined in the provider catalog
 func ZonesForOpenStack(region string) []string {
-	zones, found := openstackZones[region]
-	if !found {
-		zones = "a"
-	}
-	zone := string(zones[rand.Intn(len(zones))])
+	zones, _ := catalogZones(catalog.OpenStack, region, "a")
+	zone := zones[rand.Intn(len(zones))]
 	return []string{fmt.Sprintf("%s%s", region, zone)}
 }
@@ -4,15 +4,16 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/catalog"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestZonesForOpenStackZones(t *testing.T) {
 	regions := broker.OpenStackRegions()
 	for _, region := range regions {
-		_, exists := openstackZones[region]
+		_, exists := catalog.Current().Zones(catalog.OpenStack, region)
 		assert.True(t, exists)
 	}
-	_, exists := openstackZones[DefaultOpenStackRegion]
+	_, exists := catalog.Current().Zones(catalog.OpenStack, DefaultOpenStackRegion)
 	assert.True(t, exists)
 }
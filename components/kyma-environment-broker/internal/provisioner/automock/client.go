@@ -5,6 +5,8 @@ package automock
 import (
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	mock "github.com/stretchr/testify/mock"
+
+	provisioner "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 )
 
 // Client is an autogenerated mock type for the Client type
@@ -33,20 +35,20 @@ func (_m *Client) DeprovisionRuntime(accountID string, runtimeID string) (string
 	return r0, r1
 }
 
-// ProvisionRuntime provides a mock function with given fields: accountID, subAccountID, config
-func (_m *Client) ProvisionRuntime(accountID string, subAccountID string, config gqlschema.ProvisionRuntimeInput) (gqlschema.OperationStatus, error) {
-	ret := _m.Called(accountID, subAccountID, config)
+// ProvisionRuntime provides a mock function with given fields: accountID, subAccountID, config, extension
+func (_m *Client) ProvisionRuntime(accountID string, subAccountID string, config gqlschema.ProvisionRuntimeInput, extension provisioner.GardenerConfigExtension) (gqlschema.OperationStatus, error) {
+	ret := _m.Called(accountID, subAccountID, config, extension)
 
 	var r0 gqlschema.OperationStatus
-	if rf, ok := ret.Get(0).(func(string, string, gqlschema.ProvisionRuntimeInput) gqlschema.OperationStatus); ok {
-		r0 = rf(accountID, subAccountID, config)
+	if rf, ok := ret.Get(0).(func(string, string, gqlschema.ProvisionRuntimeInput, provisioner.GardenerConfigExtension) gqlschema.OperationStatus); ok {
+		r0 = rf(accountID, subAccountID, config, extension)
 	} else {
 		r0 = ret.Get(0).(gqlschema.OperationStatus)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, gqlschema.ProvisionRuntimeInput) error); ok {
-		r1 = rf(accountID, subAccountID, config)
+	if rf, ok := ret.Get(1).(func(string, string, gqlschema.ProvisionRuntimeInput, provisioner.GardenerConfigExtension) error); ok {
+		r1 = rf(accountID, subAccountID, config, extension)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,20 +140,20 @@ func (_m *Client) UpgradeRuntime(accountID string, runtimeID string, config gqls
 	return r0, r1
 }
 
-// UpgradeShoot provides a mock function with given fields: accountID, runtimeID, config
-func (_m *Client) UpgradeShoot(accountID string, runtimeID string, config gqlschema.UpgradeShootInput) (gqlschema.OperationStatus, error) {
-	ret := _m.Called(accountID, runtimeID, config)
+// UpgradeShoot provides a mock function with given fields: accountID, runtimeID, config, extension
+func (_m *Client) UpgradeShoot(accountID string, runtimeID string, config gqlschema.UpgradeShootInput, extension provisioner.GardenerUpgradeExtension) (gqlschema.OperationStatus, error) {
+	ret := _m.Called(accountID, runtimeID, config, extension)
 
 	var r0 gqlschema.OperationStatus
-	if rf, ok := ret.Get(0).(func(string, string, gqlschema.UpgradeShootInput) gqlschema.OperationStatus); ok {
-		r0 = rf(accountID, runtimeID, config)
+	if rf, ok := ret.Get(0).(func(string, string, gqlschema.UpgradeShootInput, provisioner.GardenerUpgradeExtension) gqlschema.OperationStatus); ok {
+		r0 = rf(accountID, runtimeID, config, extension)
 	} else {
 		r0 = ret.Get(0).(gqlschema.OperationStatus)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, gqlschema.UpgradeShootInput) error); ok {
-		r1 = rf(accountID, runtimeID, config)
+	if rf, ok := ret.Get(1).(func(string, string, gqlschema.UpgradeShootInput, provisioner.GardenerUpgradeExtension) error); ok {
+		r1 = rf(accountID, runtimeID, config, extension)
 	} else {
 		r1 = ret.Error(1)
 	}
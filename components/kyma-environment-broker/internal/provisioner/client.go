@@ -18,13 +18,19 @@ const (
 	subAccountIDKey = "sub-account"
 )
 
+// States of the canceled Provisioner operations. The operation in the Canceling state is still reverting its changes.
+const (
+	OperationStateCanceling schema.OperationState = "Canceling"
+	OperationStateCanceled  schema.OperationState = "Canceled"
+)
+
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore
 
 type Client interface {
-	ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput) (schema.OperationStatus, error)
+	ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput, extension GardenerConfigExtension) (schema.OperationStatus, error)
 	DeprovisionRuntime(accountID, runtimeID string) (string, error)
 	UpgradeRuntime(accountID, runtimeID string, config schema.UpgradeRuntimeInput) (schema.OperationStatus, error)
-	UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput) (schema.OperationStatus, error)
+	UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput, extension GardenerUpgradeExtension) (schema.OperationStatus, error)
 	ReconnectRuntimeAgent(accountID, runtimeID string) (string, error)
 	RuntimeOperationStatus(accountID, operationID string) (schema.OperationStatus, error)
 	RuntimeStatus(accountID, runtimeID string) (schema.RuntimeStatus, error)
@@ -51,8 +57,8 @@ func NewProvisionerClient(endpoint string, queryDumping bool) Client {
 	}
 }
 
-func (c *client) ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput) (schema.OperationStatus, error) {
-	provisionRuntimeIptGQL, err := c.graphqlizer.ProvisionRuntimeInputToGraphQL(config)
+func (c *client) ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput, extension GardenerConfigExtension) (schema.OperationStatus, error) {
+	provisionRuntimeIptGQL, err := c.graphqlizer.ProvisionRuntimeInputToGraphQL(config, extension)
 	if err != nil {
 		return schema.OperationStatus{}, fmt.Errorf("failed to convert Provision Runtime Input to query: %w", err)
 	}
@@ -102,8 +108,8 @@ func (c *client) UpgradeRuntime(accountID, runtimeID string, config schema.Upgra
 	return res, nil
 }
 
-func (c *client) UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput) (schema.OperationStatus, error) {
-	upgradeShootIptGQL, err := c.graphqlizer.UpgradeShootInputToGraphQL(config)
+func (c *client) UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput, extension GardenerUpgradeExtension) (schema.OperationStatus, error) {
+	upgradeShootIptGQL, err := c.graphqlizer.UpgradeShootInputToGraphQL(config, extension)
 	if err != nil {
 		return schema.OperationStatus{}, fmt.Errorf("failed to convert Upgrade Shoot Input to query: %w", err)
 	}
@@ -45,7 +45,7 @@ func TestClient_ProvisionRuntime(t *testing.T) {
 		client := NewProvisionerClient(testServer.URL, false)
 
 		// When
-		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 
 		// Then
 		assert.NoError(t, err)
@@ -65,7 +65,7 @@ func TestClient_ProvisionRuntime(t *testing.T) {
 		client := NewProvisionerClient(testServer.URL, false)
 
 		// When
-		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInputWithoutDnsConfig())
+		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInputWithoutDnsConfig(), GardenerConfigExtension{})
 
 		// Then
 		assert.NoError(t, err)
@@ -85,7 +85,7 @@ func TestClient_ProvisionRuntime(t *testing.T) {
 		client := NewProvisionerClient(testServer.URL, false)
 
 		// When
-		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		status, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 
 		// Then
 		assert.Error(t, err)
@@ -103,7 +103,7 @@ func TestClient_DeprovisionRuntime(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		// When
@@ -123,7 +123,7 @@ func TestClient_DeprovisionRuntime(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		tr.failed = true
@@ -147,7 +147,7 @@ func TestClient_UpgradeRuntime(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		// when
@@ -168,7 +168,7 @@ func TestClient_UpgradeRuntime(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		tr.failed = true
@@ -192,11 +192,11 @@ func TestClient_UpgradeShoot(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		// when
-		status, err := client.UpgradeShoot(testAccountID, *operation.RuntimeID, fixUpgradeShootInput())
+		status, err := client.UpgradeShoot(testAccountID, *operation.RuntimeID, fixUpgradeShootInput(), GardenerUpgradeExtension{})
 
 		// then
 		assert.NoError(t, err)
@@ -213,13 +213,13 @@ func TestClient_UpgradeShoot(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		tr.failed = true
 
 		// when
-		status, err := client.UpgradeShoot(testAccountID, *operation.RuntimeID, fixUpgradeShootInput())
+		status, err := client.UpgradeShoot(testAccountID, *operation.RuntimeID, fixUpgradeShootInput(), GardenerUpgradeExtension{})
 
 		// Then
 		assert.Error(t, err)
@@ -237,7 +237,7 @@ func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		// When
@@ -255,7 +255,7 @@ func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		operation, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		tr.failed = true
@@ -292,7 +292,7 @@ func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 		client := NewProvisionerClient(server.URL, false)
 
 		// when
-		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		lastErr := kebError.ReasonForError(err)
 
 		// Then
@@ -326,7 +326,7 @@ func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 		client := NewProvisionerClient(server.URL, false)
 
 		// when
-		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		lastErr := kebError.ReasonForError(err)
 
 		// Then
@@ -340,7 +340,7 @@ func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 		client := NewProvisionerClient("http://not-existing", false)
 
 		// when
-		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 
 		// Then
 		assert.Error(t, err)
@@ -356,7 +356,7 @@ func TestClient_RuntimeOperationStatus(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		// When
@@ -377,7 +377,7 @@ func TestClient_RuntimeOperationStatus(t *testing.T) {
 		defer testServer.Close()
 
 		client := NewProvisionerClient(testServer.URL, false)
-		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput(), GardenerConfigExtension{})
 		assert.NoError(t, err)
 
 		tr.failed = true
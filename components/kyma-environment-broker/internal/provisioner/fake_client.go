@@ -18,6 +18,7 @@ import (
 
 type runtime struct {
 	runtimeInput schema.ProvisionRuntimeInput
+	extension    GardenerConfigExtension
 }
 
 type FakeClient struct {
@@ -27,8 +28,10 @@ type FakeClient struct {
 	runtimes      []runtime
 	upgrades      map[string]schema.UpgradeRuntimeInput
 	shootUpgrades map[string]schema.UpgradeShootInput
-	operations    map[string]schema.OperationStatus
-	dumpRequest   bool
+	// shootUpgradeExtensions holds the fields which the pinned provisioner module does not have yet
+	shootUpgradeExtensions map[string]GardenerUpgradeExtension
+	operations             map[string]schema.OperationStatus
+	dumpRequest            bool
 
 	gardenerClient    dynamic.Interface
 	gardenerNamespace string
@@ -40,12 +43,13 @@ func NewFakeClient() *FakeClient {
 
 func NewFakeClientWithGardener(gc dynamic.Interface, ns string) *FakeClient {
 	return &FakeClient{
-		graphqlizer:    Graphqlizer{},
-		runtimes:       []runtime{},
-		operations:     make(map[string]schema.OperationStatus),
-		upgrades:       make(map[string]schema.UpgradeRuntimeInput),
-		shootUpgrades:  make(map[string]schema.UpgradeShootInput),
-		gardenerClient: gc,
+		graphqlizer:            Graphqlizer{},
+		runtimes:               []runtime{},
+		operations:             make(map[string]schema.OperationStatus),
+		upgrades:               make(map[string]schema.UpgradeRuntimeInput),
+		shootUpgrades:          make(map[string]schema.UpgradeShootInput),
+		shootUpgradeExtensions: make(map[string]GardenerUpgradeExtension),
+		gardenerClient:         gc,
 	}
 }
 
@@ -103,11 +107,11 @@ func (c *FakeClient) SetOperation(id string, operation schema.OperationStatus) {
 
 // Provisioner Client methods
 
-func (c *FakeClient) ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput) (schema.OperationStatus, error) {
+func (c *FakeClient) ProvisionRuntime(accountID, subAccountID string, config schema.ProvisionRuntimeInput, extension GardenerConfigExtension) (schema.OperationStatus, error) {
 	rid := uuid.New().String()
 	opId := uuid.New().String()
 
-	return c.ProvisionRuntimeWithIDs(accountID, subAccountID, rid, opId, config)
+	return c.provisionRuntime(accountID, subAccountID, rid, opId, config, extension)
 }
 
 func (c *FakeClient) Provision(operation internal.ProvisioningOperation) (schema.OperationStatus, error) {
@@ -121,16 +125,21 @@ func (c *FakeClient) Provision(operation internal.ProvisioningOperation) (schema
 }
 
 func (c *FakeClient) ProvisionRuntimeWithIDs(accountID, subAccountID, runtimeID, operationID string, config schema.ProvisionRuntimeInput) (schema.OperationStatus, error) {
+	return c.provisionRuntime(accountID, subAccountID, runtimeID, operationID, config, GardenerConfigExtension{})
+}
+
+func (c *FakeClient) provisionRuntime(accountID, subAccountID, runtimeID, operationID string, config schema.ProvisionRuntimeInput, extension GardenerConfigExtension) (schema.OperationStatus, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if c.dumpRequest {
-		gql, _ := c.graphqlizer.ProvisionRuntimeInputToGraphQL(config)
+		gql, _ := c.graphqlizer.ProvisionRuntimeInputToGraphQL(config, extension)
 		fmt.Println(gql)
 	}
 
 	c.runtimes = append(c.runtimes, runtime{
 		runtimeInput: config,
+		extension:    extension,
 	})
 	c.operations[operationID] = schema.OperationStatus{
 		ID:        &operationID,
@@ -245,12 +254,12 @@ func (c *FakeClient) UpgradeRuntime(accountID, runtimeID string, config schema.U
 	}, nil
 }
 
-func (c *FakeClient) UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput) (schema.OperationStatus, error) {
+func (c *FakeClient) UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput, extension GardenerUpgradeExtension) (schema.OperationStatus, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if c.dumpRequest {
-		upgradeShootIptGQL, _ := c.graphqlizer.UpgradeShootInputToGraphQL(config)
+		upgradeShootIptGQL, _ := c.graphqlizer.UpgradeShootInputToGraphQL(config, extension)
 		fmt.Println(upgradeShootIptGQL)
 	}
 
@@ -262,6 +271,7 @@ func (c *FakeClient) UpgradeShoot(accountID, runtimeID string, config schema.Upg
 		State:     schema.OperationStateInProgress,
 	}
 	c.shootUpgrades[runtimeID] = config
+	c.shootUpgradeExtensions[runtimeID] = extension
 	return schema.OperationStatus{
 		RuntimeID: &runtimeID,
 		ID:        &opId,
@@ -287,7 +297,17 @@ func (c *FakeClient) LastShootUpgrade(runtimeID string) (schema.UpgradeShootInpu
 	return input, found
 }
 
+func (c *FakeClient) LastShootUpgradeExtension(runtimeID string) (GardenerUpgradeExtension, bool) {
+	extension, found := c.shootUpgradeExtensions[runtimeID]
+	return extension, found
+}
+
 func (c *FakeClient) LastProvisioning() schema.ProvisionRuntimeInput {
 	r := c.runtimes[len(c.runtimes)-1]
 	return r.runtimeInput
 }
+
+func (c *FakeClient) LastProvisioningExtension() GardenerConfigExtension {
+	r := c.runtimes[len(c.runtimes)-1]
+	return r.extension
+}
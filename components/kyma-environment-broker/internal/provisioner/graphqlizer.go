@@ -19,13 +19,54 @@ import (
 // Graphqlizer is responsible for converting Go objects to input arguments in graphql format
 type Graphqlizer struct{}
 
-func (g *Graphqlizer) ProvisionRuntimeInputToGraphQL(in gqlschema.ProvisionRuntimeInput) (string, error) {
-	return g.genericToGraphQL(in, `{
+// GardenerConfigExtension holds the GardenerConfigInput fields of the provisioner API which the provisioner module
+// pinned in go.mod does not have yet. They are rendered together with the gqlschema fields, move them to
+// gqlschema.GardenerConfigInput when the module is bumped.
+type GardenerConfigExtension struct {
+	PodsCidr              *string
+	ServicesCidr          *string
+	APIServerAllowedCidrs []string
+}
+
+// GardenerUpgradeExtension holds the GardenerUpgradeInput fields of the provisioner API which the pinned provisioner
+// module does not have yet, see GardenerConfigExtension.
+type GardenerUpgradeExtension struct {
+	// APIServerAllowedCidrs replaces the allowed ranges, nil keeps the current ones and an empty list removes the restriction
+	APIServerAllowedCidrs *[]string
+}
+
+type provisionRuntimeInput struct {
+	gqlschema.ProvisionRuntimeInput
+	Extension GardenerConfigExtension
+}
+
+type clusterConfigInput struct {
+	gqlschema.ClusterConfigInput
+	Extension GardenerConfigExtension
+}
+
+type gardenerConfigInput struct {
+	gqlschema.GardenerConfigInput
+	GardenerConfigExtension
+}
+
+type upgradeShootInput struct {
+	gqlschema.UpgradeShootInput
+	Extension GardenerUpgradeExtension
+}
+
+type gardenerUpgradeInput struct {
+	gqlschema.GardenerUpgradeInput
+	GardenerUpgradeExtension
+}
+
+func (g *Graphqlizer) ProvisionRuntimeInputToGraphQL(in gqlschema.ProvisionRuntimeInput, extension GardenerConfigExtension) (string, error) {
+	return g.genericToGraphQL(provisionRuntimeInput{ProvisionRuntimeInput: in, Extension: extension}, `{
 		{{- if .RuntimeInput }}
 	  	runtimeInput: {{ RuntimeInputToGraphQL .RuntimeInput }},
 		{{- end }}
 		{{- if .ClusterConfig }}
-		clusterConfig: {{ ClusterConfigToGraphQL .ClusterConfig }},
+		clusterConfig: {{ clusterConfigToGraphQL .ClusterConfig .Extension }},
 		{{- end }}
 		{{- if .KymaConfig }}
 		kymaConfig: {{ KymaConfigToGraphQL .KymaConfig }},
@@ -50,9 +91,13 @@ func (g *Graphqlizer) LabelsToGQL(in gqlschema.Labels) (string, error) {
 }
 
 func (g *Graphqlizer) ClusterConfigToGraphQL(in gqlschema.ClusterConfigInput) (string, error) {
-	return g.genericToGraphQL(in, `{
+	return g.clusterConfigToGraphQL(in, GardenerConfigExtension{})
+}
+
+func (g *Graphqlizer) clusterConfigToGraphQL(in gqlschema.ClusterConfigInput, extension GardenerConfigExtension) (string, error) {
+	return g.genericToGraphQL(clusterConfigInput{ClusterConfigInput: in, Extension: extension}, `{
 		{{- if .GardenerConfig }}
-		gardenerConfig: {{ GardenerConfigInputToGraphQL .GardenerConfig }},
+		gardenerConfig: {{ gardenerConfigInputToGraphQL .GardenerConfig .Extension }},
 		{{- end }}
 		{{- if .Administrators }}
 		administrators: {{.Administrators | marshal }},
@@ -61,7 +106,11 @@ func (g *Graphqlizer) ClusterConfigToGraphQL(in gqlschema.ClusterConfigInput) (s
 }
 
 func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigInput) (string, error) {
-	return g.genericToGraphQL(in, `{
+	return g.gardenerConfigInputToGraphQL(in, GardenerConfigExtension{})
+}
+
+func (g *Graphqlizer) gardenerConfigInputToGraphQL(in gqlschema.GardenerConfigInput, extension GardenerConfigExtension) (string, error) {
+	return g.genericToGraphQL(gardenerConfigInput{GardenerConfigInput: in, GardenerConfigExtension: extension}, `{
 		{{- if .Name }}
 		name: "{{.Name}}",
 		{{- end }}
@@ -89,6 +138,12 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 		{{- end }}
 		targetSecret: "{{ .TargetSecret }}",
 		workerCidr: "{{ .WorkerCidr }}",
+		{{- if .PodsCidr }}
+		podsCidr: "{{ .PodsCidr }}",
+		{{- end }}
+		{{- if .ServicesCidr }}
+		servicesCidr: "{{ .ServicesCidr }}",
+		{{- end }}
 		autoScalerMin: {{ .AutoScalerMin }},
 		autoScalerMax: {{ .AutoScalerMax }},
 		maxSurge: {{ .MaxSurge }},
@@ -140,6 +195,9 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 		{{- if .ShootNetworkingFilterDisabled }}
 		shootNetworkingFilterDisabled: {{ .ShootNetworkingFilterDisabled }},
 		{{- end }}
+		{{- if .APIServerAllowedCidrs }}
+		apiServerAllowedCidrs: {{ .APIServerAllowedCidrs | marshal }},
+		{{- end }}
 	}`)
 }
 
@@ -269,7 +327,11 @@ func (g *Graphqlizer) KymaConfigToGraphQL(in gqlschema.KymaConfigInput) (string,
 }
 
 func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgradeInput) (string, error) {
-	return g.genericToGraphQL(in, `{
+	return g.gardenerUpgradeInputToGraphQL(in, GardenerUpgradeExtension{})
+}
+
+func (g *Graphqlizer) gardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgradeInput, extension GardenerUpgradeExtension) (string, error) {
+	return g.genericToGraphQL(gardenerUpgradeInput{GardenerUpgradeInput: in, GardenerUpgradeExtension: extension}, `{
 		{{- if .KubernetesVersion }}
 		kubernetesVersion: "{{.KubernetesVersion}}",
 		{{- end }}
@@ -316,6 +378,9 @@ func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgrade
         {{- if .ShootNetworkingFilterDisabled }}
         shootNetworkingFilterDisabled: {{ .ShootNetworkingFilterDisabled }},
 		{{- end }}
+		{{- with .APIServerAllowedCidrs }}
+		apiServerAllowedCidrs: {{ marshal . }},
+		{{- end }}
 	}`)
 }
 
@@ -357,9 +422,9 @@ func (g *Graphqlizer) UpgradeRuntimeInputToGraphQL(in gqlschema.UpgradeRuntimeIn
 	}`)
 }
 
-func (g Graphqlizer) UpgradeShootInputToGraphQL(in gqlschema.UpgradeShootInput) (string, error) {
-	return g.genericToGraphQL(in, `{
-	gardenerConfig: {{ GardenerUpgradeInputToGraphQL .GardenerConfig }},
+func (g Graphqlizer) UpgradeShootInputToGraphQL(in gqlschema.UpgradeShootInput, extension GardenerUpgradeExtension) (string, error) {
+	return g.genericToGraphQL(upgradeShootInput{UpgradeShootInput: in, Extension: extension}, `{
+	gardenerConfig: {{ gardenerUpgradeInputToGraphQL .GardenerConfig .Extension }},
 	{{- if .Administrators }}
 	administrators: {{.Administrators | marshal }},
 	{{- end }}
@@ -371,9 +436,12 @@ func (g *Graphqlizer) genericToGraphQL(obj interface{}, tmpl string) (string, er
 	fm["marshal"] = g.marshal
 	fm["RuntimeInputToGraphQL"] = g.RuntimeInputToGraphQL
 	fm["ClusterConfigToGraphQL"] = g.ClusterConfigToGraphQL
+	fm["clusterConfigToGraphQL"] = g.clusterConfigToGraphQL
 	fm["KymaConfigToGraphQL"] = g.KymaConfigToGraphQL
 	fm["GardenerConfigInputToGraphQL"] = g.GardenerConfigInputToGraphQL
+	fm["gardenerConfigInputToGraphQL"] = g.gardenerConfigInputToGraphQL
 	fm["GardenerUpgradeInputToGraphQL"] = g.GardenerUpgradeInputToGraphQL
+	fm["gardenerUpgradeInputToGraphQL"] = g.gardenerUpgradeInputToGraphQL
 	fm["AzureProviderConfigInputToGraphQL"] = g.AzureProviderConfigInputToGraphQL
 	fm["GCPProviderConfigInputToGraphQL"] = g.GCPProviderConfigInputToGraphQL
 	fm["AWSProviderConfigInputToGraphQL"] = g.AWSProviderConfigInputToGraphQL
@@ -186,6 +186,65 @@ func Test_GardenerConfigInputToGraphQL(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_GardenerConfigInputToGraphQLWithExtension(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		kubernetesVersion: "1.18",
+		machineType: "Standard_D4_v3",
+		region: "europe",
+		provider: "Azure",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/19",
+		podsCidr: "10.96.0.0/13",
+		servicesCidr: "10.104.0.0/13",
+		autoScalerMin: 2,
+		autoScalerMax: 4,
+		maxSurge: 4,
+		maxUnavailable: 1,
+	}`
+
+	// when
+	got, err := sut.gardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		Region:            "europe",
+		WorkerCidr:        "10.250.0.0/19",
+		Provider:          "Azure",
+		TargetSecret:      "scr",
+		MachineType:       "Standard_D4_v3",
+		KubernetesVersion: "1.18",
+		AutoScalerMin:     2,
+		AutoScalerMax:     4,
+		MaxSurge:          4,
+		MaxUnavailable:    1,
+	}, GardenerConfigExtension{
+		PodsCidr:     ptr.String("10.96.0.0/13"),
+		ServicesCidr: ptr.String("10.104.0.0/13"),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_ProvisionRuntimeInputToGraphQLWithExtension(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+
+	// when
+	got, err := sut.ProvisionRuntimeInputToGraphQL(gqlschema.ProvisionRuntimeInput{
+		ClusterConfig: &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{WorkerCidr: "10.250.0.0/19"},
+		},
+	}, GardenerConfigExtension{PodsCidr: ptr.String("10.96.0.0/13"), APIServerAllowedCidrs: []string{"203.0.113.0/24"}})
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, got, `workerCidr: "10.250.0.0/19",
+		podsCidr: "10.96.0.0/13",`)
+	assert.NotContains(t, got, "servicesCidr")
+	assert.Contains(t, got, `apiServerAllowedCidrs: ["203.0.113.0/24"],`)
+}
+
 func Test_GardenerConfigInputToGraphQLWithOIDC(t *testing.T) {
 	// given
 	sut := Graphqlizer{}
@@ -609,13 +668,41 @@ func Test_UpgradeShootInputToGraphQL(t *testing.T) {
 			ShootNetworkingFilterDisabled: boolPtr(true),
 		},
 		Administrators: []string{"newAdmin@kyma.cx"},
-	})
+	}, GardenerUpgradeExtension{})
 
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, exp, got)
 }
 
+func Test_UpgradeShootInputToGraphQLWithExtension(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	input := gqlschema.UpgradeShootInput{GardenerConfig: &gqlschema.GardenerUpgradeInput{KubernetesVersion: ptr.String("1.25.0")}}
+
+	for name, testCase := range map[string]struct {
+		cidrs    *[]string
+		expected string
+	}{
+		"keep the ranges":    {cidrs: nil, expected: ""},
+		"replace the ranges": {cidrs: &[]string{"203.0.113.0/24", "192.0.2.0/24"}, expected: `apiServerAllowedCidrs: ["203.0.113.0/24","192.0.2.0/24"],`},
+		"remove the ranges":  {cidrs: &[]string{}, expected: `apiServerAllowedCidrs: [],`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := sut.UpgradeShootInputToGraphQL(input, GardenerUpgradeExtension{APIServerAllowedCidrs: testCase.cidrs})
+
+			// then
+			require.NoError(t, err)
+			if testCase.expected == "" {
+				assert.NotContains(t, got, "apiServerAllowedCidrs")
+				return
+			}
+			assert.Contains(t, got, testCase.expected)
+		})
+	}
+}
+
 func TestOpenstack(t *testing.T) {
 	// given
 	input := gqlschema.ProviderSpecificInput{
@@ -19,6 +19,13 @@ type Config struct {
 	SSLRootCert string `envconfig:"optional"`
 
 	SecretKey string `envconfig:"optional"`
+	// EncryptionKeys is the keyring in format <id>:<key>, the data encrypted with any of the keys can be decrypted
+	EncryptionKeys []string `envconfig:"optional"`
+	// EncryptionPrimaryKeyID is the ID of the key from the keyring used to encrypt the data
+	EncryptionPrimaryKeyID string `envconfig:"optional"`
+	// EncryptionWriteLegacy keeps encrypting the data with AES-CFB and the SecretKey until every replica can decrypt AES-GCM,
+	// it must be disabled explicitly once all replicas are rolled out
+	EncryptionWriteLegacy bool `envconfig:"default=true"`
 
 	MaxOpenConns    int           `envconfig:"default=8"`
 	MaxIdleConns    int           `envconfig:"default=2"`
@@ -0,0 +1,19 @@
+package dbmodel
+
+// EncryptedColumn is a column storing encrypted data. The Key is an SQL expression
+// identifying the rows of the Table, it must never be built from the user input.
+type EncryptedColumn struct {
+	Table  string
+	Key    string
+	Column string
+}
+
+func (c EncryptedColumn) String() string {
+	return c.Table + "." + c.Column
+}
+
+// EncryptedDataDTO is the encrypted data stored in a single row
+type EncryptedDataDTO struct {
+	RowKey string
+	Data   string
+}
@@ -0,0 +1,18 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type OperationStepDTO struct {
+	ID          string
+	OperationID string
+	Stage       string
+	Step        string
+	Attempt     int
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	Outcome     string
+	Error       sql.NullString
+}
@@ -1,6 +1,7 @@
 package dbmodel
 
 import (
+	"database/sql"
 	"encoding/json"
 	"time"
 
@@ -18,13 +19,14 @@ type OrchestrationFilter struct {
 }
 
 type OrchestrationDTO struct {
-	OrchestrationID string
-	Type            string
-	State           string
-	Description     string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	Parameters      string
+	OrchestrationID   string
+	Type              string
+	State             string
+	Description       string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Parameters        string
+	ExecutionProgress sql.NullString
 }
 
 func NewOrchestrationDTO(o internal.Orchestration) (OrchestrationDTO, error) {
@@ -42,6 +44,13 @@ func NewOrchestrationDTO(o internal.Orchestration) (OrchestrationDTO, error) {
 		Description:     o.Description,
 		Parameters:      string(params),
 	}
+	if o.Progress != nil {
+		progress, err := json.Marshal(o.Progress)
+		if err != nil {
+			return OrchestrationDTO{}, err
+		}
+		dto.ExecutionProgress = sql.NullString{String: string(progress), Valid: true}
+	}
 	return dto, nil
 }
 
@@ -51,6 +60,13 @@ func (o *OrchestrationDTO) ToOrchestration() (internal.Orchestration, error) {
 	if err != nil {
 		return internal.Orchestration{}, err
 	}
+	var progress *orchestration.ExecutionProgress
+	if o.ExecutionProgress.Valid {
+		progress = &orchestration.ExecutionProgress{}
+		if err := json.Unmarshal([]byte(o.ExecutionProgress.String), progress); err != nil {
+			return internal.Orchestration{}, err
+		}
+	}
 	return internal.Orchestration{
 		OrchestrationID: o.OrchestrationID,
 		Type:            orchestration.Type(o.Type),
@@ -59,5 +75,6 @@ func (o *OrchestrationDTO) ToOrchestration() (internal.Orchestration, error) {
 		CreatedAt:       o.CreatedAt,
 		UpdatedAt:       o.UpdatedAt,
 		Parameters:      params,
+		Progress:        progress,
 	}, nil
 }
@@ -4,6 +4,12 @@ import (
 	"time"
 )
 
+// RuntimeKymaVersionDTO holds the Kyma version of the latest runtime state of a runtime
+type RuntimeKymaVersionDTO struct {
+	RuntimeID   string
+	KymaVersion string
+}
+
 type RuntimeStateDTO struct {
 	ID string `json:"id"`
 
@@ -0,0 +1,22 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type WebhookDeliveryDTO struct {
+	ID             string
+	EventID        string
+	EventType      string
+	Subscriber     string
+	Payload        string
+	State          string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      sql.NullString
+	LeaseOwner     sql.NullString
+	LeaseExpiresAt sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
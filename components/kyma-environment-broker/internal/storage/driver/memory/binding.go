@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type bindings struct {
+	mu sync.Mutex
+
+	data map[string]internal.Binding
+}
+
+func NewBindings() *bindings {
+	return &bindings{
+		data: make(map[string]internal.Binding, 0),
+	}
+}
+
+func (s *bindings) Insert(binding *internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := bindingKey(binding.InstanceID, binding.ID)
+	if _, found := s.data[key]; found {
+		return dberr.AlreadyExists("binding with id %s already exist", binding.ID)
+	}
+	s.data[key] = *binding
+
+	return nil
+}
+
+func (s *bindings) Get(instanceID, bindingID string) (*internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding, found := s.data[bindingKey(instanceID, bindingID)]
+	if !found {
+		return nil, dberr.NotFound("binding with id %s does not exist", bindingID)
+	}
+
+	return &binding, nil
+}
+
+func (s *bindings) Delete(instanceID, bindingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.data, bindingKey(instanceID, bindingID))
+	return nil
+}
+
+func (s *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.Binding, 0)
+	for _, binding := range s.data {
+		if binding.InstanceID == instanceID {
+			result = append(result, binding)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func bindingKey(instanceID, bindingID string) string {
+	return instanceID + "/" + bindingID
+}
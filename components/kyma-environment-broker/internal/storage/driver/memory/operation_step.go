@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type operationSteps struct {
+	mu sync.Mutex
+
+	data map[string][]internal.OperationStep
+}
+
+func NewOperationSteps() *operationSteps {
+	return &operationSteps{
+		data: make(map[string][]internal.OperationStep, 0),
+	}
+}
+
+func (s *operationSteps) Insert(step internal.OperationStep) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data[step.OperationID] = append(s.data[step.OperationID], step)
+	return nil
+}
+
+func (s *operationSteps) GetLast(operationID, stage, step string) (internal.OperationStep, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var last *internal.OperationStep
+	for i, existing := range s.data[operationID] {
+		if existing.Stage == stage && existing.Step == step && (last == nil || existing.Attempt > last.Attempt) {
+			last = &s.data[operationID][i]
+		}
+	}
+	if last == nil {
+		return internal.OperationStep{}, dberr.NotFound("step %s/%s of operation %s not found", stage, step, operationID)
+	}
+	return *last, nil
+}
+
+func (s *operationSteps) ListByOperationID(operationID string) ([]internal.OperationStep, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	steps := make([]internal.OperationStep, len(s.data[operationID]))
+	copy(steps, s.data[operationID])
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].StartedAt.Before(steps[j].StartedAt)
+	})
+	return steps, nil
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type queueEntry struct {
+	queue          string
+	nextRunAt      *time.Time
+	leaseOwner     string
+	leaseExpiresAt time.Time
+}
+
+type operationsQueue struct {
+	mu sync.Mutex
+
+	entries map[string]*queueEntry
+}
+
+func NewOperationsQueue() *operationsQueue {
+	return &operationsQueue{
+		entries: make(map[string]*queueEntry, 0),
+	}
+}
+
+func (s *operationsQueue) Schedule(queue, operationID string, runAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[operationID]
+	if !found {
+		entry = &queueEntry{}
+		s.entries[operationID] = entry
+	}
+	entry.queue = queue
+	entry.nextRunAt = earliest(entry.nextRunAt, runAt)
+
+	return nil
+}
+
+func (s *operationsQueue) ScheduleIfIdle(queue, operationID string, runAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[operationID]
+	if !found {
+		entry = &queueEntry{}
+		s.entries[operationID] = entry
+	}
+	if entry.leaseOwner != "" || entry.nextRunAt != nil {
+		return nil
+	}
+	entry.queue = queue
+	entry.nextRunAt = &runAt
+
+	return nil
+}
+
+func (s *operationsQueue) Lease(queue, owner string, leaseDuration time.Duration, limit int) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var due []string
+	for id, entry := range s.entries {
+		if entry.queue != queue {
+			continue
+		}
+		if entry.leaseOwner == "" && entry.nextRunAt != nil && !entry.nextRunAt.After(now) ||
+			entry.leaseOwner != "" && entry.leaseExpiresAt.Before(now) {
+			due = append(due, id)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		a, b := s.entries[due[i]].nextRunAt, s.entries[due[j]].nextRunAt
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	for _, id := range due {
+		entry := s.entries[id]
+		entry.leaseOwner = owner
+		entry.leaseExpiresAt = now.Add(leaseDuration)
+		entry.nextRunAt = nil
+	}
+
+	return due, nil
+}
+
+func (s *operationsQueue) ExtendLeases(owner string, operationIDs []string, leaseDuration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := time.Now().Add(leaseDuration)
+	for _, id := range operationIDs {
+		if entry, found := s.entries[id]; found && entry.leaseOwner == owner {
+			entry.leaseExpiresAt = expiresAt
+		}
+	}
+
+	return nil
+}
+
+func (s *operationsQueue) Reschedule(operationID, owner string, runAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[operationID]
+	if !found || entry.leaseOwner != owner {
+		return nil
+	}
+	entry.nextRunAt = earliest(entry.nextRunAt, runAt)
+	entry.leaseOwner = ""
+	entry.leaseExpiresAt = time.Time{}
+
+	return nil
+}
+
+func (s *operationsQueue) Release(operationID, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[operationID]
+	if !found || entry.leaseOwner != owner {
+		return nil
+	}
+	entry.leaseOwner = ""
+	entry.leaseExpiresAt = time.Time{}
+
+	return nil
+}
+
+func earliest(current *time.Time, candidate time.Time) *time.Time {
+	if current != nil && current.Before(candidate) {
+		return current
+	}
+	return &candidate
+}
@@ -90,6 +90,20 @@ func (s *runtimeState) GetLatestWithKymaVersionByRuntimeID(runtimeID string) (in
 	return internal.RuntimeState{}, dberr.NotFound("runtime state with Reconciler input for runtime with ID: %s not found", runtimeID)
 }
 
+func (s *runtimeState) ListLatestKymaVersions(runtimeIDs []string) (map[string]string, error) {
+	versions := make(map[string]string, len(runtimeIDs))
+	for _, runtimeID := range runtimeIDs {
+		state, err := s.GetLatestWithKymaVersionByRuntimeID(runtimeID)
+		switch {
+		case err == nil:
+			versions[runtimeID] = state.GetKymaVersion()
+		case !dberr.IsNotFound(err):
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
 func (s *runtimeState) GetLatestWithReconcilerInputByRuntimeID(runtimeID string) (internal.RuntimeState, error) {
 	states, err := s.getRuntimeStatesByRuntimeID(runtimeID)
 	if err != nil {
@@ -70,3 +70,29 @@ func Test_runtimeState_GetLatestWithReconcilerInputByRuntimeID(t *testing.T) {
 	// then
 	assert.Equal(t, expectedRuntimeState.ID, gotRuntimeState.ID)
 }
+
+func Test_runtimeState_ListLatestKymaVersions(t *testing.T) {
+	// given
+	runtimeStates := NewRuntimeStates()
+
+	olderRuntimeState := fixture.FixRuntimeState("older", "runtime1", uuid.NewString())
+	olderRuntimeState.KymaVersion = "2.1.0"
+
+	newerRuntimeState := fixture.FixRuntimeState("newer", "runtime1", uuid.NewString())
+	newerRuntimeState.KymaVersion = "2.2.0"
+	newerRuntimeState.CreatedAt = newerRuntimeState.CreatedAt.Add(time.Hour * 1)
+
+	otherRuntimeState := fixture.FixRuntimeState("other", "runtime2", uuid.NewString())
+	otherRuntimeState.ClusterSetup = &reconcilerApi.Cluster{RuntimeID: "runtime2", KymaConfig: reconcilerApi.KymaConfig{Version: "2.3.0"}}
+
+	runtimeStates.Insert(olderRuntimeState)
+	runtimeStates.Insert(newerRuntimeState)
+	runtimeStates.Insert(otherRuntimeState)
+
+	// when
+	gotVersions, err := runtimeStates.ListLatestKymaVersions([]string{"runtime1", "runtime2", "runtime3"})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"runtime1": "2.2.0", "runtime2": "2.3.0"}, gotVersions)
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type webhookDeliveryEntry struct {
+	delivery       internal.WebhookDelivery
+	leaseOwner     string
+	leaseExpiresAt time.Time
+}
+
+type webhookDeliveries struct {
+	mu sync.Mutex
+
+	entries map[string]*webhookDeliveryEntry
+}
+
+func NewWebhookDeliveries() *webhookDeliveries {
+	return &webhookDeliveries{
+		entries: make(map[string]*webhookDeliveryEntry, 0),
+	}
+}
+
+func (s *webhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.entries[delivery.ID]; found {
+		return dberr.AlreadyExists("webhook delivery with id %s already exist", delivery.ID)
+	}
+	s.entries[delivery.ID] = &webhookDeliveryEntry{delivery: delivery}
+
+	return nil
+}
+
+func (s *webhookDeliveries) Lease(owner string, leaseDuration time.Duration, limit int) ([]internal.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var due []*webhookDeliveryEntry
+	for _, entry := range s.entries {
+		if entry.delivery.State != internal.WebhookDeliveryPending || entry.delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		if entry.leaseOwner == "" || entry.leaseExpiresAt.Before(now) {
+			due = append(due, entry)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].delivery.NextAttemptAt.Before(due[j].delivery.NextAttemptAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	deliveries := make([]internal.WebhookDelivery, 0, len(due))
+	for _, entry := range due {
+		entry.leaseOwner = owner
+		entry.leaseExpiresAt = now.Add(leaseDuration)
+		deliveries = append(deliveries, entry.delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (s *webhookDeliveries) Update(delivery internal.WebhookDelivery, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[delivery.ID]
+	if !found || entry.leaseOwner != owner {
+		return dberr.Conflict("webhook delivery %s is not leased by %s", delivery.ID, owner)
+	}
+	entry.delivery = delivery
+	entry.leaseOwner = ""
+	entry.leaseExpiresAt = time.Time{}
+
+	return nil
+}
+
+func (s *webhookDeliveries) ListByState(state internal.WebhookDeliveryState, limit int) ([]internal.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []internal.WebhookDelivery
+	for _, entry := range s.entries {
+		if entry.delivery.State == state {
+			deliveries = append(deliveries, entry.delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].UpdatedAt.After(deliveries[j].UpdatedAt)
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	return deliveries, nil
+}
+
+func (s *webhookDeliveries) DeleteDelivered(until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, entry := range s.entries {
+		if entry.delivery.State == internal.WebhookDeliveryDelivered && !entry.delivery.UpdatedAt.After(until) {
+			delete(s.entries, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+// EncryptedData gives access to the raw encrypted columns, it is used to re-encrypt the data with a new key
+type EncryptedData struct {
+	postsql.Factory
+}
+
+func NewEncryptedData(sess postsql.Factory) *EncryptedData {
+	return &EncryptedData{
+		Factory: sess,
+	}
+}
+
+func (s *EncryptedData) List(column dbmodel.EncryptedColumn, afterKey string, limit int) ([]dbmodel.EncryptedDataDTO, error) {
+	data, err := s.NewReadSession().ListEncryptedData(column, afterKey, limit)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *EncryptedData) Count(column dbmodel.EncryptedColumn) (int, error) {
+	count, err := s.NewReadSession().CountEncryptedData(column)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Update replaces the data of the row, a conflict error is returned if the row was changed in the meantime
+func (s *EncryptedData) Update(column dbmodel.EncryptedColumn, rowKey, oldData, newData string) error {
+	err := s.NewWriteSession().UpdateEncryptedData(column, rowKey, oldData, newData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type operationSteps struct {
+	postsql.Factory
+}
+
+func NewOperationSteps(sess postsql.Factory) *operationSteps {
+	return &operationSteps{
+		Factory: sess,
+	}
+}
+
+func (s *operationSteps) Insert(step internal.OperationStep) error {
+	sess := s.NewWriteSession()
+	return sess.InsertOperationStep(toOperationStepDTO(step))
+}
+
+// GetLast returns the journal entry of the latest attempt of the step
+func (s *operationSteps) GetLast(operationID, stage, step string) (internal.OperationStep, error) {
+	sess := s.NewReadSession()
+	dto, err := sess.GetLastOperationStep(operationID, stage, step)
+	if err != nil {
+		return internal.OperationStep{}, err
+	}
+	return toOperationStep(dto), nil
+}
+
+func (s *operationSteps) ListByOperationID(operationID string) ([]internal.OperationStep, error) {
+	sess := s.NewReadSession()
+	dtos, err := sess.ListOperationSteps(operationID)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]internal.OperationStep, 0, len(dtos))
+	for _, dto := range dtos {
+		steps = append(steps, toOperationStep(dto))
+	}
+	return steps, nil
+}
+
+func toOperationStepDTO(step internal.OperationStep) dbmodel.OperationStepDTO {
+	return dbmodel.OperationStepDTO{
+		ID:          step.ID,
+		OperationID: step.OperationID,
+		Stage:       step.Stage,
+		Step:        step.Step,
+		Attempt:     step.Attempt,
+		StartedAt:   step.StartedAt,
+		FinishedAt:  step.FinishedAt,
+		Outcome:     string(step.Outcome),
+		Error:       storage.StringToSQLNullString(step.Error),
+	}
+}
+
+func toOperationStep(dto dbmodel.OperationStepDTO) internal.OperationStep {
+	return internal.OperationStep{
+		ID:          dto.ID,
+		OperationID: dto.OperationID,
+		Stage:       dto.Stage,
+		Step:        dto.Step,
+		Attempt:     dto.Attempt,
+		StartedAt:   dto.StartedAt,
+		FinishedAt:  dto.FinishedAt,
+		Outcome:     operation.StepOutcome(dto.Outcome),
+		Error:       storage.SQLNullStringToString(dto.Error),
+	}
+}
@@ -0,0 +1,63 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type operationsQueue struct {
+	postsql.Factory
+}
+
+func NewOperationsQueue(sess postsql.Factory) *operationsQueue {
+	return &operationsQueue{
+		Factory: sess,
+	}
+}
+
+// Schedule sets the time when the operation should be processed by the given queue.
+// If the operation is already scheduled earlier, the earlier time is kept.
+func (s *operationsQueue) Schedule(queue, operationID string, runAt time.Time) error {
+	sess := s.NewWriteSession()
+	return sess.ScheduleOperation(queue, operationID, runAt)
+}
+
+// ScheduleIfIdle schedules the operation only if it is neither leased nor scheduled, so it does not disturb
+// the operations which are processed or waiting for a retry
+func (s *operationsQueue) ScheduleIfIdle(queue, operationID string, runAt time.Time) error {
+	sess := s.NewWriteSession()
+	return sess.ScheduleIdleOperation(queue, operationID, runAt)
+}
+
+// Lease claims at most limit operations which are due to be processed by the given queue
+func (s *operationsQueue) Lease(queue, owner string, leaseDuration time.Duration, limit int) ([]string, error) {
+	sess := s.NewWriteSession()
+	now := time.Now()
+	ids, err := sess.LeaseOperations(queue, owner, now, now.Add(leaseDuration), limit)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ExtendLeases prolongs the leases of the operations which are still processed by the owner
+func (s *operationsQueue) ExtendLeases(owner string, operationIDs []string, leaseDuration time.Duration) error {
+	if len(operationIDs) == 0 {
+		return nil
+	}
+	sess := s.NewWriteSession()
+	return sess.ExtendOperationLeases(owner, operationIDs, time.Now().Add(leaseDuration))
+}
+
+// Reschedule releases the lease and schedules the next processing of the operation
+func (s *operationsQueue) Reschedule(operationID, owner string, runAt time.Time) error {
+	sess := s.NewWriteSession()
+	return sess.RescheduleOperation(operationID, owner, runAt)
+}
+
+// Release releases the lease without scheduling the next processing of the operation
+func (s *operationsQueue) Release(operationID, owner string) error {
+	sess := s.NewWriteSession()
+	return sess.ReleaseOperation(operationID, owner)
+}
@@ -0,0 +1,101 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationsQueue(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("Operations queue - lease, heartbeat and release", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		for _, id := range []string{"op-1", "op-2", "op-3"} {
+			operation := fixture.FixOperation(id, "inst-"+id, internal.OperationTypeProvision)
+			operation.InputCreator = nil
+			require.NoError(t, brokerStorage.Operations().InsertOperation(operation))
+		}
+		queue := brokerStorage.OperationsQueue()
+
+		// due operations are leased once, the operation scheduled in the future is not leased
+		require.NoError(t, queue.Schedule("provisioning", "op-1", time.Now().Add(-time.Minute)))
+		require.NoError(t, queue.Schedule("provisioning", "op-2", time.Now().Add(time.Hour)))
+		ids, err := queue.Lease("provisioning", "owner-1", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-1"}, ids)
+		ids, err = queue.Lease("provisioning", "owner-2", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		// the earlier schedule wins
+		require.NoError(t, queue.Schedule("provisioning", "op-2", time.Now().Add(-time.Minute)))
+		require.NoError(t, queue.Schedule("provisioning", "op-2", time.Now().Add(time.Hour)))
+		ids, err = queue.Lease("provisioning", "owner-2", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-2"}, ids)
+
+		// an expired lease is taken over by another owner, unless it is extended by the heartbeat
+		require.NoError(t, queue.Schedule("provisioning", "op-3", time.Now().Add(-time.Minute)))
+		ids, err = queue.Lease("provisioning", "owner-1", 100*time.Millisecond, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-3"}, ids)
+		require.NoError(t, queue.ExtendLeases("owner-1", []string{"op-3"}, time.Minute))
+		time.Sleep(200 * time.Millisecond)
+		ids, err = queue.Lease("provisioning", "owner-2", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		// the heartbeat of another owner does not extend the lease
+		require.NoError(t, queue.Reschedule("op-3", "owner-1", time.Now().Add(-time.Minute)))
+		ids, err = queue.Lease("provisioning", "owner-1", 100*time.Millisecond, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-3"}, ids)
+		require.NoError(t, queue.ExtendLeases("owner-2", []string{"op-3"}, time.Minute))
+		time.Sleep(200 * time.Millisecond)
+		ids, err = queue.Lease("provisioning", "owner-2", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-3"}, ids)
+
+		// the operations which are leased or scheduled are not disturbed when resumed at startup
+		require.NoError(t, queue.ScheduleIfIdle("provisioning", "op-3", time.Now().Add(-time.Minute)))
+		require.NoError(t, queue.Release("op-3", "owner-2"))
+		ids, err = queue.Lease("provisioning", "owner-1", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		// the idle operation is scheduled when resumed
+		require.NoError(t, queue.ScheduleIfIdle("provisioning", "op-3", time.Now().Add(-time.Minute)))
+		ids, err = queue.Lease("provisioning", "owner-1", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-3"}, ids)
+
+		// the lease is released only by its owner
+		require.NoError(t, queue.Release("op-1", "owner-2"))
+		require.NoError(t, queue.Reschedule("op-1", "owner-2", time.Now().Add(-time.Minute)))
+		ids, err = queue.Lease("provisioning", "owner-2", time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
@@ -182,6 +182,29 @@ func (s *runtimeState) GetLatestWithKymaVersionByRuntimeID(runtimeID string) (in
 	return internal.RuntimeState{}, fmt.Errorf("failed to find RuntimeState with kyma version for runtime %s ", runtimeID)
 }
 
+func (s *runtimeState) ListLatestKymaVersions(runtimeIDs []string) (map[string]string, error) {
+	sess := s.NewReadSession()
+	var dtos []dbmodel.RuntimeKymaVersionDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListLatestRuntimeKymaVersions(runtimeIDs)
+		if lastErr != nil {
+			log.Errorf("while listing kyma versions of runtimes: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	versions := make(map[string]string, len(dtos))
+	for _, dto := range dtos {
+		versions[dto.RuntimeID] = dto.KymaVersion
+	}
+	return versions, nil
+}
+
 func (s *runtimeState) GetLatestWithOIDCConfigByRuntimeID(runtimeID string) (internal.RuntimeState, error) {
 	sess := s.NewReadSession()
 	var state dbmodel.RuntimeStateDTO
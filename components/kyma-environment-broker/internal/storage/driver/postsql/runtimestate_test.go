@@ -284,6 +284,10 @@ func TestRuntimeState(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, runtimeStatePlainVersion.ID, gotRuntimeState.ID)
 		assert.Equal(t, "2.1.55", gotRuntimeState.GetKymaVersion())
+
+		gotVersions, err := storage.ListLatestKymaVersions([]string{fixRuntimeID, "runtime-without-states"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{fixRuntimeID: "2.1.55"}, gotVersions)
 	})
 
 	t.Run("should fetch latest RuntimeState with OIDC config", func(t *testing.T) {
@@ -0,0 +1,126 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type webhookDeliveries struct {
+	postsql.Factory
+}
+
+func NewWebhookDeliveries(sess postsql.Factory) *webhookDeliveries {
+	return &webhookDeliveries{
+		Factory: sess,
+	}
+}
+
+func (s *webhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.InsertWebhookDelivery(toWebhookDeliveryDTO(delivery))
+		if lastErr != nil {
+			if dberr.IsAlreadyExists(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while inserting webhook delivery ID %s: %v", delivery.ID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+// Lease claims at most limit pending deliveries which are due to be sent
+func (s *webhookDeliveries) Lease(owner string, leaseDuration time.Duration, limit int) ([]internal.WebhookDelivery, error) {
+	sess := s.NewWriteSession()
+	now := time.Now()
+	dtos, err := sess.LeaseWebhookDeliveries(string(internal.WebhookDeliveryPending), owner, now, now.Add(leaseDuration), limit)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveries(dtos), nil
+}
+
+// Update stores the result of the delivery attempt and releases the lease,
+// a conflict error is returned if the delivery is not leased by the owner anymore
+func (s *webhookDeliveries) Update(delivery internal.WebhookDelivery, owner string) error {
+	sess := s.NewWriteSession()
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		lastErr = sess.UpdateWebhookDelivery(toWebhookDeliveryDTO(delivery), owner)
+		if lastErr != nil {
+			if dberr.IsConflict(lastErr) {
+				return false, lastErr
+			}
+			log.Errorf("while updating webhook delivery ID %s: %v", delivery.ID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return lastErr
+	}
+	return nil
+}
+
+func (s *webhookDeliveries) ListByState(state internal.WebhookDeliveryState, limit int) ([]internal.WebhookDelivery, error) {
+	sess := s.NewReadSession()
+	dtos, err := sess.ListWebhookDeliveriesByState(string(state), limit)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveries(dtos), nil
+}
+
+func (s *webhookDeliveries) DeleteDelivered(until time.Time) error {
+	sess := s.NewWriteSession()
+	return sess.DeleteWebhookDeliveries(string(internal.WebhookDeliveryDelivered), until)
+}
+
+func toWebhookDeliveryDTO(delivery internal.WebhookDelivery) dbmodel.WebhookDeliveryDTO {
+	return dbmodel.WebhookDeliveryDTO{
+		ID:            delivery.ID,
+		EventID:       delivery.EventID,
+		EventType:     delivery.EventType,
+		Subscriber:    delivery.Subscriber,
+		Payload:       delivery.Payload,
+		State:         string(delivery.State),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     storage.StringToSQLNullString(delivery.LastError),
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveries(dtos []dbmodel.WebhookDeliveryDTO) []internal.WebhookDelivery {
+	deliveries := make([]internal.WebhookDelivery, 0, len(dtos))
+	for _, dto := range dtos {
+		deliveries = append(deliveries, internal.WebhookDelivery{
+			ID:            dto.ID,
+			EventID:       dto.EventID,
+			EventType:     dto.EventType,
+			Subscriber:    dto.Subscriber,
+			Payload:       dto.Payload,
+			State:         internal.WebhookDeliveryState(dto.State),
+			Attempts:      dto.Attempts,
+			NextAttemptAt: dto.NextAttemptAt,
+			LastError:     storage.SQLNullStringToString(dto.LastError),
+			CreatedAt:     dto.CreatedAt,
+			UpdatedAt:     dto.UpdatedAt,
+		})
+	}
+	return deliveries
+}
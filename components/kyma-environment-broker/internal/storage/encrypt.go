@@ -7,20 +7,185 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 )
 
+const (
+	// DefaultKeyID identifies the SecretKey in the keyring
+	DefaultKeyID = "default"
+
+	// gcmPrefix starts the header of the ciphertexts encrypted with AES-GCM, the header is followed by
+	// the ID of the key. Ciphertexts written before the keyring are plain base64 and never contain ':'.
+	gcmPrefix       = "gcm:"
+	headerSeparator = ":"
+)
+
+// NewEncrypter creates the Encrypter with a single key used to encrypt and decrypt the data
 func NewEncrypter(secretKey string) *Encrypter {
-	return &Encrypter{key: []byte(secretKey)}
+	return &Encrypter{
+		keys:         map[string][]byte{DefaultKeyID: []byte(secretKey)},
+		primaryKeyID: DefaultKeyID,
+		legacyKey:    []byte(secretKey),
+	}
+}
+
+// NewKeyringEncrypter creates the Encrypter which decrypts the data with any of the given keys and encrypts
+// the data with the primary key. The legacy key decrypts the data written before the keys had IDs.
+func NewKeyringEncrypter(keys map[string]string, primaryKeyID, legacyKey string) (*Encrypter, error) {
+	e := &Encrypter{
+		keys:         make(map[string][]byte, len(keys)),
+		primaryKeyID: primaryKeyID,
+		legacyKey:    []byte(legacyKey),
+	}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, headerSeparator) {
+			return nil, fmt.Errorf("invalid encryption key ID %q", id)
+		}
+		if _, err := aes.NewCipher([]byte(key)); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %s: %w", id, err)
+		}
+		e.keys[id] = []byte(key)
+	}
+	if _, found := e.keys[primaryKeyID]; !found {
+		return nil, fmt.Errorf("primary encryption key %q is not configured", primaryKeyID)
+	}
+	return e, nil
+}
+
+// NewEncrypterFromConfig creates the keyring from the EncryptionKeys, the SecretKey is the only key when
+// no EncryptionKeys are configured. Otherwise the SecretKey is kept in the keyring under the DefaultKeyID
+// so that the data encrypted before the rotation can still be decrypted. With EncryptionWriteLegacy
+// the data is still encrypted in the legacy format with the SecretKey.
+func NewEncrypterFromConfig(cfg Config) (*Encrypter, error) {
+	e, err := newEncrypterFromKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.EncryptionWriteLegacy {
+		if _, err := aes.NewCipher(e.legacyKey); err != nil {
+			return nil, fmt.Errorf("invalid secret key required by the legacy encryption: %w", err)
+		}
+		e.writeLegacy = true
+	}
+	return e, nil
+}
+
+func newEncrypterFromKeys(cfg Config) (*Encrypter, error) {
+	if len(cfg.EncryptionKeys) == 0 {
+		return NewEncrypter(cfg.SecretKey), nil
+	}
+
+	keys := make(map[string]string, len(cfg.EncryptionKeys)+1)
+	for _, entry := range cfg.EncryptionKeys {
+		id, key, found := strings.Cut(entry, headerSeparator)
+		if !found {
+			return nil, fmt.Errorf("encryption key must be in format <id>:<key>")
+		}
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("encryption key %s is configured more than once", id)
+		}
+		keys[id] = key
+	}
+	if _, exists := keys[DefaultKeyID]; !exists && cfg.SecretKey != "" {
+		keys[DefaultKeyID] = cfg.SecretKey
+	}
+
+	return NewKeyringEncrypter(keys, cfg.EncryptionPrimaryKeyID, cfg.SecretKey)
 }
 
 type Encrypter struct {
-	key []byte
+	keys         map[string][]byte
+	primaryKeyID string
+	legacyKey    []byte
+	// writeLegacy encrypts the data in the legacy format, which can be decrypted by the replicas without the keyring
+	writeLegacy bool
 }
 
+// PrimaryKeyID returns the ID of the key used to encrypt the data
+func (e *Encrypter) PrimaryKeyID() string {
+	return e.primaryKeyID
+}
+
+// WritesLegacy returns true if the data is encrypted in the legacy format
+func (e *Encrypter) WritesLegacy() bool {
+	return e.writeLegacy
+}
+
+// Encrypt encrypts the object with AES-GCM using the primary key, the ID of the key is
+// stored in the header of the ciphertext and is authenticated together with the data.
+// The object is encrypted with AES-CFB using the legacy key when the legacy writes are enabled.
 func (e *Encrypter) Encrypt(obj []byte) ([]byte, error) {
-	block, err := aes.NewCipher(e.key)
+	if e.writeLegacy {
+		return e.encryptLegacy(obj)
+	}
+	aead, err := newGCM(e.keys[e.primaryKeyID])
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	header := gcmHeader(e.primaryKeyID)
+	sealed := aead.Seal(nonce, nonce, obj, []byte(header))
+
+	return []byte(header + base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// Decrypt decrypts the object with the key given in its header, the objects without
+// the header were encrypted with AES-CFB using the legacy key
+func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
+	keyID, found := KeyID(obj)
+	if !found {
+		return e.decryptLegacy(obj)
+	}
+	key, found := e.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("encryption key %s is not configured", keyID)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := gcmHeader(keyID)
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(string(obj), header))
+	if err != nil {
+		return nil, fmt.Errorf("while decoding input object: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("cipher text is too short")
+	}
+	data, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(header))
+	if err != nil {
+		return nil, fmt.Errorf("while decrypting object with key %s: %w", keyID, err)
+	}
+	return data, nil
+}
+
+// NeedsReencryption returns true if the object was not encrypted with the primary key,
+// nothing is re-encrypted while the legacy writes are enabled
+func (e *Encrypter) NeedsReencryption(obj []byte) bool {
+	if len(obj) == 0 || e.writeLegacy {
+		return false
+	}
+	keyID, found := KeyID(obj)
+	return !found || keyID != e.primaryKeyID
+}
+
+// KeyID returns the ID of the key the object was encrypted with, false is returned for the legacy ciphertexts
+func KeyID(obj []byte) (string, bool) {
+	if !strings.HasPrefix(string(obj), gcmPrefix) {
+		return "", false
+	}
+	keyID, _, found := strings.Cut(strings.TrimPrefix(string(obj), gcmPrefix), headerSeparator)
+	return keyID, found
+}
+
+func (e *Encrypter) encryptLegacy(obj []byte) ([]byte, error) {
+	block, err := aes.NewCipher(e.legacyKey)
 	if err != nil {
 		return nil, err
 	}
@@ -32,16 +197,15 @@ func (e *Encrypter) Encrypt(obj []byte) ([]byte, error) {
 	}
 	cfb := cipher.NewCFBEncrypter(block, iv)
 	cfb.XORKeyStream(bytes[aes.BlockSize:], []byte(b))
-
 	return []byte(base64.StdEncoding.EncodeToString(bytes)), nil
 }
 
-func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
+func (e *Encrypter) decryptLegacy(obj []byte) ([]byte, error) {
 	obj, err := base64.StdEncoding.DecodeString(string(obj))
 	if err != nil {
 		return nil, fmt.Errorf("while decoding input object: %w", err)
 	}
-	block, err := aes.NewCipher(e.key)
+	block, err := aes.NewCipher(e.legacyKey)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +223,18 @@ func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
 	return data, nil
 }
 
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func gcmHeader(keyID string) string {
+	return gcmPrefix + keyID + headerSeparator
+}
+
 func (e *Encrypter) EncryptSMCreds(provisioningParameters *internal.ProvisioningParameters) error {
 	if provisioningParameters.ErsContext.SMOperatorCredentials == nil {
 		return nil
@@ -1,12 +1,18 @@
 package storage
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"k8s.io/apimachinery/pkg/util/rand"
+	k8srand "k8s.io/apimachinery/pkg/util/rand"
 )
 
 func TestNewEncrypter(t *testing.T) {
@@ -16,7 +22,7 @@ func TestNewEncrypter(t *testing.T) {
 	}
 
 	t.Run("success json", func(t *testing.T) {
-		secretKey := rand.String(32)
+		secretKey := k8srand.String(32)
 
 		e := NewEncrypter(secretKey)
 		dto := testDto{
@@ -39,7 +45,7 @@ func TestNewEncrypter(t *testing.T) {
 	})
 
 	t.Run("success string", func(t *testing.T) {
-		secretKey := rand.String(32)
+		secretKey := k8srand.String(32)
 
 		e := NewEncrypter(secretKey)
 		dto := []byte("test")
@@ -70,3 +76,167 @@ func TestNewEncrypter(t *testing.T) {
 	})
 
 }
+
+func TestKeyringEncrypter(t *testing.T) {
+	oldKey := k8srand.String(32)
+	newKey := k8srand.String(32)
+	data := []byte("kubeconfig")
+
+	t.Run("should decrypt with any key and encrypt with the primary one", func(t *testing.T) {
+		// given
+		old, err := NewKeyringEncrypter(map[string]string{"old": oldKey}, "old", "")
+		require.NoError(t, err)
+		rotated, err := NewKeyringEncrypter(map[string]string{"old": oldKey, "new": newKey}, "new", "")
+		require.NoError(t, err)
+
+		encryptedWithOld, err := old.Encrypt(data)
+		require.NoError(t, err)
+
+		// when
+		decrypted, err := rotated.Decrypt(encryptedWithOld)
+		require.NoError(t, err)
+		encryptedWithNew, err := rotated.Encrypt(decrypted)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, data, decrypted)
+		keyID, found := KeyID(encryptedWithNew)
+		assert.True(t, found)
+		assert.Equal(t, "new", keyID)
+		assert.True(t, rotated.NeedsReencryption(encryptedWithOld))
+		assert.False(t, rotated.NeedsReencryption(encryptedWithNew))
+		assert.False(t, rotated.NeedsReencryption(nil))
+
+		_, err = old.Decrypt(encryptedWithNew)
+		assert.Error(t, err)
+	})
+
+	t.Run("should decrypt the legacy cipher text", func(t *testing.T) {
+		// given
+		e, err := NewKeyringEncrypter(map[string]string{"new": newKey}, "new", oldKey)
+		require.NoError(t, err)
+		legacy := encryptLegacy(t, oldKey, data)
+
+		// when
+		decrypted, err := e.Decrypt(legacy)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted)
+		assert.True(t, e.NeedsReencryption(legacy))
+	})
+
+	t.Run("should reject tampered cipher text", func(t *testing.T) {
+		// given
+		e, err := NewKeyringEncrypter(map[string]string{"old": oldKey, "new": newKey}, "new", "")
+		require.NoError(t, err)
+		encrypted, err := e.Encrypt(data)
+		require.NoError(t, err)
+
+		// when
+		_, err = e.Decrypt([]byte(strings.Replace(string(encrypted), "gcm:new:", "gcm:old:", 1)))
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail on unknown key", func(t *testing.T) {
+		_, err := NewEncrypter(oldKey).Decrypt([]byte("gcm:unknown:AAAA"))
+		assert.EqualError(t, err, "encryption key unknown is not configured")
+	})
+
+	t.Run("should validate the keyring", func(t *testing.T) {
+		_, err := NewKeyringEncrypter(map[string]string{"new": newKey}, "old", "")
+		assert.Error(t, err)
+		_, err = NewKeyringEncrypter(map[string]string{"new": "short"}, "new", "")
+		assert.Error(t, err)
+		_, err = NewKeyringEncrypter(map[string]string{"a:b": newKey}, "a:b", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestNewEncrypterFromConfig(t *testing.T) {
+	secretKey := k8srand.String(32)
+	newKey := k8srand.String(32)
+
+	t.Run("should use the secret key when no keyring is configured", func(t *testing.T) {
+		e, err := NewEncrypterFromConfig(Config{SecretKey: secretKey})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultKeyID, e.PrimaryKeyID())
+	})
+
+	t.Run("should keep the secret key in the keyring", func(t *testing.T) {
+		// given
+		encrypted, err := NewEncrypter(secretKey).Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		// when
+		e, err := NewEncrypterFromConfig(Config{
+			SecretKey:              secretKey,
+			EncryptionKeys:         []string{"new:" + newKey},
+			EncryptionPrimaryKeyID: "new",
+		})
+		require.NoError(t, err)
+		decrypted, err := e.Decrypt(encrypted)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []byte("test"), decrypted)
+		assert.Equal(t, "new", e.PrimaryKeyID())
+	})
+
+	t.Run("should keep writing the legacy format", func(t *testing.T) {
+		// given
+		e, err := NewEncrypterFromConfig(Config{
+			SecretKey:              secretKey,
+			EncryptionKeys:         []string{"new:" + newKey},
+			EncryptionPrimaryKeyID: "new",
+			EncryptionWriteLegacy:  true,
+		})
+		require.NoError(t, err)
+
+		// when
+		encrypted, err := e.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		// then
+		_, found := KeyID(encrypted)
+		assert.False(t, found)
+		assert.False(t, e.NeedsReencryption(encrypted))
+		decrypted, err := NewEncrypter(secretKey).decryptLegacy(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("test"), decrypted)
+		decrypted, err = e.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("test"), decrypted)
+	})
+
+	t.Run("should require the secret key for the legacy writes", func(t *testing.T) {
+		_, err := NewEncrypterFromConfig(Config{
+			EncryptionKeys:         []string{"new:" + newKey},
+			EncryptionPrimaryKeyID: "new",
+			EncryptionWriteLegacy:  true,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject invalid keys", func(t *testing.T) {
+		_, err := NewEncrypterFromConfig(Config{EncryptionKeys: []string{newKey}, EncryptionPrimaryKeyID: "new"})
+		assert.Error(t, err)
+		_, err = NewEncrypterFromConfig(Config{EncryptionKeys: []string{"new:" + newKey, "new:" + newKey}, EncryptionPrimaryKeyID: "new"})
+		assert.Error(t, err)
+	})
+}
+
+// encryptLegacy encrypts the data the way it was done before the keyring
+func encryptLegacy(t *testing.T, key string, obj []byte) []byte {
+	block, err := aes.NewCipher([]byte(key))
+	require.NoError(t, err)
+	b := base64.StdEncoding.EncodeToString(obj)
+	bytes := make([]byte, aes.BlockSize+len(b))
+	iv := bytes[:aes.BlockSize]
+	_, err = io.ReadFull(rand.Reader, iv)
+	require.NoError(t, err)
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(bytes[aes.BlockSize:], []byte(b))
+	return []byte(base64.StdEncoding.EncodeToString(bytes))
+}
@@ -84,6 +84,44 @@ type RuntimeStates interface {
 	GetLatestWithReconcilerInputByRuntimeID(runtimeID string) (internal.RuntimeState, error)
 	GetLatestWithKymaVersionByRuntimeID(runtimeID string) (internal.RuntimeState, error)
 	GetLatestWithOIDCConfigByRuntimeID(runtimeID string) (internal.RuntimeState, error)
+	// ListLatestKymaVersions returns the Kyma version of the latest runtime state with a Kyma version for every given runtime,
+	// the runtimes without such a state are not included
+	ListLatestKymaVersions(runtimeIDs []string) (map[string]string, error)
+}
+
+// OperationsQueue persists the schedule of operation queues, so pending retries survive restarts
+// and can be shared between broker replicas
+type OperationsQueue interface {
+	Schedule(queue, operationID string, runAt time.Time) error
+	ScheduleIfIdle(queue, operationID string, runAt time.Time) error
+	Lease(queue, owner string, leaseDuration time.Duration, limit int) ([]string, error)
+	ExtendLeases(owner string, operationIDs []string, leaseDuration time.Duration) error
+	Reschedule(operationID, owner string, runAt time.Time) error
+	Release(operationID, owner string) error
+}
+
+type Bindings interface {
+	Insert(binding *internal.Binding) error
+	Get(instanceID, bindingID string) (*internal.Binding, error)
+	Delete(instanceID, bindingID string) error
+	ListByInstanceID(instanceID string) ([]internal.Binding, error)
+}
+
+// OperationSteps stores the step journal of operations
+type OperationSteps interface {
+	Insert(step internal.OperationStep) error
+	GetLast(operationID, stage, step string) (internal.OperationStep, error)
+	ListByOperationID(operationID string) ([]internal.OperationStep, error)
+}
+
+// WebhookDeliveries is the outbox of the webhook notifications, the pending deliveries survive restarts
+// and are leased by a single broker replica at a time
+type WebhookDeliveries interface {
+	Insert(delivery internal.WebhookDelivery) error
+	Lease(owner string, leaseDuration time.Duration, limit int) ([]internal.WebhookDelivery, error)
+	Update(delivery internal.WebhookDelivery, owner string) error
+	ListByState(state internal.WebhookDeliveryState, limit int) ([]internal.WebhookDelivery, error)
+	DeleteDelivered(until time.Time) error
 }
 
 type UpgradeKyma interface {
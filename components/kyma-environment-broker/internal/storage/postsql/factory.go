@@ -50,8 +50,16 @@ type ReadSession interface {
 	GetLatestRuntimeStateByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithReconcilerInputByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
+	ListLatestRuntimeKymaVersions(runtimeIDs []string) ([]dbmodel.RuntimeKymaVersionDTO, dberr.Error)
 	GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
+	GetBinding(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error)
+	ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error)
+	ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error)
+	GetLastOperationStep(operationID, stage, step string) (dbmodel.OperationStepDTO, dberr.Error)
+	ListWebhookDeliveriesByState(state string, limit int) ([]dbmodel.WebhookDeliveryDTO, dberr.Error)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
+	ListEncryptedData(column dbmodel.EncryptedColumn, afterKey string, limit int) ([]dbmodel.EncryptedDataDTO, dberr.Error)
+	CountEncryptedData(column dbmodel.EncryptedColumn) (int, dberr.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -61,11 +69,25 @@ type WriteSession interface {
 	DeleteInstance(instanceID string) dberr.Error
 	InsertOperation(dto dbmodel.OperationDTO) dberr.Error
 	UpdateOperation(dto dbmodel.OperationDTO) dberr.Error
+	ScheduleOperation(queue, operationID string, runAt time.Time) dberr.Error
+	ScheduleIdleOperation(queue, operationID string, runAt time.Time) dberr.Error
+	LeaseOperations(queue, owner string, now, leaseExpiresAt time.Time, limit int) ([]string, dberr.Error)
+	ExtendOperationLeases(owner string, operationIDs []string, leaseExpiresAt time.Time) dberr.Error
+	RescheduleOperation(operationID, owner string, runAt time.Time) dberr.Error
+	ReleaseOperation(operationID, owner string) dberr.Error
 	InsertOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	UpdateOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.Error
+	InsertBinding(binding dbmodel.BindingDTO) dberr.Error
+	DeleteBinding(instanceID, bindingID string) dberr.Error
+	InsertOperationStep(step dbmodel.OperationStepDTO) dberr.Error
+	InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error
+	LeaseWebhookDeliveries(state, owner string, now, leaseExpiresAt time.Time, limit int) ([]dbmodel.WebhookDeliveryDTO, dberr.Error)
+	UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO, owner string) dberr.Error
+	DeleteWebhookDeliveries(state string, until time.Time) dberr.Error
 	InsertEvent(level events.EventLevel, message, instanceID, operationID string) dberr.Error
 	DeleteEvents(until time.Time) dberr.Error
+	UpdateEncryptedData(column dbmodel.EncryptedColumn, rowKey, oldData, newData string) dberr.Error
 }
 
 type Transaction interface {
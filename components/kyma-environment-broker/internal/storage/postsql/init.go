@@ -12,12 +12,15 @@ import (
 )
 
 const (
-	schemaName             = "public"
-	InstancesTableName     = "instances"
-	OperationTableName     = "operations"
-	OrchestrationTableName = "orchestrations"
-	RuntimeStateTableName  = "runtime_states"
-	CreatedAtField         = "created_at"
+	schemaName                 = "public"
+	InstancesTableName         = "instances"
+	OperationTableName         = "operations"
+	OrchestrationTableName     = "orchestrations"
+	RuntimeStateTableName      = "runtime_states"
+	BindingsTableName          = "bindings"
+	OperationStepsTableName    = "operation_steps"
+	WebhookDeliveriesTableName = "webhook_deliveries"
+	CreatedAtField             = "created_at"
 )
 
 // InitializeDatabase opens database connection and initializes schema if it does not exist
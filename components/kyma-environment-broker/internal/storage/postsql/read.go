@@ -432,6 +432,125 @@ func (r readSession) ListRuntimeStateByRuntimeID(runtimeID string) ([]dbmodel.Ru
 	return states, nil
 }
 
+func (r readSession) GetBinding(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error) {
+	var binding dbmodel.BindingDTO
+
+	err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("id", bindingID)).
+		Where(dbr.Eq("instance_id", instanceID)).
+		LoadOne(&binding)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.BindingDTO{}, dberr.NotFound("cannot find binding: %s", err)
+		}
+		return dbmodel.BindingDTO{}, dberr.Internal("Failed to get binding: %s", err)
+	}
+	return binding, nil
+}
+
+func (r readSession) ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+
+	_, err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		OrderBy(CreatedAtField).
+		Load(&bindings)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get bindings: %s", err)
+	}
+	return bindings, nil
+}
+
+func (r readSession) ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error) {
+	var steps []dbmodel.OperationStepDTO
+
+	_, err := r.session.
+		Select("*").
+		From(OperationStepsTableName).
+		Where(dbr.Eq("operation_id", operationID)).
+		OrderBy("started_at").
+		Load(&steps)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get operation steps: %s", err)
+	}
+	return steps, nil
+}
+
+func (r readSession) GetLastOperationStep(operationID, stage, step string) (dbmodel.OperationStepDTO, dberr.Error) {
+	var dto dbmodel.OperationStepDTO
+
+	err := r.session.
+		Select("*").
+		From(OperationStepsTableName).
+		Where(dbr.Eq("operation_id", operationID)).
+		Where(dbr.Eq("stage", stage)).
+		Where(dbr.Eq("step", step)).
+		OrderDir("attempt", false).
+		Limit(1).
+		LoadOne(&dto)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.OperationStepDTO{}, dberr.NotFound("Cannot find step %s/%s of operation %s", stage, step, operationID)
+		}
+		return dbmodel.OperationStepDTO{}, dberr.Internal("Failed to get the last operation step: %s", err)
+	}
+	return dto, nil
+}
+
+func (r readSession) ListWebhookDeliveriesByState(state string, limit int) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+	var deliveries []dbmodel.WebhookDeliveryDTO
+
+	_, err := r.session.
+		Select("*").
+		From(WebhookDeliveriesTableName).
+		Where(dbr.Eq("state", state)).
+		OrderDir("updated_at", false).
+		Limit(uint64(limit)).
+		Load(&deliveries)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get webhook deliveries: %s", err)
+	}
+	return deliveries, nil
+}
+
+// ListEncryptedData returns the non-empty data of the column ordered by the row key, starting after the given key
+func (r readSession) ListEncryptedData(column dbmodel.EncryptedColumn, afterKey string, limit int) ([]dbmodel.EncryptedDataDTO, dberr.Error) {
+	var data []dbmodel.EncryptedDataDTO
+
+	_, err := r.session.
+		Select(fmt.Sprintf("%s AS row_key", column.Key), fmt.Sprintf("%s::text AS data", column.Column)).
+		From(column.Table).
+		Where(fmt.Sprintf("%s > ?", column.Key), afterKey).
+		Where(fmt.Sprintf("%s IS NOT NULL AND %s::text <> ''", column.Column, column.Column)).
+		OrderBy(column.Key).
+		Limit(uint64(limit)).
+		Load(&data)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get encrypted data of %s: %s", column, err)
+	}
+	return data, nil
+}
+
+func (r readSession) CountEncryptedData(column dbmodel.EncryptedColumn) (int, dberr.Error) {
+	var res struct {
+		Total int
+	}
+	err := r.session.
+		Select("count(*) as total").
+		From(column.Table).
+		Where(fmt.Sprintf("%s IS NOT NULL AND %s::text <> ''", column.Column, column.Column)).
+		LoadOne(&res)
+	if err != nil {
+		return 0, dberr.Internal("Failed to count encrypted data of %s: %s", column, err)
+	}
+	return res.Total, nil
+}
+
 func (r readSession) GetLatestRuntimeStateByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error) {
 	var state dbmodel.RuntimeStateDTO
 
@@ -506,6 +625,24 @@ func (r readSession) GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID s
 	return state, nil
 }
 
+func (r readSession) ListLatestRuntimeKymaVersions(runtimeIDs []string) ([]dbmodel.RuntimeKymaVersionDTO, dberr.Error) {
+	var versions []dbmodel.RuntimeKymaVersionDTO
+	if len(runtimeIDs) == 0 {
+		return versions, nil
+	}
+
+	_, err := r.session.SelectBySql(fmt.Sprintf(`
+SELECT DISTINCT ON (runtime_id) runtime_id, kyma_version
+FROM %s
+WHERE runtime_id IN ? AND kyma_version IS NOT NULL AND kyma_version != ''
+ORDER BY runtime_id, created_at DESC`, RuntimeStateTableName), runtimeIDs).
+		Load(&versions)
+	if err != nil {
+		return nil, dberr.Internal("Failed to list the latest kyma versions of runtimes: %s", err)
+	}
+	return versions, nil
+}
+
 func (r readSession) GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error) {
 	var state dbmodel.RuntimeStateDTO
 	condition := dbr.And(dbr.Eq("runtime_id", runtimeID),
@@ -1,6 +1,7 @@
 package postsql
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -127,6 +128,108 @@ func (ws writeSession) InsertOperation(op dbmodel.OperationDTO) dberr.Error {
 	return nil
 }
 
+func (ws writeSession) ScheduleOperation(queue, operationID string, runAt time.Time) dberr.Error {
+	res, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationID)).
+		Set("queue", queue).
+		Set("next_run_at", dbr.Expr("LEAST(next_run_at, ?)", runAt)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to schedule operation %s: %s", operationID, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to get number of rows affected: %s", err)
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find operation with ID: %s", operationID)
+	}
+
+	return nil
+}
+
+// ScheduleIdleOperation schedules the operation if it is neither leased nor scheduled
+func (ws writeSession) ScheduleIdleOperation(queue, operationID string, runAt time.Time) dberr.Error {
+	_, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationID)).
+		Where(dbr.Eq("lease_owner", nil)).
+		Where(dbr.Eq("next_run_at", nil)).
+		Set("queue", queue).
+		Set("next_run_at", runAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to schedule idle operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
+// LeaseOperations claims operations from the given queue which are due or whose lease has expired.
+// Rows locked by other replicas are skipped, so every operation is leased by exactly one owner.
+func (ws writeSession) LeaseOperations(queue, owner string, now, leaseExpiresAt time.Time, limit int) ([]string, dberr.Error) {
+	query := fmt.Sprintf(`UPDATE %[1]s SET lease_owner = ?, lease_expires_at = ?, next_run_at = NULL
+		WHERE id IN (
+			SELECT id FROM %[1]s
+			WHERE queue = ? AND (
+				(lease_owner IS NULL AND next_run_at <= ?) OR
+				(lease_owner IS NOT NULL AND lease_expires_at < ?))
+			ORDER BY next_run_at NULLS FIRST
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED)
+		RETURNING id`, OperationTableName)
+
+	var ids []string
+	_, err := ws.selectBySql(query, owner, leaseExpiresAt, queue, now, now, limit).Load(&ids)
+	if err != nil {
+		return nil, dberr.Internal("Failed to lease operations from queue %s: %s", queue, err)
+	}
+
+	return ids, nil
+}
+
+// ExtendOperationLeases moves the lease expiration of the operations which are still leased by the owner
+func (ws writeSession) ExtendOperationLeases(owner string, operationIDs []string, leaseExpiresAt time.Time) dberr.Error {
+	_, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationIDs)).
+		Where(dbr.Eq("lease_owner", owner)).
+		Set("lease_expires_at", leaseExpiresAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to extend leases of owner %s: %s", owner, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) RescheduleOperation(operationID, owner string, runAt time.Time) dberr.Error {
+	_, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationID)).
+		Where(dbr.Eq("lease_owner", owner)).
+		Set("next_run_at", dbr.Expr("LEAST(next_run_at, ?)", runAt)).
+		Set("lease_owner", nil).
+		Set("lease_expires_at", nil).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to reschedule operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ReleaseOperation(operationID, owner string) dberr.Error {
+	_, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationID)).
+		Where(dbr.Eq("lease_owner", owner)).
+		Set("lease_owner", nil).
+		Set("lease_expires_at", nil).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to release operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
 func (ws writeSession) InsertOrchestration(o dbmodel.OrchestrationDTO) dberr.Error {
 	_, err := ws.insertInto(OrchestrationTableName).
 		Pair("orchestration_id", o.OrchestrationID).
@@ -136,6 +239,7 @@ func (ws writeSession) InsertOrchestration(o dbmodel.OrchestrationDTO) dberr.Err
 		Pair("state", o.State).
 		Pair("type", o.Type).
 		Pair("parameters", o.Parameters).
+		Pair("execution_progress", o.ExecutionProgress).
 		Exec()
 
 	if err != nil {
@@ -159,6 +263,7 @@ func (ws writeSession) UpdateOrchestration(o dbmodel.OrchestrationDTO) dberr.Err
 		Set("state", o.State).
 		Set("type", o.Type).
 		Set("parameters", o.Parameters).
+		Set("execution_progress", o.ExecutionProgress).
 		Exec()
 
 	if err != nil {
@@ -204,6 +309,166 @@ func (ws writeSession) InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.E
 	return nil
 }
 
+func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
+	_, err := ws.insertInto(BindingsTableName).
+		Pair("id", binding.ID).
+		Pair("instance_id", binding.InstanceID).
+		Pair("created_at", binding.CreatedAt).
+		Pair("updated_at", binding.UpdatedAt).
+		Pair("expires_at", binding.ExpiresAt).
+		Pair("kubeconfig", binding.Kubeconfig).
+		Pair("expiration_seconds", binding.ExpirationSeconds).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Binding with id %s already exist", binding.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to Bindings table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteBinding(instanceID, bindingID string) dberr.Error {
+	_, err := ws.deleteFrom(BindingsTableName).
+		Where(dbr.Eq("id", bindingID)).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Exec()
+
+	if err != nil {
+		return dberr.Internal("Failed to delete record from Bindings table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) InsertOperationStep(step dbmodel.OperationStepDTO) dberr.Error {
+	_, err := ws.insertInto(OperationStepsTableName).
+		Pair("id", step.ID).
+		Pair("operation_id", step.OperationID).
+		Pair("stage", step.Stage).
+		Pair("step", step.Step).
+		Pair("attempt", step.Attempt).
+		Pair("started_at", step.StartedAt).
+		Pair("finished_at", step.FinishedAt).
+		Pair("outcome", step.Outcome).
+		Pair("error", step.Error).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to insert record to operation steps table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error {
+	_, err := ws.insertInto(WebhookDeliveriesTableName).
+		Pair("id", delivery.ID).
+		Pair("event_id", delivery.EventID).
+		Pair("event_type", delivery.EventType).
+		Pair("subscriber", delivery.Subscriber).
+		Pair("payload", delivery.Payload).
+		Pair("state", delivery.State).
+		Pair("attempts", delivery.Attempts).
+		Pair("next_attempt_at", delivery.NextAttemptAt).
+		Pair("created_at", delivery.CreatedAt).
+		Pair("updated_at", delivery.UpdatedAt).
+		Exec()
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("webhook delivery with id %s already exist", delivery.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to webhook deliveries table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) LeaseWebhookDeliveries(state, owner string, now, leaseExpiresAt time.Time, limit int) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+	query := fmt.Sprintf(`UPDATE %[1]s SET lease_owner = ?, lease_expires_at = ?
+		WHERE id IN (
+			SELECT id FROM %[1]s
+			WHERE state = ? AND next_attempt_at <= ? AND (lease_owner IS NULL OR lease_expires_at < ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED)
+		RETURNING *`, WebhookDeliveriesTableName)
+
+	var deliveries []dbmodel.WebhookDeliveryDTO
+	_, err := ws.selectBySql(query, owner, leaseExpiresAt, state, now, now, limit).Load(&deliveries)
+	if err != nil {
+		return nil, dberr.Internal("Failed to lease webhook deliveries: %s", err)
+	}
+
+	return deliveries, nil
+}
+
+func (ws writeSession) UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO, owner string) dberr.Error {
+	res, err := ws.update(WebhookDeliveriesTableName).
+		Where(dbr.Eq("id", delivery.ID)).
+		Where(dbr.Eq("lease_owner", owner)).
+		Set("state", delivery.State).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_error", delivery.LastError).
+		Set("updated_at", delivery.UpdatedAt).
+		Set("lease_owner", nil).
+		Set("lease_expires_at", nil).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update webhook delivery %s: %s", delivery.ID, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		// the optimistic locking requires numbers of rows affected
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.Conflict("webhook delivery %s is not leased by %s", delivery.ID, owner)
+	}
+
+	return nil
+}
+
+// UpdateEncryptedData replaces the data of the row only if it was not changed since it was read,
+// a conflict error is returned otherwise
+func (ws writeSession) UpdateEncryptedData(column dbmodel.EncryptedColumn, rowKey, oldData, newData string) dberr.Error {
+	res, err := ws.update(column.Table).
+		Where(fmt.Sprintf("%s = ?", column.Key), rowKey).
+		// the column is compared as text since the json columns have no equality operator
+		Where(fmt.Sprintf("%s::text = ?", column.Column), oldData).
+		Set(column.Column, newData).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update encrypted data of %s in row %s: %s", column, rowKey, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		// the optimistic locking requires numbers of rows affected
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.Conflict("encrypted data of %s in row %s was changed", column, rowKey)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteWebhookDeliveries(state string, until time.Time) dberr.Error {
+	_, err := ws.deleteFrom(WebhookDeliveriesTableName).
+		Where(dbr.Eq("state", state)).
+		Where(dbr.Lte("updated_at", until)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete %s webhook deliveries updated until %v: %s", state, until.Format(time.RFC1123Z), err)
+	}
+	return nil
+}
+
 func (ws writeSession) UpdateOperation(op dbmodel.OperationDTO) dberr.Error {
 	res, err := ws.update(OperationTableName).
 		Where(dbr.Eq("id", op.ID)).
@@ -286,6 +551,14 @@ func (ws writeSession) insertInto(table string) *dbr.InsertStmt {
 	return ws.session.InsertInto(table)
 }
 
+func (ws writeSession) selectBySql(query string, value ...interface{}) *dbr.SelectStmt {
+	if ws.transaction != nil {
+		return ws.transaction.SelectBySql(query, value...)
+	}
+
+	return ws.session.SelectBySql(query, value...)
+}
+
 func (ws writeSession) deleteFrom(table string) *dbr.DeleteStmt {
 	if ws.transaction != nil {
 		return ws.transaction.DeleteFrom(table)
@@ -23,6 +23,10 @@ type BrokerStorage interface {
 	Orchestrations() Orchestrations
 	RuntimeStates() RuntimeStates
 	Events() Events
+	Bindings() Bindings
+	OperationSteps() OperationSteps
+	OperationsQueue() OperationsQueue
+	WebhookDeliveries() WebhookDeliveries
 }
 
 const (
@@ -51,6 +55,10 @@ func NewFromConfig(cfg Config, evcfg events.Config, cipher postgres.Cipher, log
 		orchestrations: postgres.NewOrchestrations(fact),
 		runtimeStates:  postgres.NewRuntimeStates(fact, cipher),
 		events:         events.New(evcfg, eventstorage.New(fact, log)),
+		bindings:       postgres.NewBindings(fact, cipher),
+		queue:          postgres.NewOperationsQueue(fact),
+		steps:          postgres.NewOperationSteps(fact),
+		webhooks:       postgres.NewWebhookDeliveries(fact),
 	}, connection, nil
 }
 
@@ -62,6 +70,10 @@ func NewMemoryStorage() BrokerStorage {
 		orchestrations: memory.NewOrchestrations(),
 		runtimeStates:  memory.NewRuntimeStates(),
 		events:         events.New(events.Config{}, NewInMemoryEvents()),
+		bindings:       memory.NewBindings(),
+		queue:          memory.NewOperationsQueue(),
+		steps:          memory.NewOperationSteps(),
+		webhooks:       memory.NewWebhookDeliveries(),
 	}
 }
 
@@ -119,6 +131,10 @@ type storage struct {
 	orchestrations Orchestrations
 	runtimeStates  RuntimeStates
 	events         Events
+	bindings       Bindings
+	queue          OperationsQueue
+	steps          OperationSteps
+	webhooks       WebhookDeliveries
 }
 
 func (s storage) Instances() Instances {
@@ -148,3 +164,19 @@ func (s storage) RuntimeStates() RuntimeStates {
 func (s storage) Events() Events {
 	return s.events
 }
+
+func (s storage) Bindings() Bindings {
+	return s.bindings
+}
+
+func (s storage) OperationsQueue() OperationsQueue {
+	return s.queue
+}
+
+func (s storage) OperationSteps() OperationSteps {
+	return s.steps
+}
+
+func (s storage) WebhookDeliveries() WebhookDeliveries {
+	return s.webhooks
+}
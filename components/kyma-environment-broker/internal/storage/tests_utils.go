@@ -322,11 +322,14 @@ func isDBContainerAvailable(hostname, port string) (isAvailable bool, dbCfg Conf
 }
 
 func clearDBQuery() string {
-	return fmt.Sprintf("TRUNCATE TABLE %s, %s, %s, %s RESTART IDENTITY CASCADE",
+	return fmt.Sprintf("TRUNCATE TABLE %s, %s, %s, %s, %s, %s, %s RESTART IDENTITY CASCADE",
 		postsql.InstancesTableName,
 		postsql.OperationTableName,
 		postsql.OrchestrationTableName,
 		postsql.RuntimeStateTableName,
+		postsql.BindingsTableName,
+		postsql.OperationStepsTableName,
+		postsql.WebhookDeliveriesTableName,
 	)
 }
 
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	Enabled             bool          `envconfig:"default=false"`
+	SubscribersFilePath string        `envconfig:"default=/config/webhooks/subscribers.yaml"`
+	PollingInterval     time.Duration `envconfig:"default=5s"`
+	BatchSize           int           `envconfig:"default=50"`
+	LeaseDuration       time.Duration `envconfig:"default=1m"`
+	RequestTimeout      time.Duration `envconfig:"default=10s"`
+	MaxAttempts         int           `envconfig:"default=10"`
+	InitialBackoff      time.Duration `envconfig:"default=10s"`
+	MaxBackoff          time.Duration `envconfig:"default=1h"`
+	Retention           time.Duration `envconfig:"default=168h"`
+}
+
+// Subscriber is an external HTTP endpoint notified about the KEB lifecycle events.
+// The payload of every request is signed with the subscriber secret, see Sign.
+type Subscriber struct {
+	Name   string `yaml:"name"`
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
+	// EventTypes limits the events delivered to the subscriber, all the events are delivered when empty
+	EventTypes []string `yaml:"eventTypes"`
+}
+
+// Accepts returns true if the subscriber is interested in the events of the given type
+func (s Subscriber) Accepts(eventType string) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type subscribersFile struct {
+	Subscribers []Subscriber `yaml:"subscribers"`
+}
+
+func ReadSubscribersFromYAML(yamlFilePath string) ([]Subscriber, error) {
+	yamlFile, err := ioutil.ReadFile(yamlFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("while reading YAML file with webhook subscribers: %w", err)
+	}
+
+	var file subscribersFile
+	err = yaml.Unmarshal(yamlFile, &file)
+	if err != nil {
+		return nil, fmt.Errorf("while unmarshalling YAML file with webhook subscribers: %w", err)
+	}
+	if err := validateSubscribers(file.Subscribers); err != nil {
+		return nil, fmt.Errorf("while validating webhook subscribers: %w", err)
+	}
+	return file.Subscribers, nil
+}
+
+func validateSubscribers(subscribers []Subscriber) error {
+	names := map[string]bool{}
+	for _, s := range subscribers {
+		if s.Name == "" {
+			return fmt.Errorf("subscriber name must not be empty")
+		}
+		if names[s.Name] {
+			return fmt.Errorf("subscriber %s is defined more than once", s.Name)
+		}
+		names[s.Name] = true
+		if u, err := url.Parse(s.URL); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("subscriber %s has invalid URL %q", s.Name, s.URL)
+		}
+		if s.Secret == "" {
+			return fmt.Errorf("subscriber %s secret must not be empty", s.Name)
+		}
+		for _, t := range s.EventTypes {
+			if !isEventType(t) {
+				return fmt.Errorf("subscriber %s has unknown event type %s", s.Name, t)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSubscribersFromYAML(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		content       string
+		expectedError string
+	}{
+		"valid": {
+			content: `
+subscribers:
+  - name: audit
+    url: https://audit.example.com/keb
+    secret: s3cr3t
+    eventTypes: [provisioning.succeeded, suspension.finished]
+  - name: all
+    url: http://all.example.com
+    secret: s3cr3t
+`,
+		},
+		"duplicated name": {
+			content: `
+subscribers:
+  - {name: audit, url: "http://a.example.com", secret: s}
+  - {name: audit, url: "http://b.example.com", secret: s}
+`,
+			expectedError: "subscriber audit is defined more than once",
+		},
+		"invalid URL": {
+			content:       `subscribers: [{name: audit, url: "audit", secret: s}]`,
+			expectedError: `subscriber audit has invalid URL "audit"`,
+		},
+		"missing secret": {
+			content:       `subscribers: [{name: audit, url: "http://a.example.com"}]`,
+			expectedError: "subscriber audit secret must not be empty",
+		},
+		"unknown event type": {
+			content:       `subscribers: [{name: audit, url: "http://a.example.com", secret: s, eventTypes: [instance.created]}]`,
+			expectedError: "subscriber audit has unknown event type instance.created",
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			path := filepath.Join(t.TempDir(), "subscribers.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0600))
+
+			// when
+			subscribers, err := ReadSubscribersFromYAML(path)
+
+			// then
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, subscribers, 2)
+			assert.True(t, subscribers[0].Accepts(SuspensionFinished))
+			assert.False(t, subscribers[0].Accepts(OrchestrationStateChanged))
+			assert.True(t, subscribers[1].Accepts(OrchestrationStateChanged))
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	EventIDHeader    = "X-KEB-Event-ID"
+	EventTypeHeader  = "X-KEB-Event-Type"
+	DeliveryIDHeader = "X-KEB-Delivery-ID"
+	TimestampHeader  = "X-KEB-Timestamp"
+	SignatureHeader  = "X-KEB-Signature"
+
+	signaturePrefix    = "sha256="
+	maxErrorBodyLength = 256
+	cleanupInterval    = time.Hour
+)
+
+// Dispatcher sends the pending webhook deliveries to the subscribers. A failed delivery is retried with exponential
+// backoff and marked as dead after the maximum number of attempts. The deliveries are leased, so many broker replicas
+// can run the dispatcher at the same time.
+type Dispatcher struct {
+	deliveries  storage.WebhookDeliveries
+	subscribers map[string]Subscriber
+	client      *http.Client
+	cfg         Config
+	owner       string
+	log         logrus.FieldLogger
+
+	lastCleanup time.Time
+}
+
+func NewDispatcher(deliveries storage.WebhookDeliveries, subscribers []Subscriber, cfg Config, log logrus.FieldLogger) *Dispatcher {
+	byName := make(map[string]Subscriber, len(subscribers))
+	for _, s := range subscribers {
+		byName[s.Name] = s
+	}
+	return &Dispatcher{
+		deliveries:  deliveries,
+		subscribers: byName,
+		client:      &http.Client{Timeout: cfg.RequestTimeout},
+		cfg:         cfg,
+		owner:       uuid.New().String(),
+		log:         log.WithField("webhook", "dispatcher"),
+	}
+}
+
+// Run dispatches the pending deliveries every polling interval until the context is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollingInterval)
+	defer ticker.Stop()
+	for {
+		d.Dispatch(ctx)
+		d.cleanup()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch sends the deliveries which are due, it returns the number of processed deliveries
+func (d *Dispatcher) Dispatch(ctx context.Context) int {
+	deliveries, err := d.deliveries.Lease(d.owner, d.cfg.LeaseDuration, d.cfg.BatchSize)
+	if err != nil {
+		d.log.Errorf("while leasing webhook deliveries: %v", err)
+		return 0
+	}
+
+	for _, delivery := range deliveries {
+		d.process(ctx, delivery)
+	}
+	return len(deliveries)
+}
+
+func (d *Dispatcher) process(ctx context.Context, delivery internal.WebhookDelivery) {
+	log := d.log.WithField("deliveryID", delivery.ID).WithField("subscriber", delivery.Subscriber).WithField("eventType", delivery.EventType)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.UpdatedAt = now
+
+	subscriber, found := d.subscribers[delivery.Subscriber]
+	if !found {
+		delivery.State = internal.WebhookDeliveryDead
+		delivery.LastError = "subscriber is not configured"
+		log.Warnf("webhook delivery is dead: %s", delivery.LastError)
+	} else if err := d.send(ctx, subscriber, delivery); err != nil {
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= d.cfg.MaxAttempts {
+			delivery.State = internal.WebhookDeliveryDead
+			log.Warnf("webhook delivery is dead after %d attempts: %s", delivery.Attempts, err)
+		} else {
+			delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+			log.Infof("webhook delivery attempt %d failed, retrying at %s: %s", delivery.Attempts, delivery.NextAttemptAt.Format(time.RFC3339), err)
+		}
+	} else {
+		delivery.State = internal.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		log.Debugf("webhook delivered")
+	}
+
+	err := d.deliveries.Update(delivery, d.owner)
+	switch {
+	case dberr.IsConflict(err):
+		log.Warnf("webhook delivery lease expired before the result was stored, the delivery can be sent again")
+	case err != nil:
+		log.Errorf("while updating webhook delivery: %v", err)
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscriber Subscriber, delivery internal.WebhookDelivery) error {
+	payload := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscriber.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, delivery.EventID)
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(DeliveryIDHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(subscriber.Secret, timestamp, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+		return fmt.Errorf("subscriber responded with status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// backoff returns the delay before the next attempt, the delay is doubled after every failed attempt
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.InitialBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.cfg.MaxBackoff {
+		return d.cfg.MaxBackoff
+	}
+	return delay
+}
+
+func (d *Dispatcher) cleanup() {
+	if d.cfg.Retention == 0 || time.Since(d.lastCleanup) < cleanupInterval {
+		return
+	}
+	d.lastCleanup = time.Now()
+	if err := d.deliveries.DeleteDelivered(time.Now().Add(-d.cfg.Retention)); err != nil {
+		d.log.Errorf("while deleting delivered webhooks: %v", err)
+	}
+}
+
+// Sign returns the value of the signature header, which is the hex encoded HMAC-SHA256 of the timestamp
+// and the payload joined with a dot, computed with the subscriber secret
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
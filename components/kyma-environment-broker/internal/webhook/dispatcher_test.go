@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "top-secret"
+
+func TestDispatcher_Delivered(t *testing.T) {
+	// given
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+	}))
+	defer server.Close()
+
+	db := storage.NewMemoryStorage().WebhookDeliveries()
+	require.NoError(t, db.Insert(fixDelivery("delivery-1", "subscriber")))
+	dispatcher := NewDispatcher(db, []Subscriber{{Name: "subscriber", URL: server.URL, Secret: testSecret}}, fixConfig(), logrus.New())
+
+	// when
+	processed := dispatcher.Dispatch(context.Background())
+
+	// then
+	assert.Equal(t, 1, processed)
+	require.Len(t, requests, 1)
+	req := requests[0]
+	assert.Equal(t, "event-id", req.Header.Get(EventIDHeader))
+	assert.Equal(t, ProvisioningSucceeded, req.Header.Get(EventTypeHeader))
+	assert.Equal(t, "delivery-1", req.Header.Get(DeliveryIDHeader))
+	assert.Equal(t, Sign(testSecret, req.Header.Get(TimestampHeader), bodies[0]), req.Header.Get(SignatureHeader))
+	assert.Equal(t, `{"id":"event-id"}`, string(bodies[0]))
+
+	delivered, err := db.ListByState(internal.WebhookDeliveryDelivered, 10)
+	require.NoError(t, err)
+	require.Len(t, delivered, 1)
+	assert.Equal(t, 1, delivered[0].Attempts)
+
+	// the delivered webhook is not sent again
+	assert.Equal(t, 0, dispatcher.Dispatch(context.Background()))
+}
+
+func TestDispatcher_Retried(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	db := storage.NewMemoryStorage().WebhookDeliveries()
+	require.NoError(t, db.Insert(fixDelivery("delivery-1", "subscriber")))
+	cfg := fixConfig()
+	cfg.MaxAttempts = 2
+	cfg.InitialBackoff = time.Hour
+	dispatcher := NewDispatcher(db, []Subscriber{{Name: "subscriber", URL: server.URL, Secret: testSecret}}, cfg, logrus.New())
+
+	// when
+	assert.Equal(t, 1, dispatcher.Dispatch(context.Background()))
+
+	// then the delivery waits for the backoff
+	pending, err := db.ListByState(internal.WebhookDeliveryPending, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Contains(t, pending[0].LastError, "503")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), pending[0].NextAttemptAt, time.Minute)
+	assert.Equal(t, 0, dispatcher.Dispatch(context.Background()))
+}
+
+func TestDispatcher_DeadAfterMaxAttempts(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	db := storage.NewMemoryStorage().WebhookDeliveries()
+	require.NoError(t, db.Insert(fixDelivery("delivery-1", "subscriber")))
+	require.NoError(t, db.Insert(fixDelivery("delivery-2", "removed-subscriber")))
+	cfg := fixConfig()
+	cfg.MaxAttempts = 3
+	dispatcher := NewDispatcher(db, []Subscriber{{Name: "subscriber", URL: server.URL, Secret: testSecret}}, cfg, logrus.New())
+
+	// when
+	for i := 0; i < cfg.MaxAttempts; i++ {
+		dispatcher.Dispatch(context.Background())
+	}
+
+	// then
+	dead, err := db.ListByState(internal.WebhookDeliveryDead, 10)
+	require.NoError(t, err)
+	require.Len(t, dead, 2)
+	attempts := map[string]int{}
+	for _, d := range dead {
+		attempts[d.ID] = d.Attempts
+	}
+	assert.Equal(t, map[string]int{"delivery-1": 3, "delivery-2": 1}, attempts)
+	assert.Equal(t, 0, dispatcher.Dispatch(context.Background()))
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	cfg := fixConfig()
+	cfg.InitialBackoff = time.Second
+	cfg.MaxBackoff = 10 * time.Second
+	dispatcher := NewDispatcher(nil, nil, cfg, logrus.New())
+
+	assert.Equal(t, time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 2*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 8*time.Second, dispatcher.backoff(4))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(5))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(100))
+}
+
+func fixConfig() Config {
+	return Config{
+		PollingInterval: time.Second,
+		BatchSize:       10,
+		LeaseDuration:   time.Minute,
+		RequestTimeout:  time.Second,
+		MaxAttempts:     10,
+		MaxBackoff:      time.Hour,
+	}
+}
+
+func fixDelivery(id, subscriber string) internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:            id,
+		EventID:       "event-id",
+		EventType:     ProvisioningSucceeded,
+		Subscriber:    subscriber,
+		Payload:       `{"id":"event-id"}`,
+		State:         internal.WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"time"
+)
+
+// Types of the events delivered to the webhook subscribers
+const (
+	ProvisioningSucceeded     = "provisioning.succeeded"
+	DeprovisioningFinished    = "deprovisioning.finished"
+	DeprovisioningFailed      = "deprovisioning.failed"
+	SuspensionFinished        = "suspension.finished"
+	SuspensionFailed          = "suspension.failed"
+	OrchestrationStateChanged = "orchestration.stateChanged"
+)
+
+func isEventType(eventType string) bool {
+	switch eventType {
+	case ProvisioningSucceeded, DeprovisioningFinished, DeprovisioningFailed, SuspensionFinished, SuspensionFailed, OrchestrationStateChanged:
+		return true
+	}
+	return false
+}
+
+// Event is the JSON body of the webhook request
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Data      interface{} `json:"data"`
+}
+
+type OperationData struct {
+	OperationID     string `json:"operationID"`
+	InstanceID      string `json:"instanceID"`
+	RuntimeID       string `json:"runtimeID,omitempty"`
+	GlobalAccountID string `json:"globalAccountID"`
+	SubAccountID    string `json:"subAccountID"`
+	PlanID          string `json:"planID"`
+	State           string `json:"state"`
+	Description     string `json:"description"`
+}
+
+type OrchestrationData struct {
+	OrchestrationID string `json:"orchestrationID"`
+	Type            string `json:"type"`
+	State           string `json:"state"`
+	Description     string `json:"description"`
+}
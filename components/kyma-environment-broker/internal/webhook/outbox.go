@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// Outbox stores the in-process lifecycle events as webhook deliveries, one for every subscriber accepting the event type.
+// The deliveries are sent by the Dispatcher, so the events are not lost on restart.
+//
+// The ID of an operation event is derived from the operation and the event type, so the event published again
+// for the same operation does not duplicate the deliveries.
+type Outbox struct {
+	deliveries  storage.WebhookDeliveries
+	subscribers []Subscriber
+	log         logrus.FieldLogger
+}
+
+func NewOutbox(deliveries storage.WebhookDeliveries, subscribers []Subscriber, log logrus.FieldLogger) *Outbox {
+	return &Outbox{
+		deliveries:  deliveries,
+		subscribers: subscribers,
+		log:         log.WithField("webhook", "outbox"),
+	}
+}
+
+// Subscribe registers the outbox handlers of the lifecycle events
+func (o *Outbox) Subscribe(sub event.Subscriber) {
+	sub.Subscribe(process.OperationSucceeded{}, o.OnOperationSucceeded)
+	sub.Subscribe(process.OperationStepProcessed{}, o.OnOperationStepProcessed)
+	sub.Subscribe(process.OrchestrationStateChanged{}, o.OnOrchestrationStateChanged)
+}
+
+func (o *Outbox) OnOperationSucceeded(ctx context.Context, ev interface{}) error {
+	succeeded, ok := ev.(process.OperationSucceeded)
+	if !ok {
+		return fmt.Errorf("expected process.OperationSucceeded but got %+v", ev)
+	}
+
+	return o.storeOperation(succeeded.Operation)
+}
+
+// OnOperationStepProcessed stores the operations which finished in the processed step
+func (o *Outbox) OnOperationStepProcessed(ctx context.Context, ev interface{}) error {
+	stepProcessed, ok := ev.(process.OperationStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected process.OperationStepProcessed but got %+v", ev)
+	}
+
+	if stepProcessed.OldOperation.State == stepProcessed.Operation.State {
+		return nil
+	}
+	return o.storeOperation(stepProcessed.Operation)
+}
+
+func (o *Outbox) OnOrchestrationStateChanged(ctx context.Context, ev interface{}) error {
+	changed, ok := ev.(process.OrchestrationStateChanged)
+	if !ok {
+		return fmt.Errorf("expected process.OrchestrationStateChanged but got %+v", ev)
+	}
+
+	orchestration := changed.Orchestration
+	return o.store(uuid.New(), OrchestrationStateChanged, OrchestrationData{
+		OrchestrationID: orchestration.OrchestrationID,
+		Type:            string(orchestration.Type),
+		State:           orchestration.State,
+		Description:     orchestration.Description,
+	})
+}
+
+// storeOperation stores the event of the finished operation, none if the operation did not finish or its type has no events
+func (o *Outbox) storeOperation(op internal.Operation) error {
+	eventType := operationEventType(op)
+	if eventType == "" {
+		return nil
+	}
+	eventID := uuid.NewSHA1(uuid.NameSpaceOID, []byte(op.ID+"/"+eventType))
+	return o.store(eventID, eventType, operationData(op))
+}
+
+func (o *Outbox) store(eventID uuid.UUID, eventType string, data interface{}) error {
+	now := time.Now()
+	payload, err := json.Marshal(Event{
+		ID:        eventID.String(),
+		Type:      eventType,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("while marshalling %s event: %w", eventType, err)
+	}
+
+	for _, subscriber := range o.subscribers {
+		if !subscriber.Accepts(eventType) {
+			continue
+		}
+		err := o.deliveries.Insert(internal.WebhookDelivery{
+			ID:            uuid.NewSHA1(eventID, []byte(subscriber.Name)).String(),
+			EventID:       eventID.String(),
+			EventType:     eventType,
+			Subscriber:    subscriber.Name,
+			Payload:       string(payload),
+			State:         internal.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		switch {
+		case dberr.IsAlreadyExists(err):
+			o.log.Debugf("%s event %s for subscriber %s is already stored", eventType, eventID, subscriber.Name)
+		case err != nil:
+			return fmt.Errorf("while storing %s event %s for subscriber %s: %w", eventType, eventID, subscriber.Name, err)
+		default:
+			o.log.Debugf("stored %s event %s for subscriber %s", eventType, eventID, subscriber.Name)
+		}
+	}
+	return nil
+}
+
+// operationEventType returns the type of the event of the finished operation, empty if the operation causes no event
+func operationEventType(op internal.Operation) string {
+	switch {
+	case op.Type == internal.OperationTypeProvision && op.State == domain.Succeeded:
+		return ProvisioningSucceeded
+	case op.Type == internal.OperationTypeDeprovision && op.State == domain.Succeeded && op.Temporary:
+		return SuspensionFinished
+	case op.Type == internal.OperationTypeDeprovision && op.State == domain.Succeeded:
+		return DeprovisioningFinished
+	case op.Type == internal.OperationTypeDeprovision && op.State == domain.Failed && op.Temporary:
+		return SuspensionFailed
+	case op.Type == internal.OperationTypeDeprovision && op.State == domain.Failed:
+		return DeprovisioningFailed
+	}
+	return ""
+}
+
+func operationData(op internal.Operation) OperationData {
+	return OperationData{
+		OperationID:     op.ID,
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		GlobalAccountID: op.ProvisioningParameters.ErsContext.GlobalAccountID,
+		SubAccountID:    op.ProvisioningParameters.ErsContext.SubAccountID,
+		PlanID:          op.ProvisioningParameters.PlanID,
+		State:           string(op.State),
+		Description:     op.Description,
+	}
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage().WebhookDeliveries()
+	pubSub := event.NewPubSub(logrus.New())
+	NewOutbox(db, []Subscriber{
+		{Name: "all", URL: "http://all.local", Secret: "secret"},
+		{Name: "orchestrations", URL: "http://orchestrations.local", Secret: "secret", EventTypes: []string{OrchestrationStateChanged}},
+	}, logrus.New()).Subscribe(pubSub)
+
+	provisioning := fixOperation("op-1", internal.OperationTypeProvision, domain.Succeeded)
+	suspension := fixOperation("op-2", internal.OperationTypeDeprovision, domain.Succeeded)
+	suspension.Temporary = true
+	deprovisioning := fixOperation("op-3", internal.OperationTypeDeprovision, domain.Failed)
+
+	// when
+	pubSub.Publish(context.TODO(), process.OperationSucceeded{Operation: provisioning})
+	// the operation which succeeded in the last step is published twice, one event
+	pubSub.Publish(context.TODO(), process.OperationStepProcessed{
+		OldOperation: fixOperation("op-2", internal.OperationTypeDeprovision, domain.InProgress),
+		Operation:    suspension,
+	})
+	pubSub.Publish(context.TODO(), process.OperationSucceeded{Operation: suspension})
+	pubSub.Publish(context.TODO(), process.OperationStepProcessed{
+		OldOperation: fixOperation("op-3", internal.OperationTypeDeprovision, domain.InProgress),
+		Operation:    deprovisioning,
+	})
+	// the operation failed in the previous step, no event
+	pubSub.Publish(context.TODO(), process.OperationStepProcessed{OldOperation: deprovisioning, Operation: deprovisioning})
+	// the operation in progress causes no event
+	pubSub.Publish(context.TODO(), process.OperationStepProcessed{
+		OldOperation: fixOperation("op-4", internal.OperationTypeProvision, domain.InProgress),
+		Operation:    fixOperation("op-4", internal.OperationTypeProvision, domain.InProgress),
+	})
+	pubSub.Publish(context.TODO(), process.OrchestrationStateChanged{Orchestration: internal.Orchestration{
+		OrchestrationID: "orchestration-1",
+		Type:            orchestration.UpgradeKymaOrchestration,
+		State:           orchestration.InProgress,
+	}})
+
+	// then
+	var deliveries []internal.WebhookDelivery
+	assert.Eventually(t, func() bool {
+		var err error
+		deliveries, err = db.ListByState(internal.WebhookDeliveryPending, 100)
+		return err == nil && len(deliveries) == 5
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	deliveries, err := db.ListByState(internal.WebhookDeliveryPending, 100)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 5)
+
+	received := map[string][]string{}
+	for _, d := range deliveries {
+		received[d.Subscriber] = append(received[d.Subscriber], d.EventType)
+	}
+	assert.ElementsMatch(t, []string{ProvisioningSucceeded, SuspensionFinished, DeprovisioningFailed, OrchestrationStateChanged}, received["all"])
+	assert.Equal(t, []string{OrchestrationStateChanged}, received["orchestrations"])
+
+	for _, d := range deliveries {
+		if d.EventType != DeprovisioningFailed {
+			continue
+		}
+		var ev struct {
+			Event
+			Data OperationData `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(d.Payload), &ev))
+		assert.Equal(t, d.EventID, ev.ID)
+		assert.Equal(t, "op-3", ev.Data.OperationID)
+		assert.Equal(t, "ga-id", ev.Data.GlobalAccountID)
+		assert.Equal(t, string(domain.Failed), ev.Data.State)
+	}
+}
+
+func fixOperation(id string, opType internal.OperationType, state domain.LastOperationState) internal.Operation {
+	return internal.Operation{
+		ID:         id,
+		Type:       opType,
+		InstanceID: "instance-id",
+		State:      state,
+		InstanceDetails: internal.InstanceDetails{
+			RuntimeID: "runtime-id",
+		},
+		ProvisioningParameters: internal.ProvisioningParameters{
+			PlanID: "plan-id",
+			ErsContext: internal.ERSContext{
+				GlobalAccountID: "ga-id",
+				SubAccountID:    "sa-id",
+			},
+		},
+	}
+}
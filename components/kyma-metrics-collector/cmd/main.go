@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"strconv"
 	"strings"
 
 	"go.uber.org/zap"
 
+	skrserviceinstance "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/serviceinstance"
 	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
 
 	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
@@ -17,6 +19,10 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/keb"
 
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/api"
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/history"
+
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
 	"k8s.io/client-go/util/workqueue"
 
@@ -26,6 +32,7 @@ import (
 
 	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/service"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/spool"
 
 	gardenersecret "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/secret"
 	gardenershoot "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/shoot"
@@ -97,6 +104,53 @@ func main() {
 
 	edpClient := edp.NewClient(edpConfig, logger)
 
+	// Register the metric collectors
+	collectorsConfig := new(kmcprocess.CollectorsConfig)
+	if err := envconfig.Process("", collectorsConfig); err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load collectors config")
+	}
+	collectors, err := kmcprocess.NewCollectorRegistryFromConfig(collectorsConfig, publicCloudSpecs, kmcprocess.SKRConfigs{
+		NodeConfig:            skrnode.Config{},
+		PVCConfig:             skrpvc.Config{},
+		SvcConfig:             skrsvc.Config{},
+		ServiceInstanceConfig: skrserviceinstance.Config{},
+	}, logger)
+	if err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Register collectors")
+	}
+	dataStreamVersion, err := strconv.Atoi(strings.TrimPrefix(edpConfig.DataStreamVersion, "v"))
+	if err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Parse EDP datastream version")
+	}
+	if dataStreamVersion < collectors.SchemaVersion() {
+		logger.With(log.KeyResult, log.ValueFail).
+			Fatalf("EDP datastream version %d does not support the schema version %d required by the collectors: %v",
+				dataStreamVersion, collectors.SchemaVersion(), collectors.Names())
+	}
+	logger.Infof("registered collectors: %v", collectors.Names())
+
+	// Creating the spool for the undelivered EDP payloads
+	spoolConfig := new(spool.Config)
+	if err := envconfig.Process("", spoolConfig); err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load spool config")
+	}
+	var kmcSpool *spool.Spool
+	if spoolConfig.Enabled() {
+		kmcSpool, err = spool.New(*spoolConfig, logger)
+		if err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Create spool")
+		}
+		logger.Infof("spool for undelivered EDP payloads: %s, pending payloads: %d", spoolConfig.Dir, kmcSpool.Len())
+	}
+
+	// Creating the history of the reported metrics served by the API
+	historyConfig := new(history.Config)
+	if err := envconfig.Process("", historyConfig); err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load history config")
+	}
+	reportedHistory := history.New(*historyConfig)
+	runtimeIndex := kmccache.NewRuntimeIndex()
+
 	queue := workqueue.NewDelayingQueue()
 
 	kmcProcess := kmcprocess.Process{
@@ -105,14 +159,14 @@ func main() {
 		SecretClient:    secretClient,
 		EDPClient:       edpClient,
 		Logger:          logger,
-		Providers:       publicCloudSpecs,
 		Cache:           cache,
+		RuntimeIndex:    runtimeIndex,
 		ScrapeInterval:  opts.ScrapeInterval,
 		Queue:           queue,
 		WorkersPoolSize: opts.WorkerPoolSize,
-		NodeConfig:      skrnode.Config{},
-		PVCConfig:       skrpvc.Config{},
-		SvcConfig:       skrsvc.Config{},
+		Collectors:      collectors,
+		Spool:           kmcSpool,
+		History:         reportedHistory,
 	}
 
 	// Start execution
@@ -128,6 +182,28 @@ func main() {
 	})
 	router.Path(metricsPath).Handler(promhttp.Handler())
 
+	// Start a server to cater to the API endpoints, it requires the bearer token
+	apiToken, err := readToken(opts.APITokenPath)
+	if err != nil {
+		logger.With(log.KeyError, err.Error()).Warn("API token not found, the API is disabled")
+	} else {
+		apiRouter := mux.NewRouter()
+		apiHandler := api.Handler{
+			Cache:        cache,
+			RuntimeIndex: runtimeIndex,
+			History:      reportedHistory,
+			Token:        apiToken,
+			Logger:       logger,
+		}
+		apiHandler.AttachRoutes(apiRouter)
+		apiSvr := service.Server{
+			Addr:   fmt.Sprintf(":%d", opts.APIListenAddr),
+			Logger: logger,
+			Router: apiRouter,
+		}
+		go apiSvr.Start()
+	}
+
 	kmcSvr := service.Server{
 		Addr:   fmt.Sprintf(":%d", opts.ListenAddr),
 		Logger: logger,
@@ -170,3 +246,16 @@ func getEDPToken() (string, error) {
 	trimmedToken := strings.TrimSuffix(string(token), "\n")
 	return trimmedToken, nil
 }
+
+// readToken reads a non-empty token from the mounted secret file
+func readToken(path string) (string, error) {
+	token, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	trimmedToken := strings.TrimSpace(string(token))
+	if trimmedToken == "" {
+		return "", fmt.Errorf("token in %s is empty", path)
+	}
+	return trimmedToken, nil
+}
@@ -20,6 +20,8 @@ type Options struct {
 	WorkerPoolSize      int
 	DebugPort           int
 	ListenAddr          int
+	APIListenAddr       int
+	APITokenPath        string
 	LogLevel            zapcore.Level
 }
 
@@ -31,6 +33,8 @@ func ParseArgs() *Options {
 	workerPoolSize := flag.Int("worker-pool-size", 5, "The number of workers in the pool")
 	logLevelStr := flag.String("log-level", "info", "The log-level of the application. E.g. fatal, error, info, debug etc")
 	listenAddr := flag.Int("listen-addr", 8080, "The application starts server in this port to serve the metrics and healthz endpoints")
+	apiListenAddr := flag.Int("api-listen-addr", 8081, "The application starts server in this port to serve the API endpoints")
+	apiTokenPath := flag.String("api-token-path", "/api-credentials/token", "The path to the bearer token required by the API endpoints, the API is disabled if the token is missing")
 	debugPort := flag.Int("debug-port", 0, "The custom port to debug when needed")
 	flag.Parse()
 
@@ -47,12 +51,14 @@ func ParseArgs() *Options {
 		DebugPort:          *debugPort,
 		LogLevel:           logLevel,
 		ListenAddr:         *listenAddr,
+		APIListenAddr:      *apiListenAddr,
+		APITokenPath:       *apiTokenPath,
 	}
 }
 
 func (o *Options) String() string {
 	return fmt.Sprintf("--gardener-secret-path=%s --gardener-namespace=%s --scrape-interval=%v "+
-		"--worker-pool-size=%d --log-level=%s --listen-addr=%d, --debug-port=%d",
+		"--worker-pool-size=%d --log-level=%s --listen-addr=%d, --api-listen-addr=%d, --api-token-path=%s, --debug-port=%d",
 		o.GardenerSecretPath, o.GardenerNamespace, o.ScrapeInterval,
-		o.WorkerPoolSize, o.LogLevel, o.ListenAddr, o.DebugPort)
+		o.WorkerPoolSize, o.LogLevel, o.ListenAddr, o.APIListenAddr, o.APITokenPath, o.DebugPort)
 }
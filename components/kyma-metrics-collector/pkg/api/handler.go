@@ -0,0 +1,179 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/history"
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	subAccountIDParam = "subAccountID"
+	runtimeIDParam    = "runtimeID"
+	fromQueryParam    = "from"
+	toQueryParam      = "to"
+	atQueryParam      = "at"
+)
+
+// RecordResponse is the cached record of a runtime, the kubeconfig is never exposed
+type RecordResponse struct {
+	SubAccountID string                  `json:"sub_account_id"`
+	RuntimeID    string                  `json:"runtime_id"`
+	ShootName    string                  `json:"shoot_name"`
+	Metric       *edp.ConsumptionMetrics `json:"metric"`
+}
+
+type HistoryResponse struct {
+	Items []history.Entry `json:"items"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Handler serves the consumption metrics computed and reported by KMC to the clients presenting the bearer token
+type Handler struct {
+	Cache        *gocache.Cache
+	RuntimeIndex *kmccache.RuntimeIndex
+	History      *history.History
+	Token        string
+	Logger       *zap.SugaredLogger
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.Use(h.authenticate)
+	router.HandleFunc(fmt.Sprintf("/api/v1/subaccounts/{%s}/record", subAccountIDParam), h.getSubAccountRecord).Methods(http.MethodGet)
+	router.HandleFunc(fmt.Sprintf("/api/v1/subaccounts/{%s}/metrics", subAccountIDParam), h.getSubAccountMetrics).Methods(http.MethodGet)
+	router.HandleFunc(fmt.Sprintf("/api/v1/runtimes/{%s}/record", runtimeIDParam), h.getRuntimeRecord).Methods(http.MethodGet)
+	router.HandleFunc(fmt.Sprintf("/api/v1/runtimes/{%s}/metrics", runtimeIDParam), h.getRuntimeMetrics).Methods(http.MethodGet)
+}
+
+func (h *Handler) getSubAccountRecord(w http.ResponseWriter, req *http.Request) {
+	subAccountID := mux.Vars(req)[subAccountIDParam]
+	obj, found := h.Cache.Get(subAccountID)
+	if !found {
+		h.writeError(w, http.StatusNotFound, fmt.Errorf("subaccount %s is not tracked", subAccountID))
+		return
+	}
+	record, ok := obj.(kmccache.Record)
+	if !ok {
+		h.writeError(w, http.StatusInternalServerError, fmt.Errorf("bad item from cache, could not cast to a record obj"))
+		return
+	}
+	h.writeResponse(w, http.StatusOK, newRecordResponse(record))
+}
+
+func (h *Handler) getRuntimeRecord(w http.ResponseWriter, req *http.Request) {
+	runtimeID := mux.Vars(req)[runtimeIDParam]
+	if subAccountID, found := h.RuntimeIndex.SubAccountID(runtimeID); found {
+		obj, found := h.Cache.Get(subAccountID)
+		if record, ok := obj.(kmccache.Record); found && ok && record.RuntimeID == runtimeID {
+			h.writeResponse(w, http.StatusOK, newRecordResponse(record))
+			return
+		}
+	}
+	h.writeError(w, http.StatusNotFound, fmt.Errorf("runtime %s is not tracked", runtimeID))
+}
+
+func (h *Handler) getSubAccountMetrics(w http.ResponseWriter, req *http.Request) {
+	subAccountID := mux.Vars(req)[subAccountIDParam]
+	query := req.URL.Query()
+
+	if query.Get(atQueryParam) != "" {
+		at, err := time.Parse(time.RFC3339, query.Get(atQueryParam))
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s query parameter: %v", atQueryParam, err))
+			return
+		}
+		entry, found := h.History.At(subAccountID, at)
+		if !found {
+			h.writeError(w, http.StatusNotFound, fmt.Errorf("no metric reported for subaccount %s at %s", subAccountID, at.Format(time.RFC3339)))
+			return
+		}
+		h.writeResponse(w, http.StatusOK, HistoryResponse{Items: []history.Entry{entry}})
+		return
+	}
+
+	from, to, err := parseTimeRange(req)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	h.writeResponse(w, http.StatusOK, HistoryResponse{Items: h.History.Get(subAccountID, from, to)})
+}
+
+func (h *Handler) getRuntimeMetrics(w http.ResponseWriter, req *http.Request) {
+	runtimeID := mux.Vars(req)[runtimeIDParam]
+	from, to, err := parseTimeRange(req)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	h.writeResponse(w, http.StatusOK, HistoryResponse{Items: h.History.GetByRuntime(runtimeID, from, to)})
+}
+
+// authenticate rejects the requests without the bearer token of the API
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if h.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) != 1 {
+			h.writeError(w, http.StatusUnauthorized, fmt.Errorf("missing or invalid bearer token"))
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func parseTimeRange(req *http.Request) (from, to time.Time, err error) {
+	query := req.URL.Query()
+	if value := query.Get(fromQueryParam); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			return from, to, fmt.Errorf("invalid %s query parameter: %v", fromQueryParam, err)
+		}
+	}
+	if value := query.Get(toQueryParam); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			return from, to, fmt.Errorf("invalid %s query parameter: %v", toQueryParam, err)
+		}
+	}
+	return from, to, nil
+}
+
+func newRecordResponse(record kmccache.Record) RecordResponse {
+	return RecordResponse{
+		SubAccountID: record.SubAccountID,
+		RuntimeID:    record.RuntimeID,
+		ShootName:    record.ShootName,
+		Metric:       record.Metric,
+	}
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, status int, err error) {
+	if status >= http.StatusInternalServerError {
+		h.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("serve API request")
+	}
+	h.writeResponse(w, status, ErrorResponse{Error: err.Error()})
+}
+
+func (h *Handler) writeResponse(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("write API response")
+	}
+}
+
+func (h *Handler) namedLogger() *zap.SugaredLogger {
+	return h.Logger.With("component", "api")
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap/zapcore"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/history"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	subAccountID = "sa-1"
+	runtimeID    = "rt-1"
+	token        = "api-token"
+)
+
+func newTestRouter(t *testing.T) *mux.Router {
+	cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+	metric := &edp.ConsumptionMetrics{
+		SubAccountId: subAccountID,
+		RuntimeId:    runtimeID,
+		ShootName:    "shoot-1",
+		Compute:      edp.Compute{ProvisionedCpus: 4},
+	}
+	err := cache.Add(subAccountID, kmccache.Record{
+		SubAccountID: subAccountID,
+		RuntimeID:    runtimeID,
+		ShootName:    "shoot-1",
+		KubeConfig:   "secret-kubeconfig",
+		Metric:       metric,
+	}, gocache.NoExpiration)
+	if err != nil {
+		t.Fatalf("failed to populate cache: %v", err)
+	}
+
+	reportedHistory := history.New(history.Config{Window: time.Hour, MaxEntries: 10})
+	reportedHistory.Add(subAccountID, *metric, history.DeliverySpooled)
+
+	runtimeIndex := kmccache.NewRuntimeIndex()
+	runtimeIndex.Set(runtimeID, subAccountID)
+	runtimeIndex.Set("rt-gone", subAccountID)
+
+	handler := Handler{
+		Cache:        cache,
+		RuntimeIndex: runtimeIndex,
+		History:      reportedHistory,
+		Token:        token,
+		Logger:       logger.NewLogger(zapcore.InfoLevel),
+	}
+	router := mux.NewRouter()
+	handler.AttachRoutes(router)
+	return router
+}
+
+func doRequest(router *mux.Router, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+	return rw
+}
+
+func TestRecord(t *testing.T) {
+	router := newTestRouter(t)
+
+	for _, path := range []string{
+		fmt.Sprintf("/api/v1/subaccounts/%s/record", subAccountID),
+		fmt.Sprintf("/api/v1/runtimes/%s/record", runtimeID),
+	} {
+		t.Run(path, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			rw := doRequest(router, path)
+			g.Expect(rw.Code).Should(gomega.Equal(http.StatusOK))
+			g.Expect(rw.Body.String()).ShouldNot(gomega.ContainSubstring("secret-kubeconfig"))
+
+			var response RecordResponse
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).Should(gomega.Succeed())
+			g.Expect(response.SubAccountID).Should(gomega.Equal(subAccountID))
+			g.Expect(response.RuntimeID).Should(gomega.Equal(runtimeID))
+			g.Expect(response.Metric.Compute.ProvisionedCpus).Should(gomega.Equal(4))
+		})
+	}
+
+	t.Run("not tracked", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		g.Expect(doRequest(router, "/api/v1/subaccounts/unknown/record").Code).Should(gomega.Equal(http.StatusNotFound))
+		g.Expect(doRequest(router, "/api/v1/runtimes/unknown/record").Code).Should(gomega.Equal(http.StatusNotFound))
+		g.Expect(doRequest(router, "/api/v1/runtimes/rt-gone/record").Code).Should(gomega.Equal(http.StatusNotFound))
+	})
+}
+
+func TestAuthentication(t *testing.T) {
+	router := newTestRouter(t)
+	path := fmt.Sprintf("/api/v1/subaccounts/%s/record", subAccountID)
+
+	for name, header := range map[string]string{
+		"without token":    "",
+		"with wrong token": "Bearer wrong",
+		"with raw token":   token + "x",
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+			g.Expect(rw.Code).Should(gomega.Equal(http.StatusUnauthorized))
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	router := newTestRouter(t)
+	now := time.Now()
+
+	testCases := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedItems  int
+	}{
+		{
+			name:           "subaccount history",
+			path:           fmt.Sprintf("/api/v1/subaccounts/%s/metrics", subAccountID),
+			expectedStatus: http.StatusOK,
+			expectedItems:  1,
+		},
+		{
+			name:           "runtime history",
+			path:           fmt.Sprintf("/api/v1/runtimes/%s/metrics", runtimeID),
+			expectedStatus: http.StatusOK,
+			expectedItems:  1,
+		},
+		{
+			name:           "subaccount history in the future",
+			path:           fmt.Sprintf("/api/v1/subaccounts/%s/metrics?from=%s", subAccountID, url.QueryEscape(now.Add(time.Hour).Format(time.RFC3339))),
+			expectedStatus: http.StatusOK,
+			expectedItems:  0,
+		},
+		{
+			name:           "subaccount metric at a time",
+			path:           fmt.Sprintf("/api/v1/subaccounts/%s/metrics?at=%s", subAccountID, url.QueryEscape(now.Add(time.Minute).Format(time.RFC3339))),
+			expectedStatus: http.StatusOK,
+			expectedItems:  1,
+		},
+		{
+			name:           "subaccount metric before the first report",
+			path:           fmt.Sprintf("/api/v1/subaccounts/%s/metrics?at=%s", subAccountID, url.QueryEscape(now.Add(-time.Hour).Format(time.RFC3339))),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid time",
+			path:           fmt.Sprintf("/api/v1/runtimes/%s/metrics?to=yesterday", runtimeID),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			rw := doRequest(router, tc.path)
+			g.Expect(rw.Code).Should(gomega.Equal(tc.expectedStatus))
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response HistoryResponse
+			g.Expect(json.Unmarshal(rw.Body.Bytes(), &response)).Should(gomega.Succeed())
+			g.Expect(response.Items).Should(gomega.HaveLen(tc.expectedItems))
+			for _, item := range response.Items {
+				g.Expect(item.Metric.SubAccountId).Should(gomega.Equal(subAccountID))
+				g.Expect(item.Delivery).Should(gomega.Equal(history.DeliverySpooled))
+			}
+		})
+	}
+}
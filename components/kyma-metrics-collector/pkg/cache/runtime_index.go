@@ -0,0 +1,43 @@
+package cache
+
+import "sync"
+
+// RuntimeIndex maps the runtime IDs to the subaccount IDs under which the records of the runtimes are cached.
+// A nil index ignores the updates and finds nothing.
+type RuntimeIndex struct {
+	mu          sync.RWMutex
+	subAccounts map[string]string
+}
+
+func NewRuntimeIndex() *RuntimeIndex {
+	return &RuntimeIndex{subAccounts: make(map[string]string)}
+}
+
+func (i *RuntimeIndex) Set(runtimeID, subAccountID string) {
+	if i == nil || runtimeID == "" {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.subAccounts[runtimeID] = subAccountID
+}
+
+func (i *RuntimeIndex) Delete(runtimeID string) {
+	if i == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.subAccounts, runtimeID)
+}
+
+// SubAccountID returns the subaccount ID of the runtime, the cached record can belong to another runtime by now
+func (i *RuntimeIndex) SubAccountID(runtimeID string) (string, bool) {
+	if i == nil {
+		return "", false
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	subAccountID, found := i.subAccounts[runtimeID]
+	return subAccountID, found
+}
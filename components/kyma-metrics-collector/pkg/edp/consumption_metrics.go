@@ -1,5 +1,12 @@
 package edp
 
+const (
+	// SchemaVersion1 is the payload with compute and networking data
+	SchemaVersion1 = 1
+	// SchemaVersion2 adds the optional storage, NAT gateways and load balancers data
+	SchemaVersion2 = 2
+)
+
 type ConsumptionMetrics struct {
 	RuntimeId    string     `json:"runtime_id" validate:"required"`
 	SubAccountId string     `json:"sub_account_id" validate:"required"`
@@ -7,10 +14,23 @@ type ConsumptionMetrics struct {
 	Timestamp    string     `json:"timestamp" validate:"required"`
 	Compute      Compute    `json:"compute" validate:"required"`
 	Networking   Networking `json:"networking" validate:"required"`
+	Storage      *Storage   `json:"storage,omitempty"`
 }
 type Networking struct {
-	ProvisionedVnets int `json:"provisioned_vnets" validate:"numeric"`
-	ProvisionedIPs   int `json:"provisioned_ips" validate:"numeric"`
+	ProvisionedVnets int            `json:"provisioned_vnets" validate:"numeric"`
+	ProvisionedIPs   int            `json:"provisioned_ips" validate:"numeric"`
+	NATGateways      *int           `json:"nat_gateways,omitempty" validate:"omitempty,numeric"`
+	LoadBalancers    []LoadBalancer `json:"load_balancers,omitempty"`
+}
+
+type LoadBalancer struct {
+	Type  string `json:"type" validate:"required"`
+	Count int    `json:"count" validate:"numeric"`
+}
+
+type Storage struct {
+	ObjectStorageBuckets int `json:"object_storage_buckets" validate:"numeric"`
+	RedisInstances       int `json:"redis_instances" validate:"numeric"`
 }
 
 type VMType struct {
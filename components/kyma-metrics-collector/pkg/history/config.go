@@ -0,0 +1,12 @@
+package history
+
+import "time"
+
+type Config struct {
+	// Window is how long the reported metrics are kept
+	Window time.Duration `envconfig:"KMC_HISTORY_WINDOW" default:"24h"`
+	// MaxEntries is the maximum number of reported metrics kept per subaccount
+	MaxEntries int `envconfig:"KMC_HISTORY_MAX_ENTRIES" default:"500"`
+	// MaxTotalEntries is the maximum number of reported metrics kept for all subaccounts, the oldest are dropped first
+	MaxTotalEntries int `envconfig:"KMC_HISTORY_MAX_TOTAL_ENTRIES" default:"100000"`
+}
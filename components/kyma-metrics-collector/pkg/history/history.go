@@ -0,0 +1,166 @@
+package history
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+)
+
+// pruneInterval is how often the entries of all subaccounts are checked against the window
+const pruneInterval = time.Minute
+
+// Delivery is the state of the payload of a reported metric
+type Delivery string
+
+const (
+	// DeliverySent means that EDP accepted the payload
+	DeliverySent Delivery = "sent"
+	// DeliverySpooled means that the payload is stored in the spool until EDP accepts it
+	DeliverySpooled Delivery = "spooled"
+)
+
+// Entry is a consumption metric as it was reported to EDP
+type Entry struct {
+	ReportedAt time.Time              `json:"reported_at"`
+	Delivery   Delivery               `json:"delivery"`
+	Metric     edp.ConsumptionMetrics `json:"metric"`
+}
+
+// History keeps the reported consumption metrics of each subaccount for a rolling window
+type History struct {
+	config    Config
+	mu        sync.RWMutex
+	entries   map[string][]Entry
+	total     int
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func New(config Config) *History {
+	return &History{
+		config:  config,
+		entries: make(map[string][]Entry),
+		now:     time.Now,
+	}
+}
+
+// Add records the metric reported for the subaccount and the delivery state of its payload
+func (h *History) Add(subAccountID string, metric edp.ConsumptionMetrics, delivery Delivery) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	entries := append(h.entries[subAccountID], Entry{
+		ReportedAt: now,
+		Delivery:   delivery,
+		Metric:     metric,
+	})
+	if h.config.MaxEntries > 0 && len(entries) > h.config.MaxEntries {
+		entries = entries[len(entries)-h.config.MaxEntries:]
+	}
+	h.set(subAccountID, h.withinWindow(entries, now))
+
+	if now.Sub(h.lastPrune) >= pruneInterval {
+		h.prune(now)
+	}
+	if h.config.MaxTotalEntries > 0 {
+		for h.total > h.config.MaxTotalEntries {
+			h.dropOldest()
+		}
+	}
+}
+
+// Get returns the metrics reported for the subaccount between from and to, both inclusive and optional
+func (h *History) Get(subAccountID string, from, to time.Time) []Entry {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.filter(h.entries[subAccountID], func(entry Entry) bool { return true }, from, to)
+}
+
+// GetByRuntime returns the metrics reported for the runtime between from and to, both inclusive and optional
+func (h *History) GetByRuntime(runtimeID string, from, to time.Time) []Entry {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result := make([]Entry, 0)
+	for _, entries := range h.entries {
+		result = append(result, h.filter(entries, func(entry Entry) bool {
+			return entry.Metric.RuntimeId == runtimeID
+		}, from, to)...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ReportedAt.Before(result[j].ReportedAt)
+	})
+	return result
+}
+
+// At returns the last metric reported for the subaccount at or before the given time
+func (h *History) At(subAccountID string, at time.Time) (Entry, bool) {
+	entries := h.Get(subAccountID, time.Time{}, at)
+	if len(entries) == 0 {
+		return Entry{}, false
+	}
+	return entries[len(entries)-1], true
+}
+
+func (h *History) filter(entries []Entry, match func(Entry) bool, from, to time.Time) []Entry {
+	cutoff := h.now().Add(-h.config.Window)
+	result := make([]Entry, 0)
+	for _, entry := range entries {
+		if entry.ReportedAt.Before(cutoff) || !match(entry) {
+			continue
+		}
+		if !from.IsZero() && entry.ReportedAt.Before(from) {
+			continue
+		}
+		if !to.IsZero() && entry.ReportedAt.After(to) {
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// prune drops the entries outside of the window, including the subaccounts which are not reported anymore
+func (h *History) prune(now time.Time) {
+	for subAccountID, entries := range h.entries {
+		h.set(subAccountID, h.withinWindow(entries, now))
+	}
+	h.lastPrune = now
+}
+
+// dropOldest drops the oldest entry of all subaccounts
+func (h *History) dropOldest() {
+	oldest := ""
+	for subAccountID, entries := range h.entries {
+		if oldest == "" || entries[0].ReportedAt.Before(h.entries[oldest][0].ReportedAt) {
+			oldest = subAccountID
+		}
+	}
+	if oldest == "" {
+		return
+	}
+	h.set(oldest, h.entries[oldest][1:])
+}
+
+// set replaces the entries of the subaccount and keeps the total number of entries
+func (h *History) set(subAccountID string, entries []Entry) {
+	h.total += len(entries) - len(h.entries[subAccountID])
+	if len(entries) == 0 {
+		delete(h.entries, subAccountID)
+		return
+	}
+	h.entries[subAccountID] = entries
+}
+
+// withinWindow relies on the entries being ordered by the report time
+func (h *History) withinWindow(entries []Entry, now time.Time) []Entry {
+	cutoff := now.Add(-h.config.Window)
+	i := sort.Search(len(entries), func(i int) bool {
+		return !entries[i].ReportedAt.Before(cutoff)
+	})
+	return entries[i:]
+}
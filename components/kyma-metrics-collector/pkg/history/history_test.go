@@ -0,0 +1,105 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+)
+
+func newMetric(subAccountID, runtimeID string, cpus int) edp.ConsumptionMetrics {
+	return edp.ConsumptionMetrics{
+		SubAccountId: subAccountID,
+		RuntimeId:    runtimeID,
+		Compute:      edp.Compute{ProvisionedCpus: cpus},
+	}
+}
+
+func TestHistory(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newHistory := func(config Config) (*History, *time.Time) {
+		now := start
+		h := New(config)
+		h.now = func() time.Time { return now }
+		return h, &now
+	}
+
+	t.Run("should return the metrics reported in a time range", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		h, now := newHistory(Config{Window: time.Hour, MaxEntries: 10})
+		for i := 0; i < 3; i++ {
+			h.Add("sa-1", newMetric("sa-1", "rt-1", i), DeliverySent)
+			h.Add("sa-2", newMetric("sa-2", "rt-2", 10+i), DeliverySent)
+			*now = now.Add(10 * time.Minute)
+		}
+
+		entries := h.Get("sa-1", time.Time{}, time.Time{})
+		g.Expect(entries).Should(gomega.HaveLen(3))
+		g.Expect(entries[0].Metric.Compute.ProvisionedCpus).Should(gomega.Equal(0))
+		g.Expect(entries[0].ReportedAt).Should(gomega.Equal(start))
+
+		entries = h.Get("sa-1", start.Add(5*time.Minute), start.Add(20*time.Minute))
+		g.Expect(entries).Should(gomega.HaveLen(2))
+		g.Expect(entries[0].Metric.Compute.ProvisionedCpus).Should(gomega.Equal(1))
+		g.Expect(entries[1].Metric.Compute.ProvisionedCpus).Should(gomega.Equal(2))
+
+		entries = h.GetByRuntime("rt-2", time.Time{}, time.Time{})
+		g.Expect(entries).Should(gomega.HaveLen(3))
+		g.Expect(entries[2].Metric.Compute.ProvisionedCpus).Should(gomega.Equal(12))
+
+		g.Expect(h.Get("unknown", time.Time{}, time.Time{})).Should(gomega.BeEmpty())
+	})
+
+	t.Run("should return the metric reported at a given time", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		h, now := newHistory(Config{Window: time.Hour, MaxEntries: 10})
+		h.Add("sa-1", newMetric("sa-1", "rt-1", 1), DeliverySent)
+		*now = now.Add(10 * time.Minute)
+		h.Add("sa-1", newMetric("sa-1", "rt-1", 2), DeliverySent)
+
+		entry, found := h.At("sa-1", start.Add(5*time.Minute))
+		g.Expect(found).Should(gomega.BeTrue())
+		g.Expect(entry.Metric.Compute.ProvisionedCpus).Should(gomega.Equal(1))
+
+		_, found = h.At("sa-1", start.Add(-time.Minute))
+		g.Expect(found).Should(gomega.BeFalse())
+	})
+
+	t.Run("should keep the metrics within the bounds", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		h, now := newHistory(Config{Window: 30 * time.Minute, MaxEntries: 2})
+		h.Add("sa-gone", newMetric("sa-gone", "rt-gone", 1), DeliverySent)
+		for i := 0; i < 5; i++ {
+			h.Add("sa-1", newMetric("sa-1", "rt-1", i), DeliverySent)
+		}
+		g.Expect(h.Get("sa-1", time.Time{}, time.Time{})).Should(gomega.HaveLen(2))
+
+		*now = now.Add(time.Hour)
+		h.Add("sa-1", newMetric("sa-1", "rt-1", 5), DeliverySent)
+		entries := h.Get("sa-1", time.Time{}, time.Time{})
+		g.Expect(entries).Should(gomega.HaveLen(1))
+		g.Expect(entries[0].Metric.Compute.ProvisionedCpus).Should(gomega.Equal(5))
+		g.Expect(h.entries).ShouldNot(gomega.HaveKey("sa-gone"))
+	})
+
+	t.Run("should drop the oldest metrics of all subaccounts above the total bound", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		h, now := newHistory(Config{Window: time.Hour, MaxEntries: 10, MaxTotalEntries: 3})
+		h.Add("sa-1", newMetric("sa-1", "rt-1", 1), DeliverySent)
+		*now = now.Add(time.Minute)
+		h.Add("sa-2", newMetric("sa-2", "rt-2", 2), DeliverySent)
+		*now = now.Add(time.Minute)
+		h.Add("sa-2", newMetric("sa-2", "rt-2", 3), DeliverySpooled)
+		*now = now.Add(time.Minute)
+		h.Add("sa-3", newMetric("sa-3", "rt-3", 4), DeliverySent)
+
+		g.Expect(h.entries).ShouldNot(gomega.HaveKey("sa-1"))
+		g.Expect(h.total).Should(gomega.Equal(3))
+		entries := h.Get("sa-2", time.Time{}, time.Time{})
+		g.Expect(entries).Should(gomega.HaveLen(2))
+		g.Expect(entries[1].Delivery).Should(gomega.Equal(DeliverySpooled))
+	})
+}
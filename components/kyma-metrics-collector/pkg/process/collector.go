@@ -0,0 +1,167 @@
+package process
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+	skrnode "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/node"
+	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
+	skrserviceinstance "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/serviceinstance"
+	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
+)
+
+// CollectorInput contains the runtime data shared by all collectors
+type CollectorInput struct {
+	Shoot      *gardencorev1beta1.Shoot
+	KubeConfig string
+
+	// shared holds the SKR resources listed by one collector for the others, set for every run of the registry
+	shared *sharedResources
+}
+
+type sharedResources struct {
+	svcList *corev1.ServiceList
+}
+
+// services lists the services of the runtime once per run of the registry
+func (i CollectorInput) services(ctx context.Context, svcConfig skrsvc.ConfigInf) (*corev1.ServiceList, error) {
+	if i.shared != nil && i.shared.svcList != nil {
+		return i.shared.svcList, nil
+	}
+
+	svcClient, err := svcConfig.NewClient(i.KubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	svcList, err := svcClient.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if i.shared != nil {
+		i.shared.svcList = svcList
+	}
+
+	return svcList, nil
+}
+
+// Collector gathers one group of measurements of a runtime and writes it to the consumption metric
+type Collector interface {
+	Name() string
+	// SchemaVersion is the EDP payload schema version which introduced the fields filled by the collector
+	SchemaVersion() int
+	// Optional collectors may fail without failing the metric, their data is missing from the payload then
+	Optional() bool
+	Collect(ctx context.Context, input CollectorInput, metric *edp.ConsumptionMetrics) error
+}
+
+// CollectorsConfig contains the enablement flags and settings of the optional collectors.
+// The core collector which fills the compute and networking data is always enabled.
+type CollectorsConfig struct {
+	LoadBalancersEnabled    bool     `envconfig:"KMC_COLLECTOR_LOAD_BALANCERS_ENABLED" default:"false"`
+	NATGatewaysEnabled      bool     `envconfig:"KMC_COLLECTOR_NAT_GATEWAYS_ENABLED" default:"false"`
+	ServiceInstancesEnabled bool     `envconfig:"KMC_COLLECTOR_SERVICE_INSTANCES_ENABLED" default:"false"`
+	ObjectStorageOfferings  []string `envconfig:"KMC_COLLECTOR_OBJECT_STORAGE_OFFERINGS" default:"objectstore"`
+	RedisOfferings          []string `envconfig:"KMC_COLLECTOR_REDIS_OFFERINGS" default:"redis-cache"`
+}
+
+// SKRConfigs contains the factories of the SKR clients used by the collectors
+type SKRConfigs struct {
+	NodeConfig            skrnode.ConfigInf
+	PVCConfig             skrpvc.ConfigInf
+	SvcConfig             skrsvc.ConfigInf
+	ServiceInstanceConfig skrserviceinstance.ConfigInf
+}
+
+// CollectorRegistry runs the registered collectors in the registration order
+type CollectorRegistry struct {
+	collectors []Collector
+	logger     *zap.SugaredLogger
+}
+
+func NewCollectorRegistry(logger *zap.SugaredLogger) *CollectorRegistry {
+	return &CollectorRegistry{logger: logger}
+}
+
+// NewCollectorRegistryFromConfig registers the core collector and the enabled optional collectors
+func NewCollectorRegistryFromConfig(config *CollectorsConfig, providers *Providers, skrConfigs SKRConfigs, logger *zap.SugaredLogger) (*CollectorRegistry, error) {
+	registry := NewCollectorRegistry(logger)
+
+	collectors := []Collector{
+		newCoreCollector(providers, skrConfigs.NodeConfig, skrConfigs.PVCConfig, skrConfigs.SvcConfig),
+	}
+	if config.LoadBalancersEnabled {
+		collectors = append(collectors, newLoadBalancersCollector(skrConfigs.SvcConfig))
+	}
+	if config.NATGatewaysEnabled {
+		collectors = append(collectors, newNATGatewaysCollector())
+	}
+	if config.ServiceInstancesEnabled {
+		collectors = append(collectors, newServiceInstancesCollector(skrConfigs.ServiceInstanceConfig, config.ObjectStorageOfferings, config.RedisOfferings))
+	}
+
+	for _, collector := range collectors {
+		if err := registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+func (r *CollectorRegistry) Register(collector Collector) error {
+	for _, registered := range r.collectors {
+		if registered.Name() == collector.Name() {
+			return fmt.Errorf("collector %s is already registered", collector.Name())
+		}
+	}
+	r.collectors = append(r.collectors, collector)
+
+	return nil
+}
+
+func (r *CollectorRegistry) Names() []string {
+	names := make([]string, 0, len(r.collectors))
+	for _, collector := range r.collectors {
+		names = append(names, collector.Name())
+	}
+	return names
+}
+
+// SchemaVersion returns the EDP payload schema version required by the registered collectors
+func (r *CollectorRegistry) SchemaVersion() int {
+	version := edp.SchemaVersion1
+	for _, collector := range r.collectors {
+		if collector.SchemaVersion() > version {
+			version = collector.SchemaVersion()
+		}
+	}
+	return version
+}
+
+// Collect runs all collectors and fails if a required collector fails. The failure of an optional collector is logged
+// and the metric is sent without its data.
+func (r *CollectorRegistry) Collect(ctx context.Context, input CollectorInput) (*edp.ConsumptionMetrics, error) {
+	metric := new(edp.ConsumptionMetrics)
+	input.shared = &sharedResources{}
+
+	for _, collector := range r.collectors {
+		if err := collector.Collect(ctx, input, metric); err != nil {
+			collectorFailures.WithLabelValues(collector.Name()).Inc()
+			if !collector.Optional() {
+				return nil, errors.Wrapf(err, "failed to collect %s metrics", collector.Name())
+			}
+			r.logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				Warnf("Failed to collect %s metrics, sending the metric without them", collector.Name())
+		}
+	}
+	metric.Timestamp = getTimestampNow()
+
+	return metric, nil
+}
@@ -0,0 +1,292 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	gardenerawsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	gardenerazurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/env"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+	skrnode "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/node"
+	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
+	skrserviceinstance "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/serviceinstance"
+	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
+	kmctesting "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/testing"
+)
+
+type fakeCollector struct {
+	name          string
+	schemaVersion int
+	optional      bool
+	err           error
+}
+
+func (c fakeCollector) Name() string {
+	return c.name
+}
+
+func (c fakeCollector) SchemaVersion() int {
+	return c.schemaVersion
+}
+
+func (c fakeCollector) Optional() bool {
+	return c.optional
+}
+
+func (c fakeCollector) Collect(_ context.Context, _ CollectorInput, metric *edp.ConsumptionMetrics) error {
+	if c.err != nil {
+		return c.err
+	}
+	metric.Compute.ProvisionedCpus += 1
+	return nil
+}
+
+// countingSvcConfig counts the created service clients
+type countingSvcConfig struct {
+	skrsvc.FakeSvcClient
+	clients *int
+}
+
+func (c countingSvcConfig) NewClient(kubeconfig string) (*skrsvc.Client, error) {
+	*c.clients += 1
+	return c.FakeSvcClient.NewClient(kubeconfig)
+}
+
+func TestCollectorRegistry(t *testing.T) {
+	t.Run("should run collectors in the registration order", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		registry := NewCollectorRegistry(logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(registry.Register(fakeCollector{name: "foo", schemaVersion: edp.SchemaVersion1})).Should(gomega.Succeed())
+		g.Expect(registry.Register(fakeCollector{name: "bar", schemaVersion: edp.SchemaVersion2})).Should(gomega.Succeed())
+
+		metric, err := registry.Collect(context.Background(), CollectorInput{})
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(metric.Compute.ProvisionedCpus).Should(gomega.Equal(2))
+		g.Expect(metric.Timestamp).ShouldNot(gomega.BeEmpty())
+		g.Expect(registry.Names()).Should(gomega.Equal([]string{"foo", "bar"}))
+		g.Expect(registry.SchemaVersion()).Should(gomega.Equal(edp.SchemaVersion2))
+	})
+
+	t.Run("should not register a collector twice", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		registry := NewCollectorRegistry(logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(registry.Register(fakeCollector{name: "foo"})).Should(gomega.Succeed())
+		g.Expect(registry.Register(fakeCollector{name: "foo"})).ShouldNot(gomega.Succeed())
+	})
+
+	t.Run("should fail when a collector fails", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		registry := NewCollectorRegistry(logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(registry.Register(fakeCollector{name: "foo"})).Should(gomega.Succeed())
+		g.Expect(registry.Register(fakeCollector{name: "bar", err: fmt.Errorf("boom")})).Should(gomega.Succeed())
+
+		metric, err := registry.Collect(context.Background(), CollectorInput{})
+		g.Expect(err).ShouldNot(gomega.BeNil())
+		g.Expect(err.Error()).Should(gomega.ContainSubstring("bar"))
+		g.Expect(metric).Should(gomega.BeNil())
+	})
+
+	t.Run("should keep the metric when an optional collector fails", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		registry := NewCollectorRegistry(logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(registry.Register(fakeCollector{name: "foo"})).Should(gomega.Succeed())
+		g.Expect(registry.Register(fakeCollector{name: "bar", optional: true, err: fmt.Errorf("boom")})).Should(gomega.Succeed())
+
+		metric, err := registry.Collect(context.Background(), CollectorInput{})
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(metric.Compute.ProvisionedCpus).Should(gomega.Equal(1))
+	})
+
+	t.Run("should default to the first schema version", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		g.Expect(NewCollectorRegistry(logger.NewLogger(zapcore.InfoLevel)).SchemaVersion()).Should(gomega.Equal(edp.SchemaVersion1))
+	})
+}
+
+func TestNewCollectorRegistryFromConfig(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	providersData, err := kmctesting.LoadFixtureFromFile(providersFile)
+	g.Expect(err).Should(gomega.BeNil())
+	providers, err := LoadPublicCloudSpecs(&env.Config{PublicCloudSpecs: string(providersData)})
+	g.Expect(err).Should(gomega.BeNil())
+
+	skrConfigs := SKRConfigs{
+		NodeConfig:            skrnode.FakeNodeClient{},
+		PVCConfig:             skrpvc.FakePVCClient{},
+		SvcConfig:             skrsvc.FakeSvcClient{},
+		ServiceInstanceConfig: skrserviceinstance.FakeServiceInstanceClient{},
+	}
+	input := CollectorInput{
+		Shoot: kmctesting.GetShoot("testShoot", kmctesting.WithAzureProviderAndStandardD8V3VMs),
+	}
+
+	t.Run("should register only the core collector by default", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		registry, err := NewCollectorRegistryFromConfig(&CollectorsConfig{}, providers, skrConfigs, logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(registry.Names()).Should(gomega.Equal([]string{CoreCollectorName}))
+		g.Expect(registry.SchemaVersion()).Should(gomega.Equal(edp.SchemaVersion1))
+
+		metric, err := registry.Collect(context.Background(), input)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(metric.Compute).Should(gomega.Equal(NewMetric().Compute))
+		g.Expect(metric.Networking).Should(gomega.Equal(NewMetric().Networking))
+		g.Expect(metric.Storage).Should(gomega.BeNil())
+	})
+
+	t.Run("should register the enabled collectors", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		config := &CollectorsConfig{
+			LoadBalancersEnabled:    true,
+			NATGatewaysEnabled:      true,
+			ServiceInstancesEnabled: true,
+			ObjectStorageOfferings:  []string{"objectstore"},
+			RedisOfferings:          []string{"redis-cache"},
+		}
+		registry, err := NewCollectorRegistryFromConfig(config, providers, skrConfigs, logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(registry.Names()).Should(gomega.Equal([]string{
+			CoreCollectorName, LoadBalancersCollectorName, NATGatewaysCollectorName, ServiceInstancesCollectorName,
+		}))
+		g.Expect(registry.SchemaVersion()).Should(gomega.Equal(edp.SchemaVersion2))
+
+		metric, err := registry.Collect(context.Background(), input)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(metric.Compute).Should(gomega.Equal(NewMetric().Compute))
+		g.Expect(metric.Networking.ProvisionedIPs).Should(gomega.Equal(2))
+		g.Expect(metric.Networking.LoadBalancers).Should(gomega.Equal([]edp.LoadBalancer{
+			{Type: LoadBalancerTypeExternal, Count: 2},
+			{Type: LoadBalancerTypeInternal, Count: 0},
+		}))
+		g.Expect(metric.Networking.NATGateways).ShouldNot(gomega.BeNil())
+		g.Expect(*metric.Networking.NATGateways).Should(gomega.Equal(0))
+		g.Expect(metric.Storage).Should(gomega.Equal(&edp.Storage{
+			ObjectStorageBuckets: 2,
+			RedisInstances:       1,
+		}))
+	})
+
+	t.Run("should list the services once for all collectors", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		svcClients := 0
+		configs := skrConfigs
+		configs.SvcConfig = countingSvcConfig{clients: &svcClients}
+		registry, err := NewCollectorRegistryFromConfig(&CollectorsConfig{LoadBalancersEnabled: true}, providers, configs, logger.NewLogger(zapcore.InfoLevel))
+		g.Expect(err).Should(gomega.BeNil())
+
+		_, err = registry.Collect(context.Background(), input)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(svcClients).Should(gomega.Equal(1))
+
+		_, err = registry.Collect(context.Background(), input)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(svcClients).Should(gomega.Equal(2))
+	})
+}
+
+func TestCountNATGateways(t *testing.T) {
+	testCases := []struct {
+		name         string
+		providerType string
+		infraConfig  interface{}
+		expected     int
+		expectedErr  bool
+	}{
+		{
+			name:         "azure with regional and zonal NAT gateways",
+			providerType: Azure,
+			infraConfig: &gardenerazurev1alpha1.InfrastructureConfig{
+				TypeMeta: metaV1.TypeMeta{APIVersion: gardenerazurev1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+				Networks: gardenerazurev1alpha1.NetworkConfig{
+					NatGateway: &gardenerazurev1alpha1.NatGatewayConfig{Enabled: true},
+					Zones: []gardenerazurev1alpha1.Zone{
+						{Name: 1, NatGateway: &gardenerazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+						{Name: 2, NatGateway: &gardenerazurev1alpha1.ZonedNatGatewayConfig{Enabled: false}},
+						{Name: 3},
+					},
+				},
+			},
+			expected: 2,
+		},
+		{
+			name:         "aws with three zones",
+			providerType: AWS,
+			infraConfig: &gardenerawsv1alpha1.InfrastructureConfig{
+				TypeMeta: metaV1.TypeMeta{APIVersion: gardenerawsv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+				Networks: gardenerawsv1alpha1.Networks{
+					Zones: []gardenerawsv1alpha1.Zone{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+				},
+			},
+			expected: 3,
+		},
+		{
+			name:         "gcp",
+			providerType: GCP,
+			infraConfig:  map[string]string{},
+			expected:     1,
+		},
+		{
+			name:         "unknown provider",
+			providerType: "foo",
+			infraConfig:  map[string]string{},
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			raw, err := json.Marshal(tc.infraConfig)
+			g.Expect(err).Should(gomega.BeNil())
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Type:                 tc.providerType,
+						InfrastructureConfig: &runtime.RawExtension{Raw: raw},
+					},
+				},
+			}
+
+			natGateways, err := countNATGateways(shoot)
+			if tc.expectedErr {
+				g.Expect(err).ShouldNot(gomega.BeNil())
+				return
+			}
+			g.Expect(err).Should(gomega.BeNil())
+			g.Expect(natGateways).Should(gomega.Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsInternalLoadBalancer(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{name: "without annotations", expected: false},
+		{name: "aws internal", annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "0.0.0.0/0"}, expected: true},
+		{name: "azure internal", annotations: map[string]string{"service.beta.kubernetes.io/azure-load-balancer-internal": "true"}, expected: true},
+		{name: "azure external", annotations: map[string]string{"service.beta.kubernetes.io/azure-load-balancer-internal": "false"}, expected: false},
+		{name: "gcp internal", annotations: map[string]string{"networking.gke.io/load-balancer-type": "Internal"}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			svc := corev1.Service{ObjectMeta: metaV1.ObjectMeta{Annotations: tc.annotations}}
+			g.Expect(isInternalLoadBalancer(svc)).Should(gomega.Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardenerawsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	gardenerazurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	skrnode "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/node"
+	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
+	skrserviceinstance "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/serviceinstance"
+	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
+)
+
+const (
+	CoreCollectorName             = "core"
+	LoadBalancersCollectorName    = "load_balancers"
+	NATGatewaysCollectorName      = "nat_gateways"
+	ServiceInstancesCollectorName = "service_instances"
+
+	LoadBalancerTypeInternal = "internal"
+	LoadBalancerTypeExternal = "external"
+)
+
+// internalLoadBalancerAnnotations marks a LoadBalancer service as internal on the respective provider
+var internalLoadBalancerAnnotations = []string{
+	"service.beta.kubernetes.io/aws-load-balancer-internal",
+	"service.beta.kubernetes.io/azure-load-balancer-internal",
+	"networking.gke.io/load-balancer-type",
+	"cloud.google.com/load-balancer-type",
+}
+
+// coreCollector fills the compute and networking data of the schema version 1
+type coreCollector struct {
+	providers  *Providers
+	nodeConfig skrnode.ConfigInf
+	pvcConfig  skrpvc.ConfigInf
+	svcConfig  skrsvc.ConfigInf
+}
+
+func newCoreCollector(providers *Providers, nodeConfig skrnode.ConfigInf, pvcConfig skrpvc.ConfigInf, svcConfig skrsvc.ConfigInf) *coreCollector {
+	return &coreCollector{
+		providers:  providers,
+		nodeConfig: nodeConfig,
+		pvcConfig:  pvcConfig,
+		svcConfig:  svcConfig,
+	}
+}
+
+func (c *coreCollector) Name() string {
+	return CoreCollectorName
+}
+
+func (c *coreCollector) SchemaVersion() int {
+	return edp.SchemaVersion1
+}
+
+func (c *coreCollector) Optional() bool {
+	return false
+}
+
+func (c *coreCollector) Collect(ctx context.Context, input CollectorInput, metric *edp.ConsumptionMetrics) error {
+	// Get nodes
+	nodesClient, err := c.nodeConfig.NewClient(input.KubeConfig)
+	if err != nil {
+		return err
+	}
+	nodes, err := nodesClient.List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(nodes.Items) == 0 {
+		return fmt.Errorf("no nodes to process")
+	}
+
+	// Get PVCs
+	pvcClient, err := c.pvcConfig.NewClient(input.KubeConfig)
+	if err != nil {
+		return err
+	}
+	pvcList, err := pvcClient.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get Svcs
+	svcList, err := input.services(ctx, c.svcConfig)
+	if err != nil {
+		return err
+	}
+
+	parseInput := Input{
+		shoot:    input.Shoot,
+		nodeList: nodes,
+		pvcList:  pvcList,
+		svcList:  svcList,
+	}
+	parsed, err := parseInput.Parse(c.providers)
+	if err != nil {
+		return err
+	}
+	metric.Compute = parsed.Compute
+	metric.Networking.ProvisionedVnets = parsed.Networking.ProvisionedVnets
+	metric.Networking.ProvisionedIPs = parsed.Networking.ProvisionedIPs
+
+	return nil
+}
+
+// loadBalancersCollector counts the LoadBalancer services by their type
+type loadBalancersCollector struct {
+	svcConfig skrsvc.ConfigInf
+}
+
+func newLoadBalancersCollector(svcConfig skrsvc.ConfigInf) *loadBalancersCollector {
+	return &loadBalancersCollector{svcConfig: svcConfig}
+}
+
+func (c *loadBalancersCollector) Name() string {
+	return LoadBalancersCollectorName
+}
+
+func (c *loadBalancersCollector) SchemaVersion() int {
+	return edp.SchemaVersion2
+}
+
+func (c *loadBalancersCollector) Optional() bool {
+	return true
+}
+
+func (c *loadBalancersCollector) Collect(ctx context.Context, input CollectorInput, metric *edp.ConsumptionMetrics) error {
+	svcList, err := input.services(ctx, c.svcConfig)
+	if err != nil {
+		return err
+	}
+
+	internal, external := 0, 0
+	for _, svc := range svcList.Items {
+		if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		if isInternalLoadBalancer(svc) {
+			internal += 1
+		} else {
+			external += 1
+		}
+	}
+
+	metric.Networking.LoadBalancers = []edp.LoadBalancer{
+		{Type: LoadBalancerTypeExternal, Count: external},
+		{Type: LoadBalancerTypeInternal, Count: internal},
+	}
+
+	return nil
+}
+
+func isInternalLoadBalancer(svc corev1.Service) bool {
+	for _, annotation := range internalLoadBalancerAnnotations {
+		value, found := svc.Annotations[annotation]
+		if !found {
+			continue
+		}
+		// AWS accepts a CIDR as the annotation value, the other providers expect "true" or "Internal"
+		if !strings.EqualFold(value, "false") && value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// natGatewaysCollector counts the NAT gateways configured in the shoot infrastructure
+type natGatewaysCollector struct{}
+
+func newNATGatewaysCollector() *natGatewaysCollector {
+	return &natGatewaysCollector{}
+}
+
+func (c *natGatewaysCollector) Name() string {
+	return NATGatewaysCollectorName
+}
+
+func (c *natGatewaysCollector) SchemaVersion() int {
+	return edp.SchemaVersion2
+}
+
+func (c *natGatewaysCollector) Optional() bool {
+	return true
+}
+
+func (c *natGatewaysCollector) Collect(_ context.Context, input CollectorInput, metric *edp.ConsumptionMetrics) error {
+	if input.Shoot == nil {
+		return fmt.Errorf("no shoot data to compute metrics on")
+	}
+
+	natGateways, err := countNATGateways(input.Shoot)
+	if err != nil {
+		return err
+	}
+	metric.Networking.NATGateways = &natGateways
+
+	return nil
+}
+
+func countNATGateways(shoot *gardencorev1beta1.Shoot) (int, error) {
+	if shoot.Spec.Provider.InfrastructureConfig == nil {
+		return 0, nil
+	}
+	rawExtension := *shoot.Spec.Provider.InfrastructureConfig
+	decoder := serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder()
+
+	switch shoot.Spec.Provider.Type {
+	case Azure:
+		infraConfig := &gardenerazurev1alpha1.InfrastructureConfig{}
+		if err := runtime.DecodeInto(decoder, rawExtension.Raw, infraConfig); err != nil {
+			return 0, err
+		}
+		natGateways := 0
+		if infraConfig.Networks.NatGateway != nil && infraConfig.Networks.NatGateway.Enabled {
+			natGateways += 1
+		}
+		for _, zone := range infraConfig.Networks.Zones {
+			if zone.NatGateway != nil && zone.NatGateway.Enabled {
+				natGateways += 1
+			}
+		}
+		return natGateways, nil
+	case AWS:
+		// Gardener creates one NAT gateway per zone
+		infraConfig := &gardenerawsv1alpha1.InfrastructureConfig{}
+		if err := runtime.DecodeInto(decoder, rawExtension.Raw, infraConfig); err != nil {
+			return 0, err
+		}
+		return len(infraConfig.Networks.Zones), nil
+	case GCP:
+		// Gardener creates one Cloud NAT attached to the cloud router of the VPC
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("provider: %s does not match in the system", shoot.Spec.Provider.Type)
+	}
+}
+
+// serviceInstancesCollector counts the object storage buckets and Redis instances provisioned through the SAP BTP service operator
+type serviceInstancesCollector struct {
+	serviceInstanceConfig  skrserviceinstance.ConfigInf
+	objectStorageOfferings []string
+	redisOfferings         []string
+}
+
+func newServiceInstancesCollector(serviceInstanceConfig skrserviceinstance.ConfigInf, objectStorageOfferings, redisOfferings []string) *serviceInstancesCollector {
+	return &serviceInstancesCollector{
+		serviceInstanceConfig:  serviceInstanceConfig,
+		objectStorageOfferings: objectStorageOfferings,
+		redisOfferings:         redisOfferings,
+	}
+}
+
+func (c *serviceInstancesCollector) Name() string {
+	return ServiceInstancesCollectorName
+}
+
+func (c *serviceInstancesCollector) SchemaVersion() int {
+	return edp.SchemaVersion2
+}
+
+func (c *serviceInstancesCollector) Optional() bool {
+	return true
+}
+
+func (c *serviceInstancesCollector) Collect(ctx context.Context, input CollectorInput, metric *edp.ConsumptionMetrics) error {
+	instanceClient, err := c.serviceInstanceConfig.NewClient(input.KubeConfig)
+	if err != nil {
+		return err
+	}
+	instanceList, err := instanceClient.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	storage := new(edp.Storage)
+	for _, instance := range instanceList.Items {
+		offering := instance.Spec.ServiceOfferingName
+		if containsString(c.objectStorageOfferings, offering) {
+			storage.ObjectStorageBuckets += 1
+		}
+		if containsString(c.redisOfferings, offering) {
+			storage.RedisInstances += 1
+		}
+	}
+	metric.Storage = storage
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
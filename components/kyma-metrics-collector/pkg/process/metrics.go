@@ -15,4 +15,13 @@ var (
 		},
 		[]string{"requestURI"},
 	)
+	collectorFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "kmc",
+			Subsystem: "collector",
+			Name:      "failures_total",
+			Help:      "Total number of failed metric collections per collector.",
+		},
+		[]string{"collector"},
+	)
 )
@@ -17,10 +17,9 @@ import (
 	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
 	gardenersecret "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/secret"
 	gardenershoot "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/shoot"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/history"
 	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
-	skrnode "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/node"
-	skrpvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/pvc"
-	skrsvc "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/svc"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/spool"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -40,12 +39,12 @@ type Process struct {
 	ShootClient     *gardenershoot.Client
 	SecretClient    *gardenersecret.Client
 	Cache           *cache.Cache
-	Providers       *Providers
+	RuntimeIndex    *kmccache.RuntimeIndex
 	ScrapeInterval  time.Duration
 	WorkersPoolSize int
-	NodeConfig      skrnode.ConfigInf
-	PVCConfig       skrpvc.ConfigInf
-	SvcConfig       skrsvc.ConfigInf
+	Collectors      *CollectorRegistry
+	Spool           *spool.Spool
+	History         *history.History
 	Logger          *zap.SugaredLogger
 }
 
@@ -99,54 +98,11 @@ func (p Process) generateRecordWithNewMetrics(identifier int, subAccountID strin
 		return
 	}
 
-	// Get nodes dynamic client
-	nodesClient, err := p.NodeConfig.NewClient(record.KubeConfig)
-	if err != nil {
-		return
-	}
-
-	// Get nodes
-	var nodes *corev1.NodeList
-	nodes, err = nodesClient.List(ctx)
-	if err != nil {
-		return
-	}
-
-	if len(nodes.Items) == 0 {
-		err = fmt.Errorf("no nodes to process")
-		return
-	}
-
-	// Get PVCs
-	pvcClient, err := p.PVCConfig.NewClient(record.KubeConfig)
-	if err != nil {
-		return
-	}
-	var pvcList *corev1.PersistentVolumeClaimList
-	pvcList, err = pvcClient.List(ctx)
-	if err != nil {
-		return
-	}
-
-	// Get Svcs
-	var svcList *corev1.ServiceList
-	svcClient, err := p.SvcConfig.NewClient(record.KubeConfig)
-	if err != nil {
-		return
-	}
-	svcList, err = svcClient.List(ctx)
-	if err != nil {
-		return
-	}
-
-	// Create input
-	input := Input{
-		shoot:    shoot,
-		nodeList: nodes,
-		pvcList:  pvcList,
-		svcList:  svcList,
-	}
-	metric, err := input.Parse(p.Providers)
+	var metric *edp.ConsumptionMetrics
+	metric, err = p.Collectors.Collect(ctx, CollectorInput{
+		Shoot:      shoot,
+		KubeConfig: record.KubeConfig,
+	})
 	if err != nil {
 		return
 	}
@@ -210,6 +166,10 @@ func (p Process) Start() {
 		p.pollKEBForRuntimes()
 	}()
 
+	if p.Spool != nil {
+		go p.Spool.Run(context.Background(), p.sendEventStreamToEDP)
+	}
+
 	for i := 0; i < p.WorkersPoolSize; i++ {
 		j := i
 		go func() {
@@ -290,25 +250,40 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 	// Note: EDP refers SubAccountID as tenant
 	p.namedLoggerWithRuntime(record).With(log.KeySubAccountID, subAccountID).
 		With(log.KeyWorkerID, identifier).Debugf("sending EventStreamToEDP: payload: %s", string(payload))
-	err = p.sendEventStreamToEDP(subAccountID, payload)
+	if p.Spool != nil && p.Spool.HasPending(subAccountID) {
+		// Older payloads of the subAccountID are not delivered yet, the new one is spooled to keep the order
+		err = fmt.Errorf("undelivered payloads of subAccountID are pending in the spool")
+	} else {
+		err = p.sendEventStreamToEDP(subAccountID, payload)
+	}
+	delivery := history.DeliverySent
 	if err != nil {
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
 			With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
 			Errorf("send metric to EDP for event-stream: %s", string(payload))
 
-		p.Queue.AddAfter(subAccountID, p.ScrapeInterval)
-		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeyRequeue, log.ValueTrue).
-			With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
-			Debugf("requeued subAccountID after %v", p.ScrapeInterval)
+		if !p.spoolEventStream(record, subAccountID, identifier, payload) {
+			p.Queue.AddAfter(subAccountID, p.ScrapeInterval)
+			p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeyRequeue, log.ValueTrue).
+				With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
+				Debugf("requeued subAccountID after %v", p.ScrapeInterval)
 
-		// Nothing to do further hence continue
-		return
+			// Nothing to do further hence continue
+			return
+		}
+		delivery = history.DeliverySpooled
+	} else {
+		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
+			With(log.KeyWorkerID, identifier).Infof("sent event stream, shoot: %s", record.ShootName)
+	}
+
+	if p.History != nil {
+		p.History.Add(subAccountID, *record.Metric, delivery)
 	}
-	p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
-		With(log.KeyWorkerID, identifier).Infof("sent event stream, shoot: %s", record.ShootName)
 
 	if !isOldMetricValid {
 		p.Cache.Set(record.SubAccountID, *record, cache.NoExpiration)
+		p.RuntimeIndex.Set(record.RuntimeID, record.SubAccountID)
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, record.SubAccountID).
 			With(log.KeyWorkerID, identifier).Debug("saved metric")
 	}
@@ -343,6 +318,23 @@ func (p Process) getRecordWithOldOrNewMetric(identifier int, subAccountID string
 	return &record, false, nil
 }
 
+// spoolEventStream stores the undelivered payload in the spool to be replayed once EDP recovers,
+// it returns true if the payload was spooled
+func (p Process) spoolEventStream(record *kmccache.Record, subAccountID string, identifier int, payload []byte) bool {
+	if p.Spool == nil {
+		return false
+	}
+	if err := p.Spool.Add(subAccountID, payload); err != nil {
+		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+			With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
+			Error("spool undelivered event stream")
+		return false
+	}
+	p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
+		With(log.KeyWorkerID, identifier).Infof("spooled undelivered event stream, shoot: %s", record.ShootName)
+	return true
+}
+
 func (p Process) sendEventStreamToEDP(tenant string, payload []byte) error {
 	edpRequest, err := p.EDPClient.NewRequest(tenant)
 	if err != nil {
@@ -402,6 +394,7 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 						Error("Failed to add subAccountID to cache hence skipping queueing it")
 					continue
 				}
+				p.RuntimeIndex.Set(runtime.RuntimeID, runtime.SubAccountID)
 				p.Queue.Add(runtime.SubAccountID)
 				p.namedLogger().With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, runtime.SubAccountID).
 					With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Queued and added to cache")
@@ -414,6 +407,8 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 					// The shootname has changed hence the record in the cache is not valid anymore
 					// No need to queue as the subAccountID already exists in queue
 					p.Cache.Set(runtime.SubAccountID, newRecord, cache.NoExpiration)
+					p.RuntimeIndex.Delete(record.RuntimeID)
+					p.RuntimeIndex.Set(runtime.RuntimeID, runtime.SubAccountID)
 					p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
 						Debug("Resetted the values in cache for subAccount")
 				}
@@ -423,6 +418,7 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 		if isFoundInCache {
 			// Cluster is not trackable but is found in cache should be deleted
 			p.Cache.Delete(runtime.SubAccountID)
+			p.RuntimeIndex.Delete(runtime.RuntimeID)
 			p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
 				With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Deleted subAccount from cache")
 			continue
@@ -440,6 +436,7 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 				p.namedLogger().With(log.KeySubAccountID, sAccID).
 					Error("bad item from cache, could not cast to a record obj")
 			} else {
+				p.RuntimeIndex.Delete(record.RuntimeID)
 				p.namedLogger().With(log.KeySubAccountID, sAccID).With(log.KeyRuntimeID, record.RuntimeID).
 					Debug("SubAccount is not trackable anymore hence deleting it from cache")
 			}
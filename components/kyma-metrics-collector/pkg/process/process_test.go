@@ -34,7 +34,9 @@ import (
 	"github.com/google/uuid"
 
 	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/history"
 	kmckeb "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/keb"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/spool"
 	kmctesting "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/testing"
 
 	"github.com/onsi/gomega"
@@ -430,6 +432,12 @@ func TestExecute(t *testing.T) {
 	fakeNodeClient := skrnode.FakeNodeClient{}
 	fakePVCClient := skrpvc.FakePVCClient{}
 	fakeSvcClient := skrsvc.FakeSvcClient{}
+	collectors, err := NewCollectorRegistryFromConfig(&CollectorsConfig{}, providers, SKRConfigs{
+		NodeConfig: fakeNodeClient,
+		PVCConfig:  fakePVCClient,
+		SvcConfig:  fakeSvcClient,
+	}, log)
+	g.Expect(err).Should(gomega.BeNil())
 
 	newProcess := &Process{
 		EDPClient:      edpClient,
@@ -437,12 +445,9 @@ func TestExecute(t *testing.T) {
 		ShootClient:    shootClient,
 		SecretClient:   secretClient,
 		Cache:          cache,
-		Providers:      providers,
 		ScrapeInterval: 3 * time.Second,
 		Logger:         log,
-		NodeConfig:     fakeNodeClient,
-		PVCConfig:      fakePVCClient,
-		SvcConfig:      fakeSvcClient,
+		Collectors:     collectors,
 	}
 
 	go func() {
@@ -498,6 +503,73 @@ func TestExecute(t *testing.T) {
 	g.Eventually(newProcess.Queue.Len()).Should(gomega.Equal(0))
 }
 
+func TestProcessSubAccountIDWithSpool(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	subAccID := uuid.New().String()
+	shootName := fmt.Sprintf("shoot-%s", kmctesting.GenerateRandomAlphaString(5))
+	expectedPath := fmt.Sprintf("/namespaces/%s/dataStreams/%s/%s/dataTenants/%s/%s/events", testNamespace, testDataStream, testDataStreamVersion, subAccID, testEnv)
+	log := logger.NewLogger(zapcore.InfoLevel)
+
+	edpStatus := http.StatusInternalServerError
+	timesVisited := 0
+	edpTestHandler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		timesVisited += 1
+		rw.WriteHeader(edpStatus)
+	})
+	srv := kmctesting.StartTestServer(expectedPath, edpTestHandler, g)
+	defer srv.Close()
+
+	// The shoot does not exist, so the old metric from the cache is sent
+	shootClient, err := NewFakeShootClient(kmctesting.GetShoot("otherShoot", kmctesting.WithAzureProviderAndStandardD8V3VMs))
+	g.Expect(err).Should(gomega.BeNil())
+	record := NewRecord(subAccID, shootName, "foo")
+	record.Metric = NewMetric()
+	cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+	g.Expect(cache.Add(subAccID, record, gocache.NoExpiration)).Should(gomega.Succeed())
+
+	kmcSpool, err := spool.New(spool.Config{Dir: t.TempDir(), MaxEntries: 10}, log)
+	g.Expect(err).Should(gomega.BeNil())
+
+	p := Process{
+		EDPClient:      edp.NewClient(newEDPConfig(srv.URL), log),
+		Queue:          workqueue.NewDelayingQueue(),
+		ShootClient:    shootClient,
+		Cache:          cache,
+		ScrapeInterval: time.Minute,
+		Logger:         log,
+		Spool:          kmcSpool,
+		History:        history.New(history.Config{Window: time.Hour, MaxEntries: 10}),
+	}
+
+	// EDP is down, the payload is spooled
+	p.processSubAccountID(subAccID, 1)
+	g.Expect(timesVisited).Should(gomega.Equal(1))
+	g.Expect(kmcSpool.Len()).Should(gomega.Equal(1))
+
+	// Payloads are spooled without sending them while older payloads are pending
+	edpStatus = http.StatusCreated
+	p.processSubAccountID(subAccID, 1)
+	g.Expect(timesVisited).Should(gomega.Equal(1))
+	g.Expect(kmcSpool.Len()).Should(gomega.Equal(2))
+
+	// EDP recovered, the spooled payloads are replayed
+	g.Expect(kmcSpool.Replay(p.sendEventStreamToEDP)).Should(gomega.Succeed())
+	g.Expect(timesVisited).Should(gomega.Equal(3))
+	g.Expect(kmcSpool.Len()).Should(gomega.Equal(0))
+
+	// Without pending payloads the payload is sent directly
+	p.processSubAccountID(subAccID, 1)
+	g.Expect(timesVisited).Should(gomega.Equal(4))
+	g.Expect(kmcSpool.Len()).Should(gomega.Equal(0))
+
+	// All sent and spooled payloads are reported with their delivery state
+	entries := p.History.Get(subAccID, time.Time{}, time.Time{})
+	g.Expect(entries).Should(gomega.HaveLen(3))
+	g.Expect(entries[0].Delivery).Should(gomega.Equal(history.DeliverySpooled))
+	g.Expect(entries[1].Delivery).Should(gomega.Equal(history.DeliverySpooled))
+	g.Expect(entries[2].Delivery).Should(gomega.Equal(history.DeliverySent))
+}
+
 func NewFakeShootClient(shoot *gardenerv1beta1.Shoot) (*gardenershoot.Client, error) {
 	scheme, err := commons.SetupSchemeOrDie()
 	if err != nil {
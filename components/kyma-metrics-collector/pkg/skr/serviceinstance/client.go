@@ -0,0 +1,69 @@
+package serviceinstance
+
+import (
+	"context"
+	"encoding/json"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	skrcommons "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/commons"
+)
+
+type Client struct {
+	Resource dynamic.NamespaceableResourceInterface
+}
+
+func (c Config) NewClient(kubeconfig string) (*Client, error) {
+	restClientConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restClientConfig)
+	if err != nil {
+		return nil, err
+	}
+	nsResourceClient := dynamicClient.Resource(GroupVersionResource())
+	return &Client{Resource: nsResourceClient}, nil
+}
+
+// List returns the service instances from all namespaces, the list is empty if the service operator is not installed
+func (c Client) List(ctx context.Context) (*ServiceInstanceList, error) {
+	skrcommons.TotalCalls.WithLabelValues(skrcommons.CallsTotalLabel, skrcommons.ListingServiceInstancesLabel).Inc()
+	unstructuredInstanceList, err := c.Resource.Namespace(metaV1.NamespaceAll).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			skrcommons.TotalCalls.WithLabelValues(skrcommons.SuccessStatusLabel, skrcommons.SuccessListingServiceInstancesLabel).Inc()
+			return &ServiceInstanceList{}, nil
+		}
+		return nil, err
+	}
+	skrcommons.TotalCalls.WithLabelValues(skrcommons.SuccessStatusLabel, skrcommons.SuccessListingServiceInstancesLabel).Inc()
+
+	return convertUnstructuredListToServiceInstanceList(unstructuredInstanceList)
+}
+
+func convertUnstructuredListToServiceInstanceList(unstructuredInstanceList *unstructured.UnstructuredList) (*ServiceInstanceList, error) {
+	instanceList := new(ServiceInstanceList)
+	instanceListBytes, err := unstructuredInstanceList.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(instanceListBytes, instanceList)
+	if err != nil {
+		return nil, err
+	}
+	return instanceList, nil
+}
+
+func GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Version:  "v1",
+		Group:    "services.cloud.sap.com",
+		Resource: "serviceinstances",
+	}
+}
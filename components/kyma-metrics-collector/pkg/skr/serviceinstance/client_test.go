@@ -0,0 +1,77 @@
+package serviceinstance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/commons"
+	skrcommons "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/skr/commons"
+	kmctesting "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/testing"
+)
+
+func TestList(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+
+	client, err := FakeServiceInstanceClient{}.NewClient("")
+	g.Expect(err).Should(gomega.BeNil())
+
+	gotInstanceList, err := client.List(ctx)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(len(gotInstanceList.Items)).To(gomega.Equal(len(kmctesting.GetServiceInstances())))
+
+	offerings := make(map[string]int)
+	for _, instance := range gotInstanceList.Items {
+		offerings[instance.Spec.ServiceOfferingName] += 1
+	}
+	g.Expect(offerings).To(gomega.Equal(map[string]int{"objectstore": 2, "redis-cache": 1, "xsuaa": 1}))
+
+	// Tests metric
+	metricName := "kmc_skr_calls_total"
+	callsSuccess, err := skrcommons.TotalCalls.GetMetricWithLabelValues(skrcommons.SuccessStatusLabel, skrcommons.SuccessListingServiceInstancesLabel)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(testutil.ToFloat64(callsSuccess)).Should(gomega.Equal(float64(1)))
+	callsTotal, err := skrcommons.TotalCalls.GetMetricWithLabelValues(skrcommons.CallsTotalLabel, skrcommons.ListingServiceInstancesLabel)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(testutil.ToFloat64(callsTotal)).Should(gomega.Equal(float64(1)))
+	g.Expect(testutil.CollectAndCount(skrcommons.TotalCalls, metricName)).Should(gomega.Equal(2))
+
+	// Delete all the service instances
+	for _, instance := range gotInstanceList.Items {
+		err := client.Resource.Namespace(instance.Namespace).Delete(ctx, instance.Name, metaV1.DeleteOptions{})
+		g.Expect(err).Should(gomega.BeNil())
+	}
+
+	gotInstanceList, err = client.List(ctx)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(len(gotInstanceList.Items)).To(gomega.Equal(0))
+}
+
+func TestListWithoutServiceOperator(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scheme, err := commons.SetupSchemeOrDie()
+	g.Expect(err).Should(gomega.BeNil())
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			GroupVersionResource(): "ServiceInstanceList",
+		})
+	// The API server returns NotFound when the ServiceInstance CRD is not installed
+	dynamicClient.PrependReactor("list", GroupVersionResource().Resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewNotFound(GroupVersionResource().GroupResource(), "")
+	})
+	client := Client{Resource: dynamicClient.Resource(GroupVersionResource())}
+
+	gotInstanceList, err := client.List(context.Background())
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(gotInstanceList.Items).To(gomega.BeEmpty())
+}
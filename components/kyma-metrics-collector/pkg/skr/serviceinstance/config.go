@@ -0,0 +1,9 @@
+package serviceinstance
+
+type ConfigInf interface {
+	NewClient(string) (*Client, error)
+}
+
+type Config struct {
+	kubeconfig string
+}
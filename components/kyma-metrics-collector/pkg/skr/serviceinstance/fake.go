@@ -0,0 +1,25 @@
+package serviceinstance
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/gardener/commons"
+	kmctesting "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/testing"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+type FakeServiceInstanceClient struct{}
+
+func (fakeServiceInstanceClient FakeServiceInstanceClient) NewClient(string) (*Client, error) {
+	instances := kmctesting.GetServiceInstances()
+	scheme, err := commons.SetupSchemeOrDie()
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			GroupVersionResource(): "ServiceInstanceList",
+		}, instances...)
+
+	nsResourceClient := dynamicClient.Resource(GroupVersionResource())
+	return &Client{Resource: nsResourceClient}, nil
+}
@@ -0,0 +1,25 @@
+package serviceinstance
+
+import (
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceInstance contains the fields of the SAP BTP service operator ServiceInstance which are needed for metering
+type ServiceInstance struct {
+	metaV1.TypeMeta   `json:",inline"`
+	metaV1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceInstanceSpec `json:"spec"`
+}
+
+type ServiceInstanceSpec struct {
+	ServiceOfferingName string `json:"serviceOfferingName"`
+	ServicePlanName     string `json:"servicePlanName"`
+}
+
+type ServiceInstanceList struct {
+	metaV1.TypeMeta `json:",inline"`
+	metaV1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceInstance `json:"items"`
+}
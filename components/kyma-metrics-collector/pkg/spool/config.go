@@ -0,0 +1,15 @@
+package spool
+
+import "time"
+
+type Config struct {
+	// Dir is the directory where the undelivered payloads are stored, the spool is disabled when it is empty
+	Dir              string        `envconfig:"KMC_SPOOL_DIR" default:""`
+	MaxEntries       int           `envconfig:"KMC_SPOOL_MAX_ENTRIES" default:"10000"`
+	ReplayInterval   time.Duration `envconfig:"KMC_SPOOL_REPLAY_INTERVAL" default:"1m"`
+	ReplayMaxBackoff time.Duration `envconfig:"KMC_SPOOL_REPLAY_MAX_BACKOFF" default:"30m"`
+}
+
+func (c Config) Enabled() bool {
+	return c.Dir != ""
+}
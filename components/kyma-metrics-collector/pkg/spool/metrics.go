@@ -0,0 +1,40 @@
+package spool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	Namespace = "kmc"
+	Subsystem = "spool"
+)
+
+var (
+	depth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "depth",
+			Help:      "Number of undelivered EDP payloads in the spool.",
+		},
+	)
+
+	oldestAge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "oldest_undelivered_age_seconds",
+			Help:      "Age of the oldest undelivered EDP payload in the spool in seconds.",
+		},
+	)
+
+	dropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "dropped_total",
+			Help:      "Total number of undelivered EDP payloads dropped because the spool was full.",
+		},
+	)
+)
@@ -0,0 +1,314 @@
+package spool
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	entryExtension = ".json"
+	tmpExtension   = ".tmp"
+	fileMode       = 0600
+)
+
+// SendFunc delivers the payload of a subaccount to EDP
+type SendFunc func(subAccountID string, payload []byte) error
+
+// Entry is an undelivered payload stored in the spool
+type Entry struct {
+	SubAccountID string
+	Timestamp    time.Time
+	name         string
+}
+
+// Spool stores the undelivered EDP payloads on disk, one file per payload named after
+// the timestamp and the subaccount, so that they survive the restart of KMC
+type Spool struct {
+	config  Config
+	logger  *zap.SugaredLogger
+	mu      sync.Mutex
+	entries []Entry
+	lastTS  int64
+}
+
+// New creates the spool directory if needed and loads the entries left by the previous run
+func New(config Config, logger *zap.SugaredLogger) (*Spool, error) {
+	if err := os.MkdirAll(config.Dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create spool directory %s", config.Dir)
+	}
+
+	s := &Spool{
+		config: config,
+		logger: logger,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.updateMetrics()
+
+	return s, nil
+}
+
+func (s *Spool) load() error {
+	files, err := ioutil.ReadDir(s.config.Dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read spool directory %s", s.config.Dir)
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(file.Name(), tmpExtension) {
+			// Leftover of an interrupted write, the payload was never acknowledged as spooled
+			if err := os.Remove(filepath.Join(s.config.Dir, file.Name())); err != nil {
+				s.namedLogger().With(log.KeyError, err.Error()).Warnf("failed to remove incomplete spool file %s", file.Name())
+			}
+			continue
+		}
+		entry, err := parseEntryName(file.Name())
+		if err != nil {
+			s.namedLogger().With(log.KeyError, err.Error()).Warnf("ignoring unknown file %s in spool directory", file.Name())
+			continue
+		}
+		s.entries = append(s.entries, entry)
+		if entry.Timestamp.UnixNano() > s.lastTS {
+			s.lastTS = entry.Timestamp.UnixNano()
+		}
+	}
+	sort.Slice(s.entries, func(i, j int) bool {
+		return s.entries[i].name < s.entries[j].name
+	})
+
+	return nil
+}
+
+// Add stores the payload of a subaccount, the oldest entry is dropped when the spool is full
+func (s *Spool) Add(subAccountID string, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.config.MaxEntries > 0 && len(s.entries) >= s.config.MaxEntries {
+		oldest := s.entries[0]
+		if err := s.removeFile(oldest); err != nil {
+			return err
+		}
+		s.entries = s.entries[1:]
+		dropped.Inc()
+		s.namedLogger().With(log.KeySubAccountID, oldest.SubAccountID).
+			Warnf("spool is full, dropped undelivered payload from %s", oldest.Timestamp.Format(time.RFC3339))
+	}
+
+	// Keep the timestamps unique and increasing so that the file names reflect the order of the payloads
+	ts := time.Now().UnixNano()
+	if ts <= s.lastTS {
+		ts = s.lastTS + 1
+	}
+	entry := Entry{
+		SubAccountID: subAccountID,
+		Timestamp:    time.Unix(0, ts),
+		name:         entryName(ts, subAccountID),
+	}
+
+	path := filepath.Join(s.config.Dir, entry.name)
+	if err := ioutil.WriteFile(path+tmpExtension, payload, fileMode); err != nil {
+		return errors.Wrapf(err, "failed to write spool file %s", entry.name)
+	}
+	if err := os.Rename(path+tmpExtension, path); err != nil {
+		return errors.Wrapf(err, "failed to rename spool file %s", entry.name)
+	}
+	s.lastTS = ts
+	s.entries = append(s.entries, entry)
+	s.updateMetrics()
+
+	return nil
+}
+
+// Entries returns the spooled entries ordered from the oldest
+func (s *Spool) Entries() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make([]Entry, len(s.entries))
+	copy(entries, s.entries)
+	return entries
+}
+
+func (s *Spool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.entries)
+}
+
+// HasPending returns true if there are undelivered payloads of the subaccount, newer payloads
+// of the subaccount must be spooled too in order to be delivered after them
+func (s *Spool) HasPending(subAccountID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, entry := range s.entries {
+		if entry.SubAccountID == subAccountID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Spool) Read(entry Entry) ([]byte, error) {
+	payload, err := ioutil.ReadFile(filepath.Join(s.config.Dir, entry.name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read spool file %s", entry.name)
+	}
+	return payload, nil
+}
+
+func (s *Spool) Remove(entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.removeFile(entry); err != nil {
+		return err
+	}
+	for i, e := range s.entries {
+		if e.name == entry.name {
+			s.entries = append(s.entries[:i], s.entries[i+1:]...)
+			break
+		}
+	}
+	s.updateMetrics()
+
+	return nil
+}
+
+// Replay sends the spooled payloads in order and removes the delivered ones. When a payload of a
+// subaccount cannot be delivered, the newer payloads of the same subaccount are kept for the next replay.
+func (s *Spool) Replay(send SendFunc) error {
+	var replayErr error
+	failedSubAccounts := make(map[string]bool)
+
+	for _, entry := range s.Entries() {
+		if failedSubAccounts[entry.SubAccountID] {
+			continue
+		}
+
+		payload, err := s.Read(entry)
+		if err == nil {
+			err = send(entry.SubAccountID, payload)
+		}
+		if err != nil {
+			failedSubAccounts[entry.SubAccountID] = true
+			if replayErr == nil {
+				replayErr = errors.Wrapf(err, "failed to replay payload of subaccount %s", entry.SubAccountID)
+			}
+			continue
+		}
+
+		if err := s.Remove(entry); err != nil {
+			// The payload would be sent again, stop to not duplicate the newer payloads too
+			return err
+		}
+		s.namedLogger().With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, entry.SubAccountID).
+			Infof("replayed undelivered payload from %s", entry.Timestamp.Format(time.RFC3339))
+	}
+
+	return replayErr
+}
+
+// Run replays the spooled payloads periodically, the interval grows exponentially up to the maximum backoff
+// while EDP keeps failing and is reset once all payloads are delivered
+func (s *Spool) Run(ctx context.Context, send SendFunc) {
+	interval := s.config.ReplayInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		s.mu.Lock()
+		s.updateMetrics()
+		s.mu.Unlock()
+
+		if s.Len() == 0 {
+			interval = s.config.ReplayInterval
+			continue
+		}
+
+		if err := s.Replay(send); err != nil {
+			interval *= 2
+			if interval > s.config.ReplayMaxBackoff {
+				interval = s.config.ReplayMaxBackoff
+			}
+			s.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				With(log.KeyRetry, log.ValueTrue).Warnf("replay spooled payloads, next attempt in %v", interval)
+			continue
+		}
+		interval = s.config.ReplayInterval
+	}
+}
+
+func (s *Spool) removeFile(entry Entry) error {
+	err := os.Remove(filepath.Join(s.config.Dir, entry.name))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove spool file %s", entry.name)
+	}
+	return nil
+}
+
+// updateMetrics must be called with the lock held
+func (s *Spool) updateMetrics() {
+	depth.Set(float64(len(s.entries)))
+	if len(s.entries) == 0 {
+		oldestAge.Set(0)
+		return
+	}
+	oldestAge.Set(time.Since(s.entries[0].Timestamp).Seconds())
+}
+
+func (s *Spool) namedLogger() *zap.SugaredLogger {
+	return s.logger.With("component", "spool")
+}
+
+// entryName zero-pads the timestamp so that the lexical order of the names is the chronological order
+func entryName(ts int64, subAccountID string) string {
+	return fmt.Sprintf("%020d_%s%s", ts, url.PathEscape(subAccountID), entryExtension)
+}
+
+func parseEntryName(name string) (Entry, error) {
+	if !strings.HasSuffix(name, entryExtension) {
+		return Entry{}, fmt.Errorf("missing %s extension", entryExtension)
+	}
+	parts := strings.SplitN(strings.TrimSuffix(name, entryExtension), "_", 2)
+	if len(parts) != 2 {
+		return Entry{}, fmt.Errorf("missing subaccount")
+	}
+	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Entry{}, errors.Wrap(err, "invalid timestamp")
+	}
+	subAccountID, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return Entry{}, errors.Wrap(err, "invalid subaccount")
+	}
+
+	return Entry{
+		SubAccountID: subAccountID,
+		Timestamp:    time.Unix(0, ts),
+		name:         name,
+	}, nil
+}
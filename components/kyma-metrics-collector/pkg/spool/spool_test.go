@@ -0,0 +1,140 @@
+package spool
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+func newTestSpool(t *testing.T, dir string, maxEntries int) *Spool {
+	s, err := New(Config{
+		Dir:              dir,
+		MaxEntries:       maxEntries,
+		ReplayInterval:   time.Millisecond,
+		ReplayMaxBackoff: 10 * time.Millisecond,
+	}, logger.NewLogger(zapcore.InfoLevel))
+	if err != nil {
+		t.Fatalf("failed to create spool: %v", err)
+	}
+	return s
+}
+
+type sent struct {
+	subAccountID string
+	payload      string
+}
+
+func TestSpool(t *testing.T) {
+	t.Run("should keep the entries across restarts", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		dir := t.TempDir()
+
+		s := newTestSpool(t, dir, 10)
+		g.Expect(s.Add("sa-1", []byte("1"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-2", []byte("2"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-1", []byte("3"))).Should(gomega.Succeed())
+		// Leftover of an interrupted write
+		g.Expect(ioutil.WriteFile(filepath.Join(dir, "00000000000000000001_sa-3.json.tmp"), []byte("4"), 0600)).Should(gomega.Succeed())
+
+		restarted := newTestSpool(t, dir, 10)
+		entries := restarted.Entries()
+		g.Expect(entries).Should(gomega.HaveLen(3))
+		g.Expect(entries[0].SubAccountID).Should(gomega.Equal("sa-1"))
+		g.Expect(entries[1].SubAccountID).Should(gomega.Equal("sa-2"))
+		g.Expect(entries[2].SubAccountID).Should(gomega.Equal("sa-1"))
+		payload, err := restarted.Read(entries[2])
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(string(payload)).Should(gomega.Equal("3"))
+
+		files, err := ioutil.ReadDir(dir)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(files).Should(gomega.HaveLen(3))
+	})
+
+	t.Run("should drop the oldest entry when full", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		s := newTestSpool(t, t.TempDir(), 2)
+		g.Expect(s.Add("sa-1", []byte("1"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-2", []byte("2"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-3", []byte("3"))).Should(gomega.Succeed())
+
+		entries := s.Entries()
+		g.Expect(entries).Should(gomega.HaveLen(2))
+		g.Expect(entries[0].SubAccountID).Should(gomega.Equal("sa-2"))
+		g.Expect(entries[1].SubAccountID).Should(gomega.Equal("sa-3"))
+		g.Expect(s.HasPending("sa-1")).Should(gomega.BeFalse())
+		g.Expect(s.HasPending("sa-3")).Should(gomega.BeTrue())
+	})
+
+	t.Run("should replay in order and keep the entries of failing subaccounts", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		s := newTestSpool(t, t.TempDir(), 10)
+		g.Expect(s.Add("sa-1", []byte("1"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-2", []byte("2"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-1", []byte("3"))).Should(gomega.Succeed())
+		g.Expect(s.Add("sa-3", []byte("4"))).Should(gomega.Succeed())
+
+		var delivered []sent
+		err := s.Replay(func(subAccountID string, payload []byte) error {
+			if subAccountID == "sa-1" {
+				return fmt.Errorf("EDP is down")
+			}
+			delivered = append(delivered, sent{subAccountID, string(payload)})
+			return nil
+		})
+		g.Expect(err).ShouldNot(gomega.BeNil())
+		g.Expect(delivered).Should(gomega.Equal([]sent{{"sa-2", "2"}, {"sa-3", "4"}}))
+		g.Expect(s.Len()).Should(gomega.Equal(2))
+
+		delivered = nil
+		err = s.Replay(func(subAccountID string, payload []byte) error {
+			delivered = append(delivered, sent{subAccountID, string(payload)})
+			return nil
+		})
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(delivered).Should(gomega.Equal([]sent{{"sa-1", "1"}, {"sa-1", "3"}}))
+		g.Expect(s.Len()).Should(gomega.Equal(0))
+	})
+
+	t.Run("should replay periodically until delivered", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		s := newTestSpool(t, t.TempDir(), 10)
+		g.Expect(s.Add("sa-1", []byte("1"))).Should(gomega.Succeed())
+
+		attempts := make(chan struct{}, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go s.Run(ctx, func(subAccountID string, payload []byte) error {
+			attempts <- struct{}{}
+			if len(attempts) < 3 {
+				return fmt.Errorf("EDP is down")
+			}
+			return nil
+		})
+
+		g.Eventually(s.Len, time.Second).Should(gomega.Equal(0))
+	})
+
+	t.Run("should escape the subaccount in file names", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		dir := t.TempDir()
+		s := newTestSpool(t, dir, 10)
+		g.Expect(s.Add("../sa/1", []byte("1"))).Should(gomega.Succeed())
+
+		files, err := ioutil.ReadDir(dir)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(files).Should(gomega.HaveLen(1))
+		g.Expect(newTestSpool(t, dir, 10).HasPending("../sa/1")).Should(gomega.BeTrue())
+		_, err = os.Stat(filepath.Join(filepath.Dir(dir), "sa"))
+		g.Expect(os.IsNotExist(err)).Should(gomega.BeTrue())
+	})
+}
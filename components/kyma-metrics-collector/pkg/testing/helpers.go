@@ -19,6 +19,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/onsi/gomega"
 
@@ -396,6 +397,32 @@ func WithLoadBalancer(service *corev1.Service) {
 	}
 }
 
+func GetServiceInstances() []runtime.Object {
+	return []runtime.Object{
+		GetServiceInstance("bucket1", "foo", "objectstore"),
+		GetServiceInstance("bucket2", "bar", "objectstore"),
+		GetServiceInstance("cache", "foo", "redis-cache"),
+		GetServiceInstance("xsuaa", "foo", "xsuaa"),
+	}
+}
+
+func GetServiceInstance(name, ns, offering string) *unstructured.Unstructured {
+	instance := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"serviceOfferingName": offering,
+				"servicePlanName":     "standard",
+			},
+		},
+	}
+	instance.SetAPIVersion("services.cloud.sap.com/v1")
+	instance.SetKind("ServiceInstance")
+	instance.SetName(name)
+	instance.SetNamespace(ns)
+
+	return instance
+}
+
 func NewSecret(shootName, kubeconfigVal string) *corev1.Secret {
 	return &corev1.Secret{
 		TypeMeta: metaV1.TypeMeta{
@@ -34,6 +34,8 @@ CREATE TABLE gardener_config
     target_secret varchar(256) NOT NULL,
     disk_type varchar(256),
     worker_cidr varchar(256) NOT NULL,
+    pods_cidr varchar(256),
+    services_cidr varchar(256),
     auto_scaler_min integer NOT NULL,
     auto_scaler_max integer NOT NULL,
     max_surge integer NOT NULL,
@@ -45,6 +47,9 @@ CREATE TABLE gardener_config
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
     eu_access boolean NOT NULL,
+    hibernation_schedules jsonb,
+    worker_pools jsonb,
+    api_server_allowed_cidrs jsonb,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -54,7 +59,9 @@ CREATE TABLE gardener_config
 CREATE TYPE operation_state AS ENUM (
     'IN_PROGRESS',
     'SUCCEEDED',
-    'FAILED'
+    'FAILED',
+    'CANCELING',
+    'CANCELED'
     );
 
 CREATE TYPE operation_type AS ENUM (
@@ -65,7 +72,8 @@ CREATE TYPE operation_type AS ENUM (
     'UPGRADE_SHOOT',
     'HIBERNATE',
     'PROVISION_NO_INSTALL',
-    'DEPROVISION_NO_INSTALL'
+    'DEPROVISION_NO_INSTALL',
+    'WAKE_UP'
     );
 
 CREATE TABLE operation
@@ -82,7 +90,8 @@ CREATE TABLE operation
     last_transition timestamp without time zone,
     err_message text NOT NULL,
     reason text NOT NULL,
-    component text NOT NULL
+    component text NOT NULL,
+    retriable boolean NOT NULL DEFAULT false
 );
 
 -- Kyma Release
@@ -153,6 +162,15 @@ CREATE TABLE runtime_upgrade
     foreign key (post_upgrade_kyma_config_id) REFERENCES kyma_config (id) ON DELETE CASCADE
 );
 
+-- Shoot Upgrade
+
+CREATE TABLE shoot_upgrade
+(
+    operation_id uuid PRIMARY KEY,
+    pre_upgrade_gardener_config jsonb NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
 -- Cluster administrators
 
 CREATE TABLE cluster_administrator
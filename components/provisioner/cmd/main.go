@@ -71,6 +71,7 @@ type config struct {
 	HibernationTimeout             queue.HibernationTimeouts
 
 	OperatorRoleBinding provisioningStages.OperatorRoleBinding
+	ShootRetry          provisioningStages.ShootRetry
 
 	Gardener struct {
 		Project                                    string `envconfig:"default=gardenerProject"`
@@ -109,6 +110,7 @@ func (c *config) String() string {
 		"DeprovisioningNoInstallTimeoutClusterDeletion: %s, DeprovisioningNoInstallTimeoutWaitingForClusterDeletion: %s "+
 		"ShootUpgradeTimeout: %s, "+
 		"OperatorRoleBindingL2SubjectName: %s, OperatorRoleBindingL3SubjectName: %s, OperatorRoleBindingCreatingForAdmin: %t"+
+		"ShootRetryMaxAttempts: %d, ShootRetryBackoff: %s, "+
 		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, "+
 		"LatestDownloadedReleases: %d, DownloadPreReleases: %v, "+
 		"EnqueueInProgressOperations: %v"+
@@ -126,6 +128,7 @@ func (c *config) String() string {
 		c.DeprovisioningNoInstallTimeout.ClusterDeletion.String(), c.DeprovisioningNoInstallTimeout.WaitingForClusterDeletion.String(),
 		c.ProvisioningTimeout.ShootUpgrade.String(),
 		c.OperatorRoleBinding.L2SubjectName, c.OperatorRoleBinding.L3SubjectName, c.OperatorRoleBinding.CreatingForAdmin,
+		c.ShootRetry.MaxAttempts, c.ShootRetry.Backoff.String(),
 		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath,
 		c.LatestDownloadedReleases, c.DownloadPreReleases,
 		c.EnqueueInProgressOperations,
@@ -200,6 +203,7 @@ func main() {
 		shootClient,
 		secretsInterface,
 		cfg.OperatorRoleBinding,
+		cfg.ShootRetry,
 		k8sClientProvider)
 
 	provisioningNoInstallQueue := queue.CreateProvisioningNoInstallQueue(
@@ -209,20 +213,24 @@ func main() {
 		shootClient,
 		secretsInterface,
 		cfg.OperatorRoleBinding,
+		cfg.ShootRetry,
 		k8sClientProvider,
 		runtimeConfigurator)
 
+	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
+
 	upgradeQueue := queue.CreateUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, installationService)
 
 	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, installationService, directorClient, shootClient, 5*time.Minute)
 
 	deprovisioningNoInstallQueue := queue.CreateDeprovisioningNoInstallQueue(cfg.DeprovisioningNoInstallTimeout, dbsFactory, directorClient, shootClient)
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface, provisioner)
 
-	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient)
+	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient, provisioner)
+
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient)
 
-	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
 	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
 	exitOnError(err, "Failed to create Shoot controller.")
 	go func() {
@@ -244,6 +252,7 @@ func main() {
 		upgradeQueue,
 		shootUpgradeQueue,
 		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate)
 
@@ -268,6 +277,8 @@ func main() {
 
 	hibernationQueue.Run(ctx.Done())
 
+	wakeUpQueue.Run(ctx.Done())
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -323,14 +334,14 @@ func main() {
 	}()
 
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
 	wg.Wait()
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -364,6 +375,8 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 			upgradeQueue.Add(op.ID)
 		case model.Hibernate:
 			hibernationQueue.Add(op.ID)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID)
 		case model.UpgradeShoot:
 			shootUpgradeQueue.Add(op.ID)
 		}
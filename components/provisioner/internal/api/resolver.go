@@ -2,8 +2,12 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	log "github.com/sirupsen/logrus"
 
@@ -62,6 +66,11 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 		log.Errorf("Failed to provision Runtime %s: %s", config.RuntimeInput.Name, err)
 		return nil, err
 	}
+
+	if operationStatus.DryRunResult != nil {
+		log.Infof("Dry run of provisioning finished for Runtime %s.", config.RuntimeInput.Name)
+		return operationStatus, nil
+	}
 	log.Infof("Provisioning started for Runtime %s. Operation id %s", config.RuntimeInput.Name, *operationStatus.ID)
 
 	return operationStatus, nil
@@ -167,10 +176,66 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
+func (r *Resolver) Runtimes(ctx context.Context, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimePage, error) {
+	log.Infof("Requested to list Runtimes.")
+
+	tenant, err := r.tenantUpdater.GetTenant(ctx)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	runtimeFilter := model.RuntimeFilter{Tenant: tenant}
+	if filter != nil {
+		runtimeFilter.Name = filter.Name
+		runtimeFilter.SubAccountID = filter.SubAccountID
+		runtimeFilter.Provider = filter.Provider
+		runtimeFilter.Region = filter.Region
+	}
+
+	page, err := r.provisioning.ListRuntimes(runtimeFilter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (r *Resolver) Operations(ctx context.Context, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string, first *int, after *string) (*gqlschema.OperationPage, error) {
+	log.Infof("Requested to list operations.")
+
+	tenant, err := r.tenantUpdater.GetTenant(ctx)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	operationFilter, err := newOperationFilter(tenant, runtimeID, operationType, state, since)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	page, err := r.provisioning.ListOperations(operationFilter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	return page, nil
+}
+
 func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
-	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	var err error
+	if util.UnwrapBoolOrDefault(input.DryRun, false) {
+		// the dry run changes nothing, the tenant of the Runtime included
+		_, err = r.tenantUpdater.GetTenant(ctx)
+	} else {
+		err = r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	}
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
 		return nil, err
@@ -211,6 +276,48 @@ func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gql
 	return status, nil
 }
 
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to wake up runtime : %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpCluster(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (r *Resolver) CancelOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to cancel operation %s.", operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to cancel operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	status, err = r.provisioning.CancelOperation(operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
 func getSubAccount(ctx context.Context) string {
 	subAccount, ok := ctx.Value(middlewares.SubAccountID).(string)
 	if !ok {
@@ -218,3 +325,57 @@ func getSubAccount(ctx context.Context) string {
 	}
 	return subAccount
 }
+
+func newOperationFilter(tenant string, runtimeID *string, operationType *gqlschema.OperationType, state *gqlschema.OperationState, since *string) (model.OperationFilter, apperrors.AppError) {
+	filter := model.OperationFilter{
+		Tenant:    tenant,
+		RuntimeID: runtimeID,
+	}
+
+	if operationType != nil {
+		modelType, ok := operationTypes[*operationType]
+		if !ok {
+			return model.OperationFilter{}, apperrors.BadRequest("unsupported operation type: %s", *operationType)
+		}
+		filter.Type = &modelType
+	}
+
+	if state != nil {
+		modelState, ok := operationStates[*state]
+		if !ok {
+			return model.OperationFilter{}, apperrors.BadRequest("unsupported operation state: %s", *state)
+		}
+		filter.State = &modelState
+	}
+
+	if since != nil {
+		sinceTime, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			return model.OperationFilter{}, apperrors.BadRequest("since must be an RFC 3339 timestamp: %s", err.Error())
+		}
+		filter.Since = &sinceTime
+	}
+
+	return filter, nil
+}
+
+var operationTypes = map[gqlschema.OperationType]model.OperationType{
+	gqlschema.OperationTypeProvision:            model.Provision,
+	gqlschema.OperationTypeProvisionNoInstall:   model.ProvisionNoInstall,
+	gqlschema.OperationTypeUpgrade:              model.Upgrade,
+	gqlschema.OperationTypeUpgradeShoot:         model.UpgradeShoot,
+	gqlschema.OperationTypeDeprovision:          model.Deprovision,
+	gqlschema.OperationTypeDeprovisionNoInstall: model.DeprovisionNoInstall,
+	gqlschema.OperationTypeReconnectRuntime:     model.ReconnectRuntime,
+	gqlschema.OperationTypeHibernate:            model.Hibernate,
+	gqlschema.OperationTypeWakeUp:               model.WakeUp,
+}
+
+// Operations are never stored in the Pending state, so it cannot be used as a filter
+var operationStates = map[gqlschema.OperationState]model.OperationState{
+	gqlschema.OperationStateInProgress: model.InProgress,
+	gqlschema.OperationStateSucceeded:  model.Succeeded,
+	gqlschema.OperationStateFailed:     model.Failed,
+	gqlschema.OperationStateCanceling:  model.Canceling,
+	gqlschema.OperationStateCanceled:   model.Canceled,
+}
@@ -170,6 +170,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 		shootInterface,
 		secretsInterface,
 		testOperatorRoleBinding(),
+		testShootRetry(),
 		mockK8sClientProvider)
 	provisioningQueue.Run(queueCtx.Done())
 
@@ -180,6 +181,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 		shootInterface,
 		secretsInterface,
 		testOperatorRoleBinding(),
+		testShootRetry(),
 		mockK8sClientProvider,
 		runtimeConfigurator)
 	provisioningNoInstallQueue.Run(queueCtx.Done())
@@ -193,12 +195,17 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	upgradeQueue := queue.CreateUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, installationServiceMock)
 	upgradeQueue.Run(queueCtx.Done())
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, secretsInterface)
+	clusterProvisioner := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath)
+
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, secretsInterface, clusterProvisioner)
 	shootUpgradeQueue.Run(queueCtx.Done())
 
-	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface, clusterProvisioner)
 	shootHibernationQueue.Run(queueCtx.Done())
 
+	shootWakeUpQueue := queue.CreateWakeUpQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	shootWakeUpQueue.Run(queueCtx.Done())
+
 	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
 	require.NoError(t, err)
 
@@ -247,7 +254,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			inputConverter := provisioning.NewInputConverter(uuidGenerator, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
-			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue)
+			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue, shootWakeUpQueue)
 
 			validator := api.NewValidator()
 
@@ -548,9 +555,17 @@ func testOperatorRoleBinding() provisioning2.OperatorRoleBinding {
 	}
 }
 
+func testShootRetry() provisioning2.ShootRetry {
+	return provisioning2.ShootRetry{
+		MaxAttempts: 3,
+		Backoff:     time.Minute,
+	}
+}
+
 func testHibernationTimeouts() queue.HibernationTimeouts {
 	return queue.HibernationTimeouts{
 		WaitingForClusterHibernation: 5 * time.Minute,
+		WaitingForClusterWakeUp:      5 * time.Minute,
 	}
 }
 
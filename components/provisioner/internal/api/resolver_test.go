@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
@@ -11,11 +12,13 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	validatorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/api/mocks"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -104,6 +107,39 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		assert.Equal(t, util.StringPtr("Message"), status.Message)
 	})
 
+	t.Run("Should return rendered Shoot when dry run is requested", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		operation := &gqlschema.OperationStatus{
+			Operation:    gqlschema.OperationTypeProvisionNoInstall,
+			State:        gqlschema.OperationStateSucceeded,
+			Message:      util.StringPtr("Dry run, no operation was started"),
+			DryRunResult: &gqlschema.DryRunResult{Shoot: "kind: Shoot"},
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+			DryRun:        util.BoolPtr(true),
+		}
+
+		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(operation, nil)
+		validator.On("ValidateProvisioningInput", config).Return(nil)
+
+		//when
+		status, err := resolver.ProvisionRuntime(ctx, config)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+	})
+
 	t.Run("Should return error when Kyma config validation fails", func(t *testing.T) {
 		//given
 		provisioningService := &mocks.Service{}
@@ -497,6 +533,136 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestResolver_Runtimes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should list Runtimes of the tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		first := 10
+		filter := &gqlschema.RuntimesFilter{Provider: util.StringPtr("gcp")}
+		page := &gqlschema.RuntimePage{
+			Data:     []*gqlschema.Runtime{{ID: runtimeID}},
+			PageInfo: &gqlschema.PageInfo{},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListRuntimes", model.RuntimeFilter{Tenant: tenant, Provider: filter.Provider}, &first, (*string)(nil)).Return(page, nil)
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, filter, &first, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, runtimes)
+	})
+
+	t.Run("Should fail when tenant header is not passed to context", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		ctx := context.Background()
+
+		tenantUpdater.On("GetTenant", ctx).Return("", apperrors.BadRequest("missing tenant header"))
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		assert.Nil(t, runtimes)
+		provisioningService.AssertNotCalled(t, "ListRuntimes")
+	})
+}
+
+func TestResolver_Operations(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should list operations matching filters", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		operationType := gqlschema.OperationTypeUpgradeShoot
+		state := gqlschema.OperationStateFailed
+		since := "2026-10-01T12:00:00Z"
+		after := "cursor"
+		page := &gqlschema.OperationPage{
+			Data:     []*gqlschema.OperationStatus{{ID: util.StringPtr(operationID)}},
+			PageInfo: &gqlschema.PageInfo{},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("ListOperations", mock.MatchedBy(func(filter model.OperationFilter) bool {
+			return filter.Tenant == tenant &&
+				*filter.RuntimeID == runtimeID &&
+				*filter.Type == model.UpgradeShoot &&
+				*filter.State == model.Failed &&
+				filter.Since.Equal(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+		}), (*int)(nil), &after).Return(page, nil)
+
+		//when
+		operations, err := provisioner.Operations(ctx, util.StringPtr(runtimeID), &operationType, &state, &since, nil, &after)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, page, operations)
+	})
+
+	t.Run("Should return error when since is not a valid timestamp", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		since := "yesterday"
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		//when
+		operations, err := provisioner.Operations(ctx, nil, nil, nil, &since, nil, nil)
+
+		//then
+		require.Error(t, err)
+		assert.Nil(t, operations)
+		provisioningService.AssertNotCalled(t, "ListOperations")
+	})
+
+	t.Run("Should return error when filtering by Pending state", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		state := gqlschema.OperationStatePending
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		//when
+		operations, err := provisioner.Operations(ctx, nil, nil, &state, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		assert.Nil(t, operations)
+	})
+}
+
 func TestResolver_UpgradeShoot(t *testing.T) {
 	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
 
@@ -533,6 +699,38 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		assert.Equal(t, operation, status)
 	})
 
+	t.Run("Should not update tenant when dry run is requested", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		dryRunInput := NewUpgradeShootInput()
+		dryRunInput.DryRun = util.BoolPtr(true)
+
+		operation := &gqlschema.OperationStatus{
+			Operation:    gqlschema.OperationTypeUpgradeShoot,
+			State:        gqlschema.OperationStateSucceeded,
+			Message:      util.StringPtr("Dry run, no operation was started"),
+			RuntimeID:    util.StringPtr(runtimeID),
+			DryRunResult: &gqlschema.DryRunResult{Shoot: "kind: Shoot"},
+		}
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		validator.On("ValidateUpgradeShootInput", dryRunInput).Return(nil)
+		provisioningService.On("UpgradeGardenerShoot", runtimeID, dryRunInput).Return(operation, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		//when
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, dryRunInput)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+		tenantUpdater.AssertNotCalled(t, "GetAndUpdateTenant", runtimeID, ctx)
+	})
+
 	t.Run("Should return error when validation fails", func(t *testing.T) {
 		//given
 		provisioningService := &mocks.Service{}
@@ -608,6 +806,61 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 	})
 }
 
+func TestResolver_WakeUpRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+
+	t.Run("Should wake up cluster", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+		message := "some message"
+
+		operationStatus := &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeWakeUp,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: &runtimeID,
+			Message:   &message,
+		}
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(operationStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operationStatus, status)
+	})
+
+	t.Run("Should return error when wake up fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(nil, apperrors.Internal("Some error"))
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		require.Empty(t, status)
+	})
+}
+
 func oidcInput() *gqlschema.OIDCConfigInput {
 	return &gqlschema.OIDCConfigInput{
 		ClientID:       "9bd05ed7-a930-44e6-8c79-e6defeb2222",
@@ -631,3 +884,85 @@ func dnsInput() *gqlschema.DNSConfigInput {
 		},
 	}
 }
+
+func TestResolver_CancelOperation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+	operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+
+	inProgressStatus := &gqlschema.OperationStatus{
+		ID:        &operationID,
+		Operation: gqlschema.OperationTypeProvision,
+		State:     gqlschema.OperationStateInProgress,
+		RuntimeID: &runtimeID,
+	}
+
+	t.Run("Should cancel operation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		canceledStatus := &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateCanceling,
+			RuntimeID: &runtimeID,
+		}
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(inProgressStatus, nil)
+		provisioningService.On("CancelOperation", operationID).Return(canceledStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.CancelOperation(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, canceledStatus, status)
+	})
+
+	t.Run("Should return error when operation belongs to other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(inProgressStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		//when
+		status, err := provisioner.CancelOperation(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		require.Empty(t, status)
+		provisioningService.AssertNotCalled(t, "CancelOperation", operationID)
+	})
+
+	t.Run("Should return error when cancellation fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(inProgressStatus, nil)
+		provisioningService.On("CancelOperation", operationID).Return(nil, apperrors.Internal("Some error"))
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.CancelOperation(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		require.Empty(t, status)
+	})
+}
@@ -1,9 +1,16 @@
 package api
 
 import (
+	"net"
 	"strings"
+	"time"
+	// the image has no time zone database, the locations of the hibernation schedules are validated with the embedded one
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -76,6 +83,18 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateWorkerPools(config.WorkerPools); err != nil {
+		return err
+	}
+
+	if err := v.validateHibernationSchedules(config.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateAPIServerAllowedCidrs(config.APIServerAllowedCidrs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -122,6 +141,28 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateWorkerPools(gardenerConfig.WorkerPools); err != nil {
+		return err
+	}
+
+	for _, pool := range gardenerConfig.WorkerPools {
+		if err := v.validateOpenStackVolume(pool.DiskType, pool.VolumeSizeGb, gardenerConfig.Provider); err != nil {
+			return err
+		}
+	}
+
+	if err := v.validateHibernationSchedules(gardenerConfig.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateNetworking(gardenerConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateAPIServerAllowedCidrs(gardenerConfig.APIServerAllowedCidrs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -132,6 +173,106 @@ func (v *validator) validateMachineImage(gardenerConfig gqlschema.GardenerConfig
 	return nil
 }
 
+func (v *validator) validateWorkerPools(pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	names := map[string]bool{model.DefaultWorkerPoolName: true}
+
+	for _, pool := range pools {
+		if pool.Name == "" {
+			return apperrors.BadRequest("error: worker pool name is empty")
+		}
+		if names[pool.Name] {
+			return apperrors.BadRequest("error: worker pool name %s is not unique", pool.Name)
+		}
+		names[pool.Name] = true
+
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: machine type of worker pool %s is empty", pool.Name)
+		}
+		if pool.AutoScalerMin < 0 {
+			return apperrors.BadRequest("error: autoScalerMin of worker pool %s is negative", pool.Name)
+		}
+		if pool.AutoScalerMin > pool.AutoScalerMax {
+			return apperrors.BadRequest("error: autoScalerMin of worker pool %s is greater than autoScalerMax", pool.Name)
+		}
+		if pool.MaxSurge == 0 && pool.MaxUnavailable == 0 {
+			return apperrors.BadRequest("error: maxSurge and maxUnavailable of worker pool %s are both zero", pool.Name)
+		}
+		if util.NotNilOrEmpty(pool.MachineImageVersion) && util.IsNilOrEmpty(pool.MachineImage) {
+			return apperrors.BadRequest("error: Machine Image Version of worker pool %s passed while Machine Image is empty", pool.Name)
+		}
+	}
+	return nil
+}
+
+// validateHibernationSchedules checks the schedules the same way as Gardener, so an invalid schedule is not stored
+func (v *validator) validateHibernationSchedules(schedules []*gqlschema.HibernationScheduleInput) apperrors.AppError {
+	for i, schedule := range schedules {
+		if schedule == nil || (util.IsNilOrEmpty(schedule.Start) && util.IsNilOrEmpty(schedule.End)) {
+			return apperrors.BadRequest("error: hibernation schedule %d has neither start nor end", i)
+		}
+		for _, expression := range []*string{schedule.Start, schedule.End} {
+			if util.IsNilOrEmpty(expression) {
+				continue
+			}
+			if _, err := cron.ParseStandard(*expression); err != nil {
+				return apperrors.BadRequest("error: invalid cron expression %q of hibernation schedule %d: %s", *expression, i, err.Error())
+			}
+		}
+		if schedule.Location != nil {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				return apperrors.BadRequest("error: invalid location %q of hibernation schedule %d: %s", *schedule.Location, i, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// validateNetworking checks that the optional pods and services ranges are valid and do not overlap each other or the nodes range
+func (v *validator) validateNetworking(gardenerConfig gqlschema.GardenerConfigInput) apperrors.AppError {
+	ranges := map[string]*net.IPNet{}
+	for _, name := range []string{"pods", "services"} {
+		cidr := gardenerConfig.PodsCidr
+		if name == "services" {
+			cidr = gardenerConfig.ServicesCidr
+		}
+		if cidr == nil {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(*cidr)
+		if err != nil {
+			return apperrors.BadRequest("error: invalid %s CIDR %q: %s", name, *cidr, err.Error())
+		}
+		ranges[name] = ipNet
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+	// the nodes range is not validated here, it is only compared if it is a valid CIDR
+	if _, nodes, err := net.ParseCIDR(gardenerConfig.WorkerCidr); err == nil {
+		ranges["nodes"] = nodes
+	}
+
+	names := []string{"nodes", "pods", "services"}
+	for i, first := range names {
+		for _, second := range names[i+1:] {
+			a, b := ranges[first], ranges[second]
+			if a != nil && b != nil && (a.Contains(b.IP) || b.Contains(a.IP)) {
+				return apperrors.BadRequest("error: %s CIDR %s overlaps with %s CIDR %s", first, a.String(), second, b.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateAPIServerAllowedCidrs(cidrs []string) apperrors.AppError {
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return apperrors.BadRequest("error: invalid API server allowed CIDR %q: %s", cidr, err.Error())
+		}
+	}
+	return nil
+}
+
 // OpenStack does not accept diskType or volumeSize
 func (v *validator) validateOpenStackVolume(diskType *string, volumeSizeGb *int, provider string) apperrors.AppError {
 	if strings.ToLower(provider) == "openstack" {
@@ -149,6 +149,133 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 		//then
 		require.Error(t, err)
 	})
+
+	t.Run("should validate worker pools", func(t *testing.T) {
+		for _, testCase := range []struct {
+			description string
+			pool        gqlschema.WorkerPoolInput
+			valid       bool
+		}{
+			{description: "valid pool", pool: gqlschema.WorkerPoolInput{Name: "memory", MachineType: "m5.xlarge", AutoScalerMin: 0, AutoScalerMax: 1, MaxUnavailable: 1}, valid: true},
+			{description: "empty name", pool: gqlschema.WorkerPoolInput{MachineType: "m5.xlarge", AutoScalerMax: 1}},
+			{description: "default pool name", pool: gqlschema.WorkerPoolInput{Name: "cpu-worker-0", MachineType: "m5.xlarge", AutoScalerMax: 1}},
+			{description: "empty machine type", pool: gqlschema.WorkerPoolInput{Name: "memory", AutoScalerMax: 1}},
+			{description: "min greater than max", pool: gqlschema.WorkerPoolInput{Name: "memory", MachineType: "m5.xlarge", AutoScalerMin: 3, AutoScalerMax: 1, MaxSurge: 1}},
+			{description: "negative min", pool: gqlschema.WorkerPoolInput{Name: "memory", MachineType: "m5.xlarge", AutoScalerMin: -1, AutoScalerMax: 1, MaxSurge: 1}},
+			{description: "zero max surge and max unavailable", pool: gqlschema.WorkerPoolInput{Name: "memory", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 1}},
+		} {
+			t.Run(testCase.description, func(t *testing.T) {
+				//given
+				validator := NewValidator()
+
+				testClusterConfig, _, _ := initializeConfigs()
+				pool := testCase.pool
+				testClusterConfig.GardenerConfig.WorkerPools = []*gqlschema.WorkerPoolInput{&pool}
+
+				config := gqlschema.ProvisionRuntimeInput{
+					RuntimeInput:  runtimeInput,
+					ClusterConfig: testClusterConfig,
+					KymaConfig:    kymaConfig,
+				}
+
+				//when
+				err := validator.ValidateProvisioningInput(config)
+
+				//then
+				if testCase.valid {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			})
+		}
+	})
+
+	t.Run("should validate hibernation schedules", func(t *testing.T) {
+		for _, testCase := range []struct {
+			description string
+			schedule    gqlschema.HibernationScheduleInput
+			valid       bool
+		}{
+			{description: "start and end with location", schedule: gqlschema.HibernationScheduleInput{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 06 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")}, valid: true},
+			{description: "start only", schedule: gqlschema.HibernationScheduleInput{Start: util.StringPtr("00 20 * * *")}, valid: true},
+			{description: "neither start nor end", schedule: gqlschema.HibernationScheduleInput{Location: util.StringPtr("UTC")}},
+			{description: "invalid start", schedule: gqlschema.HibernationScheduleInput{Start: util.StringPtr("every evening")}},
+			{description: "invalid end", schedule: gqlschema.HibernationScheduleInput{Start: util.StringPtr("00 20 * * *"), End: util.StringPtr("00 25 * * *")}},
+			{description: "invalid location", schedule: gqlschema.HibernationScheduleInput{Start: util.StringPtr("00 20 * * *"), Location: util.StringPtr("Europe/Atlantis")}},
+		} {
+			t.Run(testCase.description, func(t *testing.T) {
+				//given
+				validator := NewValidator()
+
+				testClusterConfig, _, _ := initializeConfigs()
+				schedule := testCase.schedule
+				testClusterConfig.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{&schedule}
+
+				config := gqlschema.ProvisionRuntimeInput{
+					RuntimeInput:  runtimeInput,
+					ClusterConfig: testClusterConfig,
+					KymaConfig:    kymaConfig,
+				}
+
+				//when
+				err := validator.ValidateProvisioningInput(config)
+
+				//then
+				if testCase.valid {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			})
+		}
+	})
+
+	t.Run("should validate pods and services CIDRs", func(t *testing.T) {
+		for _, testCase := range []struct {
+			description string
+			workerCidr  string
+			pods        *string
+			services    *string
+			valid       bool
+		}{
+			{description: "defaults", workerCidr: "10.250.0.0/16", valid: true},
+			{description: "disjoint ranges", workerCidr: "10.250.0.0/16", pods: util.StringPtr("10.96.0.0/13"), services: util.StringPtr("10.104.0.0/13"), valid: true},
+			{description: "invalid pods", workerCidr: "10.250.0.0/16", pods: util.StringPtr("10.96.0.0/33")},
+			{description: "invalid services", workerCidr: "10.250.0.0/16", services: util.StringPtr("services")},
+			{description: "pods overlap nodes", workerCidr: "10.250.0.0/16", pods: util.StringPtr("10.0.0.0/8")},
+			{description: "services overlap pods", workerCidr: "10.250.0.0/16", pods: util.StringPtr("10.96.0.0/13"), services: util.StringPtr("10.100.0.0/16")},
+		} {
+			t.Run(testCase.description, func(t *testing.T) {
+				//given
+				validator := NewValidator()
+
+				testClusterConfig, _, _ := initializeConfigs()
+				testClusterConfig.GardenerConfig.WorkerCidr = testCase.workerCidr
+				testClusterConfig.GardenerConfig.PodsCidr = testCase.pods
+				testClusterConfig.GardenerConfig.ServicesCidr = testCase.services
+
+				config := gqlschema.ProvisionRuntimeInput{
+					RuntimeInput:  runtimeInput,
+					ClusterConfig: testClusterConfig,
+					KymaConfig:    kymaConfig,
+				}
+
+				//when
+				err := validator.ValidateProvisioningInput(config)
+
+				//then
+				if testCase.valid {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			})
+		}
+	})
 }
 
 func TestValidator_ValidateUpgradeInput(t *testing.T) {
@@ -366,6 +493,65 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
 	})
+
+	t.Run("Should return error when Gardener config input provides invalid hibernation schedule", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				HibernationSchedules: []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 20 * * *"), Location: util.StringPtr("Mars/Olympus_Mons")},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when Gardener config input provides duplicated worker pool names", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				WorkerPools: []*gqlschema.WorkerPoolInput{
+					{Name: "memory", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 2},
+					{Name: "memory", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 2},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when API server allowed CIDR is invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				APIServerAllowedCidrs: []string{"203.0.113.0/24", "203.0.113.0"},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
 }
 
 func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput, *gqlschema.KymaConfigInput) {
@@ -60,9 +60,30 @@ type GardenerProvisioner struct {
 }
 
 func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
+	shootTemplate, err := g.RenderShoot(cluster)
+	if err != nil {
+		return err
+	}
+
+	annotate(shootTemplate, runtimeIDAnnotation, cluster.ID)
+	annotate(shootTemplate, operationIDAnnotation, operationId)
+	annotate(shootTemplate, legacyRuntimeIDAnnotation, cluster.ID)
+	annotate(shootTemplate, legacyOperationIDAnnotation, operationId)
+
+	_, k8serr := g.shootClient.Create(context.Background(), shootTemplate, v1.CreateOptions{})
+	if k8serr != nil {
+		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
+	}
+
+	return nil
+}
+
+// RenderShoot returns the Shoot that would be created for the cluster, without the Runtime and operation annotations
+func (g *GardenerProvisioner) RenderShoot(cluster model.Cluster) (*gardener_types.Shoot, apperrors.AppError) {
 	shootTemplate, err := cluster.ClusterConfig.ToShootTemplate(g.namespace, cluster.Tenant, util.UnwrapStr(cluster.SubAccountId), cluster.ClusterConfig.OIDCConfig, cluster.ClusterConfig.DNSConfig)
 	if err != nil {
-		return err.Append("failed to convert cluster config to Shoot template")
+		return nil, err.Append("failed to convert cluster config to Shoot template")
 	}
 
 	region := cluster.ClusterConfig.Region
@@ -75,26 +96,15 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 		err := g.setMaintenanceWindow(shootTemplate, region)
 
 		if err != nil {
-			return err.Append("error setting maintenance window for %s cluster", cluster.ID)
+			return nil, err.Append("error setting maintenance window for %s cluster", cluster.ID)
 		}
 	}
 
-	annotate(shootTemplate, runtimeIDAnnotation, cluster.ID)
-	annotate(shootTemplate, operationIDAnnotation, operationId)
-	annotate(shootTemplate, legacyRuntimeIDAnnotation, cluster.ID)
-	annotate(shootTemplate, legacyOperationIDAnnotation, operationId)
-
 	if g.policyConfigMapName != "" {
 		g.applyAuditConfig(shootTemplate)
 	}
 
-	_, k8serr := g.shootClient.Create(context.Background(), shootTemplate, v1.CreateOptions{})
-	if k8serr != nil {
-		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
-		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
-	}
-
-	return nil
+	return shootTemplate, nil
 }
 
 func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
@@ -130,6 +140,26 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 	return nil
 }
 
+// RenderShootUpgrade returns the current Shoot of the cluster and the Shoot that the upgrade would apply
+func (g *GardenerProvisioner) RenderShootUpgrade(clusterID string, upgradeConfig model.GardenerConfig) (*gardener_types.Shoot, *gardener_types.Shoot, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(context.Background(), upgradeConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return nil, nil, appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, upgradeConfig.Name)
+	}
+
+	upgradedShoot := shoot.DeepCopy()
+
+	appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, upgradedShoot)
+	if appErr != nil {
+		return nil, nil, appErr.Append("error while updating Gardener shoot configuration")
+	}
+
+	setObjectFields(upgradedShoot)
+
+	return shoot, upgradedShoot, nil
+}
+
 func (g *GardenerProvisioner) HibernateCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		shoot, err := g.shootClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
@@ -172,6 +202,41 @@ func (g *GardenerProvisioner) HibernateCluster(clusterID string, gardenerConfig
 	return nil
 }
 
+func (g *GardenerProvisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		shoot, err := g.shootClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+		if err != nil {
+			appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+			return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, gardenerConfig.Name)
+		}
+
+		if shoot.Spec.Hibernation == nil || shoot.Spec.Hibernation.Enabled == nil || !*shoot.Spec.Hibernation.Enabled {
+			return k8sErrors.NewBadRequest("cannot wake up cluster: cluster is not hibernated")
+		}
+
+		enabled := false
+		shoot.Spec.Hibernation.Enabled = &enabled
+
+		setObjectFields(shoot)
+
+		shootData, err := json.Marshal(shoot)
+		if err != nil {
+			apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+			return apperr.Append("error during marshaling Shoot data")
+		}
+
+		_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
+		return err
+	})
+
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return apperr.Append("error executing update shoot configuration")
+	}
+
+	return nil
+}
+
 func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, withoutUninstall bool, operationId string) (model.Operation, apperrors.AppError) {
 	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
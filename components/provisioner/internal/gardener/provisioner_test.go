@@ -246,6 +246,26 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		require.Error(t, apperr)
 		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
 	})
+	t.Run("should render shoot upgrade without changing shoot", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+
+		// when
+		currentShoot, upgradedShoot, apperr := provisioner.RenderShootUpgrade(cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		assert.Equal(t, initialShoot.Spec, currentShoot.Spec)
+		assert.Equal(t, expectedShoot.Spec, upgradedShoot.Spec)
+
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, initialShoot, shoot)
+	})
 }
 
 func newClusterConfig(name string, subAccountID *string, providerConfig model.GardenerProviderConfig, region string, purpose string) model.Cluster {
@@ -359,6 +379,68 @@ func TestGardenerProvisioner_HibernateCluster(t *testing.T) {
 	})
 }
 
+func TestGardenerProvisioner_WakeUpCluster(t *testing.T) {
+
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should return error if failed to get shoot", func(t *testing.T) {
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+
+		// when
+		apperr := provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
+	})
+
+	t.Run("should return error if cluster is not hibernated", func(t *testing.T) {
+		shoot := testkit.NewTestShoot(clusterName).
+			InNamespace(gardenerNamespace).
+			WithHibernationEnabled(false).
+			ToShoot()
+
+		clientset := fake.NewSimpleClientset(shoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+
+		// when
+		apperr := provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeBadRequest, apperr.Code())
+	})
+
+	t.Run("should wake up cluster", func(t *testing.T) {
+		shoot := testkit.NewTestShoot(clusterName).
+			InNamespace(gardenerNamespace).
+			WithHibernationState(true, true).
+			WithHibernationEnabled(true).
+			ToShoot()
+
+		clientset := fake.NewSimpleClientset(shoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+
+		// when
+		apperr := provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.NoError(t, apperr)
+	})
+}
+
 func TestGardenerProvisioner_GetHibernationStatus(t *testing.T) {
 	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
 	require.NoError(t, err)
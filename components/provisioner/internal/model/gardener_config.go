@@ -25,6 +25,9 @@ const (
 	EuAccessAnnotation                   = "support.gardener.cloud/eu-access-for-cluster-nodes"
 	ShootNetworkingFilterExtensionType   = "shoot-networking-filter"
 	ShootNetworkingFilterDisabledDefault = true
+	ACLExtensionType                     = "acl"
+
+	DefaultWorkerPoolName = "cpu-worker-0"
 )
 
 var networkingType = "calico"
@@ -68,6 +71,8 @@ type GardenerConfig struct {
 	TargetSecret                        string
 	Region                              string
 	WorkerCidr                          string
+	PodsCidr                            *string
+	ServicesCidr                        *string
 	AutoScalerMin                       int
 	AutoScalerMax                       int
 	MaxSurge                            int
@@ -81,6 +86,30 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool
 	ControlPlaneFailureTolerance        *string
 	EuAccess                            bool
+	HibernationSchedules                []HibernationSchedule `db:"-"`
+	WorkerPools                         []WorkerPool          `db:"-"`
+	APIServerAllowedCidrs               []string              `db:"-"`
+}
+
+// HibernationSchedule is a recurring time window in which the shoot is hibernated
+type HibernationSchedule struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+// WorkerPool is an additional worker group created next to the default one
+type WorkerPool struct {
+	Name                string  `json:"name"`
+	MachineType         string  `json:"machineType"`
+	MachineImage        *string `json:"machineImage,omitempty"`
+	MachineImageVersion *string `json:"machineImageVersion,omitempty"`
+	DiskType            *string `json:"diskType,omitempty"`
+	VolumeSizeGB        *int    `json:"volumeSizeGB,omitempty"`
+	AutoScalerMin       int     `json:"autoScalerMin"`
+	AutoScalerMax       int     `json:"autoScalerMax"`
+	MaxSurge            int     `json:"maxSurge"`
+	MaxUnavailable      int     `json:"maxUnavailable"`
 }
 
 type ExtensionProviderConfig struct {
@@ -104,6 +133,30 @@ type ShootIssuers struct {
 	Enabled bool `json:"enabled"`
 }
 
+// ACLExtensionProviderConfig is the configuration of the extension restricting the access to the API server of the shoot
+type ACLExtensionProviderConfig struct {
+	Rule ACLRule `json:"rule"`
+}
+
+type ACLRule struct {
+	// Action is applied to the connections matching the rule
+	Action string `json:"action"`
+	// Type is the property of the connection compared with the ranges
+	Type string `json:"type"`
+	// Cidrs are the ranges matched by the rule
+	Cidrs []string `json:"cidrs"`
+}
+
+func NewACLConfig(cidrs []string) *ACLExtensionProviderConfig {
+	return &ACLExtensionProviderConfig{
+		Rule: ACLRule{
+			Action: "ALLOW",
+			Type:   "remote_ip",
+			Cidrs:  cidrs,
+		},
+	}
+}
+
 func NewDNSConfig() *ExtensionProviderConfig {
 	return &ExtensionProviderConfig{
 		ApiVersion:             "service.dns.extensions.gardener.cloud/v1alpha1",
@@ -190,8 +243,10 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				EnableStaticTokenKubeconfig: util.BoolPtr(true),
 			},
 			Networking: &gardener_types.Networking{
-				Type:  &networkingType, // Default value - we may consider adding it to API (if Hydroform will support it)
-				Nodes: util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c)),
+				Type:     &networkingType, // Default value - we may consider adding it to API (if Hydroform will support it)
+				Nodes:    util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c)),
+				Pods:     c.PodsCidr,
+				Services: c.ServicesCidr,
 			},
 			Purpose:           purpose,
 			ExposureClassName: exposureClassName,
@@ -208,9 +263,18 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				{Type: ShootNetworkingFilterExtensionType, Disabled: util.DefaultBoolIfNil(c.ShootNetworkingFilterDisabled, util.BoolPtr(ShootNetworkingFilterDisabledDefault))},
 			},
 			ControlPlane: controlPlane,
+			Hibernation:  gardenerHibernationConfig(c.HibernationSchedules),
 		},
 	}
 
+	if len(c.APIServerAllowedCidrs) > 0 {
+		aclExtension, err := gardenerACLExtension(c.APIServerAllowedCidrs)
+		if err != nil {
+			return nil, err
+		}
+		shoot.Spec.Extensions = append(shoot.Spec.Extensions, aclExtension)
+	}
+
 	err := c.GardenerProviderConfig.ExtendShootConfig(c, shoot)
 	if err != nil {
 		return nil, err.Append("error extending shoot config with Provider")
@@ -233,6 +297,42 @@ func gardenerOidcConfig(oidcConfig *OIDCConfig) *gardener_types.OIDCConfig {
 	return nil
 }
 
+func gardenerHibernationConfig(schedules []HibernationSchedule) *gardener_types.Hibernation {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	return &gardener_types.Hibernation{
+		Schedules: gardenerHibernationSchedules(schedules),
+	}
+}
+
+func gardenerHibernationSchedules(schedules []HibernationSchedule) []gardener_types.HibernationSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	gardenerSchedules := make([]gardener_types.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		gardenerSchedules = append(gardenerSchedules, gardener_types.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+
+	return gardenerSchedules
+}
+
+func gardenerACLExtension(cidrs []string) (gardener_types.Extension, apperrors.AppError) {
+	jsonACLConfig, encodingErr := json.Marshal(NewACLConfig(cidrs))
+	if encodingErr != nil {
+		return gardener_types.Extension{}, apperrors.Internal("error encoding ACL extension config: %s", encodingErr.Error())
+	}
+
+	return gardener_types.Extension{Type: ACLExtensionType, ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonACLConfig}}, nil
+}
+
 func gardenerDnsConfig(dnsConfig *DNSConfig) *gardener_types.DNS {
 	dns := gardener_types.DNS{}
 
@@ -363,7 +463,7 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -512,7 +612,7 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -611,7 +711,7 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -676,7 +776,7 @@ func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig,
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = c.input.CloudProfileName
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	openStackInfra := NewOpenStackInfrastructure(c.input.FloatingPoolName, gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(openStackInfra)
@@ -700,27 +800,100 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 	return nil
 }
 
-func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
+func getWorkersConfig(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig.defaultWorkerPool(), zones)}
+
+	for _, pool := range gardenerConfig.WorkerPools {
+		workers = append(workers, getWorkerConfig(pool, zones))
+	}
+
+	return workers
+}
+
+func getWorkerConfig(pool WorkerPool, zones []string) gardener_types.Worker {
 	worker := gardener_types.Worker{
-		Name:           "cpu-worker-0",
-		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxSurge)),
-		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxUnavailable)),
-		Machine:        getMachineConfig(gardenerConfig),
-		Maximum:        int32(gardenerConfig.AutoScalerMax),
-		Minimum:        int32(gardenerConfig.AutoScalerMin),
+		Name:           pool.Name,
+		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(pool.MaxSurge)),
+		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(pool.MaxUnavailable)),
+		Machine:        getMachineConfig(pool),
+		Maximum:        int32(pool.AutoScalerMax),
+		Minimum:        int32(pool.AutoScalerMin),
 		Zones:          zones,
 	}
 
-	if gardenerConfig.DiskType != nil && gardenerConfig.VolumeSizeGB != nil {
+	if pool.DiskType != nil && pool.VolumeSizeGB != nil {
 		worker.Volume = &gardener_types.Volume{
-			Type:       gardenerConfig.DiskType,
-			VolumeSize: fmt.Sprintf("%dGi", *gardenerConfig.VolumeSizeGB),
+			Type:       pool.DiskType,
+			VolumeSize: fmt.Sprintf("%dGi", *pool.VolumeSizeGB),
 		}
 	}
 
 	return worker
 }
 
+func (c GardenerConfig) defaultWorkerPool() WorkerPool {
+	return WorkerPool{
+		Name:                DefaultWorkerPoolName,
+		MachineType:         c.MachineType,
+		MachineImage:        c.MachineImage,
+		MachineImageVersion: c.MachineImageVersion,
+		DiskType:            c.DiskType,
+		VolumeSizeGB:        c.VolumeSizeGB,
+		AutoScalerMin:       c.AutoScalerMin,
+		AutoScalerMax:       c.AutoScalerMax,
+		MaxSurge:            c.MaxSurge,
+		MaxUnavailable:      c.MaxUnavailable,
+	}
+}
+
+// updateWorkerPools makes the additional worker groups of the shoot match the requested pools.
+// The default worker group is always kept, new pools inherit its zones.
+func updateWorkerPools(pools []WorkerPool, shoot *gardener_types.Shoot) {
+	defaultWorker := shoot.Spec.Provider.Workers[0]
+	workers := []gardener_types.Worker{defaultWorker}
+
+	for _, pool := range pools {
+		worker, found := findWorker(shoot.Spec.Provider.Workers[1:], pool.Name)
+		if !found {
+			workers = append(workers, getWorkerConfig(pool, defaultWorker.Zones))
+			continue
+		}
+
+		worker.MaxSurge = util.IntOrStringPtr(intstr.FromInt(pool.MaxSurge))
+		worker.MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(pool.MaxUnavailable))
+		worker.Machine.Type = pool.MachineType
+		worker.Maximum = int32(pool.AutoScalerMax)
+		worker.Minimum = int32(pool.AutoScalerMin)
+		if util.NotNilOrEmpty(pool.MachineImage) {
+			if worker.Machine.Image == nil {
+				worker.Machine.Image = &gardener_types.ShootMachineImage{}
+			}
+			worker.Machine.Image.Name = *pool.MachineImage
+		}
+		if util.NotNilOrEmpty(pool.MachineImageVersion) && worker.Machine.Image != nil {
+			worker.Machine.Image.Version = pool.MachineImageVersion
+		}
+		if pool.DiskType != nil && pool.VolumeSizeGB != nil {
+			worker.Volume = &gardener_types.Volume{
+				Type:       pool.DiskType,
+				VolumeSize: fmt.Sprintf("%dGi", *pool.VolumeSizeGB),
+			}
+		}
+		workers = append(workers, worker)
+	}
+
+	shoot.Spec.Provider.Workers = workers
+}
+
+func findWorker(workers []gardener_types.Worker, name string) (gardener_types.Worker, bool) {
+	for _, worker := range workers {
+		if worker.Name == name {
+			return worker, true
+		}
+	}
+	return gardener_types.Worker{}, false
+}
+
 func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 
 	if upgradeConfig.KubernetesVersion != "" {
@@ -790,6 +963,37 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Extensions = upgradedExtensions
 	}
 
+	// nil leaves the additional worker pools untouched, an empty list removes them
+	if upgradeConfig.WorkerPools != nil {
+		updateWorkerPools(upgradeConfig.WorkerPools, shoot)
+	}
+
+	// nil leaves the schedules untouched, an empty list removes them
+	if upgradeConfig.HibernationSchedules != nil {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardener_types.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Schedules = gardenerHibernationSchedules(upgradeConfig.HibernationSchedules)
+	}
+
+	// nil leaves the API server access untouched, an empty list removes the restriction
+	if upgradeConfig.APIServerAllowedCidrs != nil {
+		upgradedExtensions := []gardener_types.Extension{}
+		for _, extension := range shoot.Spec.Extensions {
+			if extension.Type != ACLExtensionType {
+				upgradedExtensions = append(upgradedExtensions, extension)
+			}
+		}
+		if len(upgradeConfig.APIServerAllowedCidrs) > 0 {
+			aclExtension, err := gardenerACLExtension(upgradeConfig.APIServerAllowedCidrs)
+			if err != nil {
+				return err
+			}
+			upgradedExtensions = append(upgradedExtensions, aclExtension)
+		}
+		shoot.Spec.Extensions = upgradedExtensions
+	}
+
 	// Needed for upgrade to Kubernetes 1.25
 	shoot.Spec.Kubernetes.AllowPrivilegedContainers = nil
 
@@ -804,16 +1008,16 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 	return nil
 }
 
-func getMachineConfig(config GardenerConfig) gardener_types.Machine {
+func getMachineConfig(pool WorkerPool) gardener_types.Machine {
 	machine := gardener_types.Machine{
-		Type: config.MachineType,
+		Type: pool.MachineType,
 	}
-	if util.NotNilOrEmpty(config.MachineImage) {
+	if util.NotNilOrEmpty(pool.MachineImage) {
 		machine.Image = &gardener_types.ShootMachineImage{
-			Name: *config.MachineImage,
+			Name: *pool.MachineImage,
 		}
-		if util.NotNilOrEmpty(config.MachineImageVersion) {
-			machine.Image.Version = config.MachineImageVersion
+		if util.NotNilOrEmpty(pool.MachineImageVersion) {
+			machine.Image.Version = pool.MachineImageVersion
 		}
 	}
 	return machine
@@ -688,6 +688,136 @@ func TestEditShootConfig(t *testing.T) {
 				return shoot
 			}(expectedShoot),
 		},
+		{description: "should set hibernation schedules",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{
+					{Start: util.StringPtr("00 20 * * 1-5"), End: util.StringPtr("00 07 * * 1-5"), Location: util.StringPtr("Europe/Berlin")},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: initialShoot.DeepCopy(),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Schedules: []gardener_types.HibernationSchedule{
+						{Start: util.StringPtr("00 20 * * 1-5"), End: util.StringPtr("00 07 * * 1-5"), Location: util.StringPtr("Europe/Berlin")},
+					},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove hibernation schedules and keep hibernation state",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled:   util.BoolPtr(true),
+					Schedules: []gardener_types.HibernationSchedule{{Start: util.StringPtr("00 20 * * *")}},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{Enabled: util.BoolPtr(true)}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should restrict the API server access",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.APIServerAllowedCidrs = []string{"203.0.113.0/24"}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: initialShoot.DeepCopy(),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardener_types.Extension{
+					Type:           ACLExtensionType,
+					ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"rule":{"action":"ALLOW","type":"remote_ip","cidrs":["203.0.113.0/24"]}}`)},
+				})
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove the API server access restriction",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.APIServerAllowedCidrs = []string{}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardener_types.Extension{
+					Type:           ACLExtensionType,
+					ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"rule":{"action":"ALLOW","type":"remote_ip","cidrs":["203.0.113.0/24"]}}`)},
+				})
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = []gardener_types.Extension{}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should add, resize and remove worker pools",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.WorkerPools = []WorkerPool{
+					{Name: "memory", MachineType: "m5.4xlarge", AutoScalerMin: 2, AutoScalerMax: 5, MaxSurge: 1},
+					{Name: "extra", MachineType: "m5.xlarge", DiskType: util.StringPtr("SSD"), VolumeSizeGB: util.IntPtr(50), AutoScalerMin: 1, AutoScalerMax: 2, MaxSurge: 1},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Zones = zones
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").WithMachineType("m5.2xlarge").WithMinMax(1, 2).WithZones(zones...).ToWorker(),
+					testkit.NewTestWorker("gpu").WithMachineType("g4dn.xlarge").WithMinMax(1, 1).WithZones(zones...).ToWorker(),
+				)
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Zones = zones
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").WithMachineType("m5.4xlarge").WithMinMax(2, 5).WithMaxSurge(1).WithZones(zones...).ToWorker(),
+					gardener_types.Worker{
+						Name:           "extra",
+						MaxSurge:       util.IntOrStringPtr(intstr.FromInt(1)),
+						MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(0)),
+						Machine:        gardener_types.Machine{Type: "m5.xlarge"},
+						Volume:         &gardener_types.Volume{Type: util.StringPtr("SSD"), VolumeSize: "50Gi"},
+						Maximum:        2,
+						Minimum:        1,
+						Zones:          zones,
+					},
+				)
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should keep worker pools when none are requested",
+			provider:      "gcp",
+			upgradeConfig: fixGardenerConfig("gcp", gcpProviderConfig),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").WithMachineType("m5.2xlarge").WithMinMax(1, 2).ToWorker(),
+				)
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").WithMachineType("m5.2xlarge").WithMinMax(1, 2).ToWorker(),
+				)
+				return shoot
+			}(expectedShoot),
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -725,6 +855,93 @@ func TestEditShootConfig(t *testing.T) {
 	}
 }
 
+func TestGardenerConfig_ToShootTemplateWithWorkerPools(t *testing.T) {
+	// given
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+	gardenerConfig.WorkerPools = []WorkerPool{
+		{
+			Name:                "memory",
+			MachineType:         "m5.4xlarge",
+			MachineImage:        util.StringPtr("gardenlinux"),
+			MachineImageVersion: util.StringPtr("25.0.0"),
+			AutoScalerMin:       0,
+			AutoScalerMax:       4,
+			MaxSurge:            1,
+			MaxUnavailable:      0,
+		},
+	}
+
+	// when
+	template, err := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []gardener_types.Worker{
+		fixWorker(zones),
+		{
+			Name:           "memory",
+			MaxSurge:       util.IntOrStringPtr(intstr.FromInt(1)),
+			MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(0)),
+			Machine: gardener_types.Machine{
+				Type: "m5.4xlarge",
+				Image: &gardener_types.ShootMachineImage{
+					Name:    "gardenlinux",
+					Version: util.StringPtr("25.0.0"),
+				},
+			},
+			Maximum: 4,
+			Minimum: 0,
+			Zones:   zones,
+		},
+	}, template.Spec.Provider.Workers)
+}
+
+func TestGardenerConfig_ToShootTemplateWithNetworking(t *testing.T) {
+	// given
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+	gardenerConfig.PodsCidr = util.StringPtr("10.96.0.0/13")
+	gardenerConfig.ServicesCidr = util.StringPtr("10.104.0.0/13")
+
+	// when
+	template, err := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &gardener_types.Networking{
+		Type:     &networkingType,
+		Nodes:    util.StringPtr("10.10.10.10/255"),
+		Pods:     util.StringPtr("10.96.0.0/13"),
+		Services: util.StringPtr("10.104.0.0/13"),
+	}, template.Spec.Networking)
+}
+
+func TestGardenerConfig_ToShootTemplateWithAPIServerAllowedCidrs(t *testing.T) {
+	// given
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpGardenerProvider)
+	gardenerConfig.APIServerAllowedCidrs = []string{"203.0.113.0/24", "198.51.100.7/32"}
+
+	// when
+	template, err := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, template.Spec.Extensions, gardener_types.Extension{
+		Type:           ACLExtensionType,
+		ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"rule":{"action":"ALLOW","type":"remote_ip","cidrs":["203.0.113.0/24","198.51.100.7/32"]}}`)},
+	})
+}
+
 func fixGardenerConfig(provider string, providerCfg GardenerProviderConfig) GardenerConfig {
 	return GardenerConfig{
 		ID:                                  "",
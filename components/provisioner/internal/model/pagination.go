@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// PageCursor points at the last element of a returned page. The next page starts right after it.
+type PageCursor struct {
+	Timestamp time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+// RuntimeFilter narrows down listed Runtimes. Nil fields are not taken into account.
+type RuntimeFilter struct {
+	Tenant       string
+	Name         *string
+	SubAccountID *string
+	Provider     *string
+	Region       *string
+}
+
+// OperationFilter narrows down listed operations. Nil fields are not taken into account.
+type OperationFilter struct {
+	Tenant    string
+	RuntimeID *string
+	Type      *OperationType
+	State     *OperationState
+	Since     *time.Time
+}
+
+// ListedCluster is a Cluster returned by the list query together with its last operation, nil if it has none.
+type ListedCluster struct {
+	Cluster
+	LastOperation *Operation
+}
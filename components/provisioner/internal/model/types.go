@@ -15,6 +15,8 @@ const (
 	InProgress OperationState = "IN_PROGRESS"
 	Succeeded  OperationState = "SUCCEEDED"
 	Failed     OperationState = "FAILED"
+	Canceling  OperationState = "CANCELING"
+	Canceled   OperationState = "CANCELED"
 )
 
 type OperationType string
@@ -28,6 +30,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -53,6 +56,7 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
@@ -77,6 +81,7 @@ type LastError struct {
 	ErrMessage string
 	Reason     string
 	Component  string
+	Retriable  bool
 }
 
 type Operation struct {
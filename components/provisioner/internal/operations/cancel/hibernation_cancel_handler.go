@@ -0,0 +1,35 @@
+package cancel
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+//go:generate mockery --name=ClusterWaker
+type ClusterWaker interface {
+	WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
+}
+
+// HibernationCancelHandler wakes up the shoot whose hibernation was canceled
+type HibernationCancelHandler struct {
+	waker ClusterWaker
+}
+
+func NewHibernationCancelHandler(waker ClusterWaker) *HibernationCancelHandler {
+	return &HibernationCancelHandler{
+		waker: waker,
+	}
+}
+
+func (h HibernationCancelHandler) HandleCancel(_ model.Operation, cluster model.Cluster) error {
+	err := h.waker.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		if err.Code() == apperrors.CodeBadRequest {
+			// the hibernation is not enabled in the shoot, there is nothing to revert
+			return nil
+		}
+		return err.Append("error waking up cluster of canceled hibernation")
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package cancel
+
+import "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+type NoopCancelHandler struct {
+}
+
+func NewNoopCancelHandler() *NoopCancelHandler {
+	return &NoopCancelHandler{}
+}
+
+func (h NoopCancelHandler) HandleCancel(_ model.Operation, _ model.Cluster) error {
+	return nil
+}
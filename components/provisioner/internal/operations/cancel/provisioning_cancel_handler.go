@@ -0,0 +1,115 @@
+package cancel
+
+import (
+	"context"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/director"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+const confirmDeletionAnnotation = "confirmation.gardener.cloud/deletion"
+
+//go:generate mockery --name=GardenerClient
+type GardenerClient interface {
+	Get(ctx context.Context, name string, options v1.GetOptions) (*gardener_types.Shoot, error)
+	Update(ctx context.Context, shoot *gardener_types.Shoot, options v1.UpdateOptions) (*gardener_types.Shoot, error)
+	Delete(ctx context.Context, name string, options v1.DeleteOptions) error
+}
+
+// ProvisioningCancelHandler removes everything the canceled provisioning created: the shoot, the Runtime registered
+// in Director and the cluster record, which is marked as deleted the same way as after deprovisioning
+type ProvisioningCancelHandler struct {
+	gardenerClient GardenerClient
+	dbsFactory     dbsession.Factory
+	directorClient director.DirectorClient
+}
+
+func NewProvisioningCancelHandler(gardenerClient GardenerClient, dbsFactory dbsession.Factory, directorClient director.DirectorClient) *ProvisioningCancelHandler {
+	return &ProvisioningCancelHandler{
+		gardenerClient: gardenerClient,
+		dbsFactory:     dbsFactory,
+		directorClient: directorClient,
+	}
+}
+
+func (h ProvisioningCancelHandler) HandleCancel(_ model.Operation, cluster model.Cluster) error {
+	err := h.deleteShoot(cluster.ClusterConfig.Name)
+	if err != nil {
+		return err
+	}
+
+	return h.deleteCluster(cluster)
+}
+
+func (h ProvisioningCancelHandler) deleteShoot(shootName string) error {
+	shoot, err := h.gardenerClient.Get(context.Background(), shootName, v1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if shoot.Annotations[confirmDeletionAnnotation] != "true" {
+		if shoot.Annotations == nil {
+			shoot.Annotations = map[string]string{}
+		}
+		shoot.Annotations[confirmDeletionAnnotation] = "true"
+
+		_, err = h.gardenerClient.Update(context.Background(), shoot, v1.UpdateOptions{})
+		if err != nil {
+			return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		}
+	}
+
+	err = h.gardenerClient.Delete(context.Background(), shootName, v1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+	}
+
+	return nil
+}
+
+func (h ProvisioningCancelHandler) deleteCluster(cluster model.Cluster) error {
+	session, dberr := h.dbsFactory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return errors.Wrap(dberr, "error starting db session with transaction")
+	}
+	defer session.RollbackUnlessCommitted()
+
+	dberr = session.MarkClusterAsDeleted(cluster.ID)
+	if dberr != nil {
+		return errors.Wrap(dberr, "error marking cluster for deletion")
+	}
+
+	exists, appErr := h.directorClient.RuntimeExists(cluster.ID, cluster.Tenant)
+	if appErr != nil {
+		return errors.Wrap(appErr, "error checking Runtime exists in Director")
+	}
+
+	if exists {
+		appErr = h.directorClient.DeleteRuntime(cluster.ID, cluster.Tenant)
+		if appErr != nil {
+			return errors.Wrap(appErr, "error deleting Runtime from Director")
+		}
+	}
+
+	dberr = session.Commit()
+	if dberr != nil {
+		return errors.Wrap(dberr, "error commiting transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package cancel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	directorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/director/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	gardenerMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/cancel/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+)
+
+func TestProvisioningCancelHandler_HandleCancel(t *testing.T) {
+
+	clusterID := "cluster-id"
+	clusterName := "name"
+	tenant := "tenant"
+
+	cluster := model.Cluster{
+		ID:     clusterID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	notFoundErr := k8serrors.NewNotFound(schema.GroupResource{}, clusterName)
+
+	for _, testCase := range []struct {
+		description  string
+		gardenerFunc func(gardenerClient *gardenerMocks.GardenerClient)
+		runtimeFound bool
+	}{
+		{
+			description: "should confirm deletion, delete shoot and unregister Runtime",
+			gardenerFunc: func(gardenerClient *gardenerMocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(&gardener_types.Shoot{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}, nil)
+				gardenerClient.On("Update", context.Background(), mock.MatchedBy(func(shoot *gardener_types.Shoot) bool {
+					return shoot.Annotations[confirmDeletionAnnotation] == "true"
+				}), mock.Anything).Return(&gardener_types.Shoot{}, nil)
+				gardenerClient.On("Delete", context.Background(), clusterName, mock.Anything).Return(nil)
+			},
+			runtimeFound: true,
+		},
+		{
+			description: "should mark cluster as deleted when shoot and Runtime do not exist",
+			gardenerFunc: func(gardenerClient *gardenerMocks.GardenerClient) {
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, notFoundErr)
+			},
+		},
+		{
+			description: "should not delete shoot which is already being deleted",
+			gardenerFunc: func(gardenerClient *gardenerMocks.GardenerClient) {
+				deletionTimestamp := metav1.NewTime(time.Now())
+				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(&gardener_types.Shoot{ObjectMeta: metav1.ObjectMeta{Name: clusterName, DeletionTimestamp: &deletionTimestamp}}, nil)
+			},
+			runtimeFound: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &gardenerMocks.GardenerClient{}
+			directorClient := &directorMocks.DirectorClient{}
+			sessionFactory := &sessionMocks.Factory{}
+			session := &sessionMocks.WriteSessionWithinTransaction{}
+
+			testCase.gardenerFunc(gardenerClient)
+			sessionFactory.On("NewSessionWithinTransaction").Return(session, nil)
+			session.On("MarkClusterAsDeleted", clusterID).Return(nil)
+			session.On("Commit").Return(nil)
+			session.On("RollbackUnlessCommitted").Return()
+			directorClient.On("RuntimeExists", clusterID, tenant).Return(testCase.runtimeFound, nil)
+			if testCase.runtimeFound {
+				directorClient.On("DeleteRuntime", clusterID, tenant).Return(nil)
+			}
+
+			handler := NewProvisioningCancelHandler(gardenerClient, sessionFactory, directorClient)
+
+			// when
+			err := handler.HandleCancel(model.Operation{}, cluster)
+
+			// then
+			require.NoError(t, err)
+			gardenerClient.AssertExpectations(t)
+			directorClient.AssertExpectations(t)
+			session.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return error when failed to delete shoot", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(&gardener_types.Shoot{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}, nil)
+		gardenerClient.On("Update", context.Background(), mock.Anything, mock.Anything).Return(&gardener_types.Shoot{}, nil)
+		gardenerClient.On("Delete", context.Background(), clusterName, mock.Anything).Return(errors.New("some error"))
+
+		handler := NewProvisioningCancelHandler(gardenerClient, nil, nil)
+
+		// when
+		err := handler.HandleCancel(model.Operation{}, cluster)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "some error")
+		gardenerClient.AssertExpectations(t)
+	})
+
+	t.Run("should not commit cluster deletion when failed to unregister Runtime", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+		directorClient := &directorMocks.DirectorClient{}
+		sessionFactory := &sessionMocks.Factory{}
+		session := &sessionMocks.WriteSessionWithinTransaction{}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, notFoundErr)
+		sessionFactory.On("NewSessionWithinTransaction").Return(session, nil)
+		session.On("MarkClusterAsDeleted", clusterID).Return(nil)
+		session.On("RollbackUnlessCommitted").Return()
+		directorClient.On("RuntimeExists", clusterID, tenant).Return(true, nil)
+		directorClient.On("DeleteRuntime", clusterID, tenant).Return(dberrors.Internal("some error"))
+
+		handler := NewProvisioningCancelHandler(gardenerClient, sessionFactory, directorClient)
+
+		// when
+		err := handler.HandleCancel(model.Operation{}, cluster)
+
+		// then
+		require.Error(t, err)
+		session.AssertNotCalled(t, "Commit")
+		directorClient.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,61 @@
+package cancel
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+//go:generate mockery --name=ClusterUpgrader
+type ClusterUpgrader interface {
+	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+}
+
+// ShootUpgradeCancelHandler applies the Gardener config stored before the canceled upgrade to the shoot and the database
+type ShootUpgradeCancelHandler struct {
+	session  dbsession.ReadWriteSession
+	upgrader ClusterUpgrader
+}
+
+func NewShootUpgradeCancelHandler(session dbsession.ReadWriteSession, upgrader ClusterUpgrader) *ShootUpgradeCancelHandler {
+	return &ShootUpgradeCancelHandler{
+		session:  session,
+		upgrader: upgrader,
+	}
+}
+
+func (h ShootUpgradeCancelHandler) HandleCancel(operation model.Operation, cluster model.Cluster) error {
+	preUpgradeConfig, dberr := h.session.GetPreUpgradeGardenerConfig(operation.ID)
+	if dberr != nil {
+		return dberr.Append("error getting Gardener config from before the canceled upgrade")
+	}
+	preUpgradeConfig = withEmptyLists(preUpgradeConfig)
+
+	err := h.upgrader.UpgradeCluster(cluster.ID, preUpgradeConfig)
+	if err != nil {
+		return err.Append("error reverting shoot of canceled upgrade")
+	}
+
+	dberr = h.session.UpdateGardenerClusterConfig(preUpgradeConfig)
+	if dberr != nil {
+		return dberr.Append("error reverting Gardener config of canceled upgrade")
+	}
+
+	return nil
+}
+
+// withEmptyLists replaces the nil lists of the config from before the upgrade with empty lists. The upgrade leaves
+// the shoot untouched for a nil list, but the config from before the upgrade is complete, so nil means there were
+// no additional worker pools, hibernation schedules or allowed CIDRs, and those the upgrade added must be removed.
+func withEmptyLists(config model.GardenerConfig) model.GardenerConfig {
+	if config.WorkerPools == nil {
+		config.WorkerPools = []model.WorkerPool{}
+	}
+	if config.HibernationSchedules == nil {
+		config.HibernationSchedules = []model.HibernationSchedule{}
+	}
+	if config.APIServerAllowedCidrs == nil {
+		config.APIServerAllowedCidrs = []string{}
+	}
+	return config
+}
@@ -0,0 +1,150 @@
+package cancel
+
+import (
+	"encoding/json"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	upgraderMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/cancel/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+func TestShootUpgradeCancelHandler_HandleCancel(t *testing.T) {
+
+	operation := model.Operation{ID: "operation-id"}
+	cluster := model.Cluster{ID: "cluster-id"}
+	preUpgradeConfig := model.GardenerConfig{ClusterID: "cluster-id", Name: "name", KubernetesVersion: "1.25.3"}
+	// the config from before the upgrade had no additional pools, schedules and allowed CIDRs
+	revertedConfig := preUpgradeConfig
+	revertedConfig.WorkerPools = []model.WorkerPool{}
+	revertedConfig.HibernationSchedules = []model.HibernationSchedule{}
+	revertedConfig.APIServerAllowedCidrs = []string{}
+
+	t.Run("should apply Gardener config from before the upgrade", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		upgrader := &upgraderMocks.ClusterUpgrader{}
+
+		session.On("GetPreUpgradeGardenerConfig", operation.ID).Return(preUpgradeConfig, nil)
+		upgrader.On("UpgradeCluster", cluster.ID, revertedConfig).Return(nil)
+		session.On("UpdateGardenerClusterConfig", revertedConfig).Return(nil)
+
+		handler := NewShootUpgradeCancelHandler(session, upgrader)
+
+		// when
+		err := handler.HandleCancel(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		session.AssertExpectations(t)
+		upgrader.AssertExpectations(t)
+	})
+
+	t.Run("should not update Gardener config when failed to revert shoot", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		upgrader := &upgraderMocks.ClusterUpgrader{}
+
+		session.On("GetPreUpgradeGardenerConfig", operation.ID).Return(preUpgradeConfig, nil)
+		upgrader.On("UpgradeCluster", cluster.ID, revertedConfig).Return(apperrors.Internal("some error"))
+
+		handler := NewShootUpgradeCancelHandler(session, upgrader)
+
+		// when
+		err := handler.HandleCancel(operation, cluster)
+
+		// then
+		require.Error(t, err)
+		session.AssertNotCalled(t, "UpdateGardenerClusterConfig", mock.Anything)
+		upgrader.AssertExpectations(t)
+	})
+
+	t.Run("should remove worker pools, schedules and allowed CIDRs added by the upgrade", func(t *testing.T) {
+		// given
+		upgradedShoot := testkit.NewTestShoot("shoot").
+			InNamespace("garden-project").
+			WithWorkers(
+				testkit.NewTestWorker(model.DefaultWorkerPoolName).ToWorker(),
+				testkit.NewTestWorker("memory").WithMachineType("m5.2xlarge").ToWorker()).
+			WithExtensions([]gardener_types.Extension{{Type: model.ACLExtensionType}}).
+			ToShoot()
+		upgradedShoot.Spec.Hibernation = &gardener_types.Hibernation{
+			Schedules: []gardener_types.HibernationSchedule{{Start: util.StringPtr("00 20 * * *")}},
+		}
+		clientset := fake.NewSimpleClientset(upgradedShoot)
+		// the fake client merges the lists of the applied shoot, so the applied shoot is captured instead
+		var appliedShoot gardener_types.Shoot
+		clientset.PrependReactor("patch", "shoots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patch := action.(k8stesting.PatchAction)
+			require.NoError(t, json.Unmarshal(patch.GetPatch(), &appliedShoot))
+			return true, &appliedShoot, nil
+		})
+		shootClient := clientset.CoreV1beta1().Shoots("garden-project")
+
+		providerConfig, configErr := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west3-a"}})
+		require.NoError(t, configErr)
+		snapshot := model.GardenerConfig{
+			ClusterID:              "cluster-id",
+			Name:                   "shoot",
+			KubernetesVersion:      "1.25.3",
+			MachineType:            "m5.xlarge",
+			AutoScalerMin:          1,
+			AutoScalerMax:          3,
+			MaxSurge:               1,
+			GardenerProviderConfig: providerConfig,
+		}
+
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetPreUpgradeGardenerConfig", operation.ID).Return(snapshot, nil)
+		session.On("UpdateGardenerClusterConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+
+		handler := NewShootUpgradeCancelHandler(session, gardener.NewProvisioner("garden-project", shootClient, nil, "", ""))
+
+		// when
+		err := handler.HandleCancel(operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		session.AssertExpectations(t)
+
+		shoot := appliedShoot
+		require.Len(t, shoot.Spec.Provider.Workers, 1)
+		assert.Equal(t, model.DefaultWorkerPoolName, shoot.Spec.Provider.Workers[0].Name)
+		assert.Equal(t, "m5.xlarge", shoot.Spec.Provider.Workers[0].Machine.Type)
+		assert.Empty(t, shoot.Spec.Hibernation.Schedules)
+		for _, extension := range shoot.Spec.Extensions {
+			assert.NotEqual(t, model.ACLExtensionType, extension.Type)
+		}
+	})
+
+	t.Run("should return error when Gardener config from before the upgrade is missing", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		upgrader := &upgraderMocks.ClusterUpgrader{}
+
+		session.On("GetPreUpgradeGardenerConfig", operation.ID).Return(model.GardenerConfig{}, dberrors.NotFound("not found"))
+
+		handler := NewShootUpgradeCancelHandler(session, upgrader)
+
+		// when
+		err := handler.HandleCancel(operation, cluster)
+
+		// then
+		require.Error(t, err)
+		upgrader.AssertNotCalled(t, "UpgradeCluster")
+	})
+}
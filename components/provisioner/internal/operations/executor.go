@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/director"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	"github.com/sirupsen/logrus"
 )
@@ -27,6 +28,7 @@ func NewExecutor(
 	operation model.OperationType,
 	stages map[model.OperationStage]Step,
 	failureHandler FailureHandler,
+	cancelHandler CancelHandler,
 	directorClient director.DirectorClient) *Executor {
 
 	return &Executor{
@@ -34,6 +36,7 @@ func NewExecutor(
 		stages:         stages,
 		operation:      operation,
 		failureHandler: failureHandler,
+		cancelHandler:  cancelHandler,
 		log:            logrus.WithFields(logrus.Fields{"Component": "Executor", "OperationType": operation}),
 		directorClient: directorClient,
 	}
@@ -44,6 +47,7 @@ type Executor struct {
 	stages         map[model.OperationStage]Step
 	operation      model.OperationType
 	failureHandler FailureHandler
+	cancelHandler  CancelHandler
 	directorClient director.DirectorClient
 
 	log logrus.FieldLogger
@@ -62,7 +66,7 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 
 	log = log.WithField("RuntimeId", operation.ClusterID)
 
-	if operation.State != model.InProgress {
+	if operation.State != model.InProgress && operation.State != model.Canceling {
 		log.Infof("Operation not InProgress. State: %s", operation.State)
 		return ProcessingResult{Requeue: false}
 	}
@@ -76,6 +80,11 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	log = log.WithField("ShootName", cluster.ClusterConfig.Name)
 
 	if operation.Type == e.operation {
+		if operation.State == model.Canceling {
+			e.cancel(operation, cluster, log)
+			return ProcessingResult{Requeue: false}
+		}
+
 		requeue, delay, err := e.process(operation, cluster, log)
 		e.updateOperationLastError(log, operation.ID, err)
 		if err != nil {
@@ -83,12 +92,21 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 			if errors.As(err, &nonRecoverable) {
 				log.Errorf("unrecoverable error occurred while processing operation: %s", err.Error())
 				e.handleOperationFailure(operation, cluster, log)
-				e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, time.Now())
+				if !e.finishOperation(log, operation.ID, nonRecoverable.Error(), model.InProgress, model.Failed) {
+					log.Infof("Operation canceled before it failed")
+					return ProcessingResult{Requeue: true}
+				}
 				e.setRuntimeStatusCondition(log, cluster.ID, cluster.Tenant)
 
 				return ProcessingResult{Requeue: false}
 			}
 
+			retriable := RetriableError{}
+			if errors.As(err, &retriable) {
+				log.Warnf("retriable error occurred while processing operation: %s", err.Error())
+				return ProcessingResult{Requeue: true, Delay: retriable.Delay()}
+			}
+
 			return ProcessingResult{Requeue: true, Delay: defaultDelay}
 		}
 
@@ -146,14 +164,49 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		if result.Delay > 0 {
 			return true, result.Delay, nil
 		}
+
+		if e.cancelRequested(operation.ID, log) {
+			log.Infof("Operation canceled, stopping before the next step")
+			return true, 0, nil
+		}
 	}
 
 	logger.Infof("Setting operation to succeeded")
-	e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, time.Now())
+	if !e.finishOperation(logger, operation.ID, "Operation succeeded", model.InProgress, model.Succeeded) {
+		logger.Infof("Operation canceled before it succeeded")
+		return true, 0, nil
+	}
 
 	return false, 0, nil
 }
 
+func (e *Executor) cancelRequested(operationID string, log logrus.FieldLogger) bool {
+	operation, err := e.dbSession.GetOperation(operationID)
+	if err != nil {
+		log.Warnf("error checking if operation was canceled: %s", err.Error())
+		return false
+	}
+
+	return operation.State == model.Canceling
+}
+
+func (e *Executor) cancel(operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
+	message := "Operation canceled"
+
+	log.Infof("Reverting changes of canceled operation")
+	err := retry.Do(func() error {
+		return e.cancelHandler.HandleCancel(operation, cluster)
+	}, retry.Attempts(5))
+	e.updateOperationLastError(log, operation.ID, err)
+	if err != nil {
+		log.Errorf("error reverting changes of canceled operation: %s", err.Error())
+		message = "Operation canceled, reverting its changes failed"
+	}
+
+	log.Infof("Setting operation to canceled")
+	e.finishOperation(log, operation.ID, message, model.Canceling, model.Canceled)
+}
+
 func (e *Executor) timeoutReached(operation model.Operation, timeout time.Duration) bool {
 
 	lastTimestamp := operation.StartTimestamp
@@ -175,13 +228,22 @@ func (e *Executor) handleOperationFailure(operation model.Operation, cluster mod
 	}
 }
 
-func (e *Executor) updateOperationStatus(log logrus.FieldLogger, id, message string, state model.OperationState, t time.Time) {
+// finishOperation sets the final state of the operation, returns false if the operation left the expected state in the meantime
+func (e *Executor) finishOperation(log logrus.FieldLogger, id, message string, expectedState, state model.OperationState) bool {
+	stateChanged := false
 	err := retry.Do(func() error {
-		return e.dbSession.UpdateOperationState(id, message, state, t)
+		dberr := e.dbSession.CompareAndSetOperationState(id, message, expectedState, state, time.Now())
+		if dberr != nil && dberr.Code() == dberrors.CodeNotFound {
+			stateChanged = true
+			return nil
+		}
+		return dberr
 	}, retry.Attempts(5))
 	if err != nil {
 		log.Infof("Cannot set operation status to %s: %s", state, err.Error())
 	}
+
+	return !stateChanged
 }
 
 func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, runErr error) {
@@ -193,11 +255,12 @@ func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, r
 			ErrMessage: runErr.Error(),
 			Reason:     string(appErr.Reason()),
 			Component:  string(appErr.Component()),
+			Retriable:  errors.As(runErr, &RetriableError{}),
 		}
 	}
 
 	err := retry.Do(func() error {
-		return e.dbSession.UpdateOperationLastError(id, lastErr.ErrMessage, lastErr.Reason, lastErr.Component)
+		return e.dbSession.UpdateOperationLastError(id, lastErr.ErrMessage, lastErr.Reason, lastErr.Component, lastErr.Retriable)
 	}, retry.Attempts(5))
 
 	if err != nil {
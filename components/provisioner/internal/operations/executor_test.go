@@ -50,9 +50,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
 		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
+		dbSession.On("CompareAndSetOperationState", operationId, "Operation succeeded", model.InProgress, model.Succeeded, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "", false).Return(nil)
 
 		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 10*time.Second, 10*time.Second)
 
@@ -62,7 +62,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &MockCancelHandler{}, directorClient)
 
 		// when
 		result := executor.Execute(operationId)
@@ -78,7 +78,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
-		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner), false).Return(nil)
 
 		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, time.Second*10)
 
@@ -88,7 +88,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &MockCancelHandler{}, directorClient)
 
 		// when
 		result := executor.Execute(operationId)
@@ -98,15 +98,43 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		assert.True(t, mockStage.called)
 	})
 
+	t.Run("should requeue operation with error delay and mark last error as retriable if Retriable error occurred", func(t *testing.T) {
+		// given
+		runErr := NewRetriableError(apperrors.External("gardener error").SetComponent(apperrors.ErrGardener).SetReason("ERR_INFRA_QUOTA_EXCEEDED"), 5*time.Minute)
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, "gardener error", "ERR_INFRA_QUOTA_EXCEEDED", string(apperrors.ErrGardener), true).Return(nil)
+
+		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &MockCancelHandler{}, directorClient)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.Equal(t, true, result.Requeue)
+		assert.Equal(t, 5*time.Minute, result.Delay)
+		assert.True(t, mockStage.called)
+		dbSession.AssertExpectations(t)
+	})
+
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred", func(t *testing.T) {
 		// given
 		runErr := NewNonRecoverableError(apperrors.External("gardener error").SetComponent(apperrors.ErrGardener).SetReason("ERR_INFRA_QUOTA_EXCEEDED").Append("something"))
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
-		dbSession.On("UpdateOperationState", operationId, "something, gardener error", model.Failed, mock.AnythingOfType("time.Time")).
+		dbSession.On("CompareAndSetOperationState", operationId, "something, gardener error", model.InProgress, model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationLastError", operationId, "something, gardener error", "ERR_INFRA_QUOTA_EXCEEDED", string(apperrors.ErrGardener)).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "something, gardener error", "ERR_INFRA_QUOTA_EXCEEDED", string(apperrors.ErrGardener), false).Return(nil)
 
 		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, 10*time.Second)
 
@@ -119,7 +147,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, &MockCancelHandler{}, directorClient)
 
 		// when
 		result := executor.Execute(operationId)
@@ -136,9 +164,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
-		dbSession.On("UpdateOperationState", operationId, "kyma installation: error", model.Failed, mock.AnythingOfType("time.Time")).
+		dbSession.On("CompareAndSetOperationState", operationId, "kyma installation: error", model.InProgress, model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationLastError", operationId, "kyma installation: error", "istio", string(apperrors.ErrKymaInstaller)).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "kyma installation: error", "istio", string(apperrors.ErrKymaInstaller), false).Return(nil)
 
 		mockStage := NewErrorStep(model.StartingInstallation, runErr, 10*time.Second)
 
@@ -151,7 +179,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, &MockCancelHandler{}, directorClient)
 
 		// when
 		result := executor.Execute(operationId)
@@ -169,9 +197,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
 		dbSession.On("TransitionOperation", operationId, "Operation in progress", model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationState", operationId, "error: timeout while processing operation", model.Failed, mock.AnythingOfType("time.Time")).
+		dbSession.On("CompareAndSetOperationState", operationId, "error: timeout while processing operation", model.InProgress, model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
-		dbSession.On("UpdateOperationLastError", operationId, "error: timeout while processing operation", string(apperrors.ErrProvisionerTimeout), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "error: timeout while processing operation", string(apperrors.ErrProvisionerTimeout), string(apperrors.ErrProvisioner), false).Return(nil)
 
 		mockStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 0*time.Second)
 
@@ -184,7 +212,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, &MockCancelHandler{}, directorClient)
 
 		// when
 		result := executor.Execute(operationId)
@@ -194,6 +222,111 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		assert.False(t, mockStage.called)
 		assert.True(t, failureHandler.called)
 	})
+
+	t.Run("should stop before the next step when operation was canceled", func(t *testing.T) {
+		// given
+		lastTransition := time.Now()
+		runningOperation := operation
+		runningOperation.LastTransition = &lastTransition
+
+		canceledOperation := runningOperation
+		canceledOperation.State = model.Canceling
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(runningOperation, nil).Once()
+		dbSession.On("GetOperation", operationId).Return(canceledOperation, nil).Once()
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("TransitionOperation", operationId, "Operation in progress. Stage ConnectRuntimeAgent", model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "", false).Return(nil)
+
+		installStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 10*time.Second)
+		connectStage := NewMockStep(model.ConnectRuntimeAgent, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: installStage,
+			model.ConnectRuntimeAgent:    connectStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &MockCancelHandler{}, directorClient)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.True(t, result.Requeue)
+		assert.Equal(t, time.Duration(0), result.Delay)
+		assert.True(t, installStage.called)
+		assert.False(t, connectStage.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should revert changes and set operation to canceled", func(t *testing.T) {
+		// given
+		canceledOperation := operation
+		canceledOperation.State = model.Canceling
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(canceledOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "", false).Return(nil)
+		dbSession.On("CompareAndSetOperationState", operationId, "Operation canceled", model.Canceling, model.Canceled, mock.AnythingOfType("time.Time")).
+			Return(nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+		cancelHandler := &MockCancelHandler{}
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), cancelHandler, directorClient)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.False(t, mockStage.called)
+		assert.True(t, cancelHandler.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should set operation to canceled when reverting changes failed", func(t *testing.T) {
+		// given
+		canceledOperation := operation
+		canceledOperation.State = model.Canceling
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(canceledOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("UpdateOperationLastError", operationId, mock.AnythingOfType("string"), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner), false).Return(nil)
+		dbSession.On("CompareAndSetOperationState", operationId, "Operation canceled, reverting its changes failed", model.Canceling, model.Canceled, mock.AnythingOfType("time.Time")).
+			Return(nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+		cancelHandler := &MockCancelHandler{err: fmt.Errorf("error")}
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), cancelHandler, directorClient)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.True(t, cancelHandler.called)
+		dbSession.AssertExpectations(t)
+	})
 }
 
 type mockStep struct {
@@ -291,3 +424,14 @@ func TestConvertToAppError(t *testing.T) {
 		assert.Equal(t, expectK8sErr, apperrK8sErr)
 	})
 }
+
+type MockCancelHandler struct {
+	err error
+
+	called bool
+}
+
+func (m *MockCancelHandler) HandleCancel(operation model.Operation, cluster model.Cluster) error {
+	m.called = true
+	return m.err
+}
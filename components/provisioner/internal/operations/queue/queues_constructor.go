@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/installation"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/cancel"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
@@ -56,6 +57,7 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
 }
 
 func CreateProvisioningQueue(
@@ -68,6 +70,7 @@ func CreateProvisioningQueue(
 	shootClient gardener_apis.ShootInterface,
 	secretsClient v1core.SecretInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
+	shootRetryConfig provisioning.ShootRetry,
 	k8sClientProvider k8s.K8sClientProvider) OperationQueue {
 
 	waitForAgentToConnectStep := provisioning.NewWaitForAgentToConnectStep(ccClientConstructor, configurator, model.FinishedStage, timeouts.AgentConnection, directorClient)
@@ -75,7 +78,7 @@ func CreateProvisioningQueue(
 	waitForInstallStep := provisioning.NewWaitForInstallationStep(installationClient, configureAgentStep.Name(), timeouts.Installation, factory.NewWriteSession())
 	installStep := provisioning.NewInstallKymaStep(installationClient, waitForInstallStep.Name(), timeouts.InstallationTriggering)
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, installStep.Name(), timeouts.BindingsCreation)
-	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), gardener.NewKubeconfigProvider(secretsClient), createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
+	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), gardener.NewKubeconfigProvider(secretsClient), shootRetryConfig, createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
 	waitForClusterDomainStep := provisioning.NewWaitForClusterDomainStep(shootClient, directorClient, waitForClusterCreationStep.Name(), timeouts.ClusterDomains)
 
 	provisionSteps := map[model.OperationStage]operations.Step{
@@ -93,6 +96,7 @@ func CreateProvisioningQueue(
 		model.Provision,
 		provisionSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewProvisioningCancelHandler(shootClient, factory, directorClient),
 		directorClient,
 	)
 
@@ -106,12 +110,13 @@ func CreateProvisioningNoInstallQueue(
 	shootClient gardener_apis.ShootInterface,
 	secretsClient v1core.SecretInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
+	shootRetryConfig provisioning.ShootRetry,
 	k8sClientProvider k8s.K8sClientProvider,
 	configurator runtime.Configurator) OperationQueue {
 
 	configureAgentStep := provisioning.NewConnectAgentStep(configurator, model.FinishedStage, timeouts.AgentConfiguration)
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, configureAgentStep.Name(), timeouts.BindingsCreation)
-	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), gardener.NewKubeconfigProvider(secretsClient), createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
+	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), gardener.NewKubeconfigProvider(secretsClient), shootRetryConfig, createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
 	waitForClusterDomainStep := provisioning.NewWaitForClusterDomainStep(shootClient, directorClient, waitForClusterCreationStep.Name(), timeouts.ClusterDomains)
 
 	provisionNoInstallSteps := map[model.OperationStage]operations.Step{
@@ -126,6 +131,7 @@ func CreateProvisioningNoInstallQueue(
 		model.ProvisionNoInstall,
 		provisionNoInstallSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewProvisioningCancelHandler(shootClient, factory, directorClient),
 		directorClient,
 	)
 
@@ -152,6 +158,7 @@ func CreateUpgradeQueue(
 		model.Upgrade,
 		upgradeSteps,
 		failure.NewUpgradeFailureHandler(factory.NewWriteSession()),
+		cancel.NewNoopCancelHandler(),
 		directorClient,
 	)
 
@@ -183,6 +190,7 @@ func CreateDeprovisioningQueue(
 		model.Deprovision,
 		deprovisioningSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewNoopCancelHandler(),
 		directorClient,
 	)
 
@@ -209,6 +217,7 @@ func CreateDeprovisioningNoInstallQueue(
 		model.DeprovisionNoInstall,
 		deprovisioningNoInstallSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewNoopCancelHandler(),
 		directorClient,
 	)
 
@@ -223,6 +232,7 @@ func CreateShootUpgradeQueue(
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	secretsClient v1core.SecretInterface,
+	provisioner cancel.ClusterUpgrader,
 ) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, model.FinishedStage, timeouts.BindingsCreation)
@@ -240,6 +250,7 @@ func CreateShootUpgradeQueue(
 		model.UpgradeShoot,
 		upgradeSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewShootUpgradeCancelHandler(factory.NewReadWriteSession(), provisioner),
 		directorClient,
 	)
 
@@ -250,7 +261,8 @@ func CreateHibernationQueue(
 	timeouts HibernationTimeouts,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
-	shootClient gardener_apis.ShootInterface) OperationQueue {
+	shootClient gardener_apis.ShootInterface,
+	provisioner cancel.ClusterWaker) OperationQueue {
 
 	waitForHibernation := hibernation.NewWaitForHibernationStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterHibernation)
 
@@ -263,8 +275,33 @@ func CreateHibernationQueue(
 		model.Hibernate,
 		hibernationSteps,
 		failure.NewNoopFailureHandler(),
+		cancel.NewHibernationCancelHandler(provisioner),
 		directorClient,
 	)
 
 	return NewQueue(hibernateClusterExecutor)
 }
+
+func CreateWakeUpQueue(
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: waitForWakeUp,
+	}
+
+	wakeUpClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		cancel.NewNoopCancelHandler(),
+		directorClient,
+	)
+
+	return NewQueue(wakeUpClusterExecutor)
+}
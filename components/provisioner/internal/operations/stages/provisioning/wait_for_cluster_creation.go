@@ -3,11 +3,12 @@ package provisioning
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
@@ -17,10 +18,20 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const retryAttemptsAnnotation = "provisioner.kyma-project.io/retry-attempts"
+
+// ShootRetry configures how many times the Shoot creation is retried after a transient Gardener error
+// and the delay before the first retry, the delay doubles with every attempt
+type ShootRetry struct {
+	MaxAttempts int           `envconfig:"default=3"`
+	Backoff     time.Duration `envconfig:"default=2m"`
+}
+
 type WaitForClusterCreationStep struct {
 	gardenerClient     GardenerClient
 	dbSession          dbsession.ReadWriteSession
 	kubeconfigProvider KubeconfigProvider
+	retryConfig        ShootRetry
 	nextStep           model.OperationStage
 	timeLimit          time.Duration
 }
@@ -30,11 +41,12 @@ type KubeconfigProvider interface {
 	FetchRaw(shootName string) ([]byte, error)
 }
 
-func NewWaitForClusterCreationStep(gardenerClient GardenerClient, dbSession dbsession.ReadWriteSession, kubeconfigProvider KubeconfigProvider, nextStep model.OperationStage, timeLimit time.Duration) *WaitForClusterCreationStep {
+func NewWaitForClusterCreationStep(gardenerClient GardenerClient, dbSession dbsession.ReadWriteSession, kubeconfigProvider KubeconfigProvider, retryConfig ShootRetry, nextStep model.OperationStage, timeLimit time.Duration) *WaitForClusterCreationStep {
 	return &WaitForClusterCreationStep{
 		gardenerClient:     gardenerClient,
 		dbSession:          dbSession,
 		kubeconfigProvider: kubeconfigProvider,
+		retryConfig:        retryConfig,
 
 		nextStep:  nextStep,
 		timeLimit: timeLimit,
@@ -49,7 +61,7 @@ func (s *WaitForClusterCreationStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, operation model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
 	shoot, err := s.gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
@@ -69,8 +81,8 @@ func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operatio
 				reason = util.GardenerErrCodesToErrReason(shoot.Status.LastErrors...)
 			}
 
-			if gardencorev1beta1helper.HasErrorCode(shoot.Status.LastErrors, gardencorev1beta1.ErrorInfraRateLimitsExceeded) {
-				return operations.StageResult{}, apperrors.External("error during cluster provisioning: rate limits exceeded").SetComponent(apperrors.ErrGardener).SetReason(reason)
+			if util.IsGardenerErrRetriable(shoot.Status.LastErrors...) {
+				return s.retryShootCreation(shoot, operation, reason, logger)
 			}
 
 			if lastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile {
@@ -88,6 +100,65 @@ func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operatio
 	return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
 }
 
+func (s *WaitForClusterCreationStep) retryShootCreation(shoot *gardener_types.Shoot, operation model.Operation, reason apperrors.ErrReason, logger log.FieldLogger) (operations.StageResult, error) {
+	lastOperation := shoot.Status.LastOperation
+
+	if shoot.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ShootOperationRetry {
+		err := apperrors.External("error during cluster provisioning: waiting for Gardener to start retry of failed Shoot operation").SetComponent(apperrors.ErrGardener).SetReason(reason)
+		return operations.StageResult{}, operations.NewRetriableError(err, 20*time.Second)
+	}
+
+	attempts, convErr := strconv.Atoi(shoot.Annotations[retryAttemptsAnnotation])
+	if convErr != nil {
+		attempts = 0
+	}
+
+	if attempts >= s.retryConfig.MaxAttempts {
+		logger.Warningf("Provisioning failed after %d retries! Last state: %s, Description: %s", attempts, lastOperation.State, lastOperation.Description)
+
+		err := apperrors.External(fmt.Sprintf("cluster provisioning failed after %d retries. Last Shoot state: %s, Shoot description: %s", attempts, lastOperation.State, lastOperation.Description)).SetComponent(apperrors.ErrGardener).SetReason(reason)
+		return operations.StageResult{}, operations.NewNonRecoverableError(err)
+	}
+
+	retryTime := lastOperation.LastUpdateTime.Add(s.retryConfig.Backoff << attempts)
+	if wait := time.Until(retryTime); wait > 0 {
+		err := apperrors.External(fmt.Sprintf("error during cluster provisioning: transient Gardener error, retry %d of %d scheduled at %s", attempts+1, s.retryConfig.MaxAttempts, retryTime.UTC().Format(time.RFC3339))).SetComponent(apperrors.ErrGardener).SetReason(reason)
+		return operations.StageResult{}, operations.NewRetriableError(err, s.capDelay(wait, operation))
+	}
+
+	logger.Infof("Retrying failed Shoot operation, attempt %d of %d", attempts+1, s.retryConfig.MaxAttempts)
+
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.ShootOperationRetry
+	shoot.Annotations[retryAttemptsAnnotation] = strconv.Itoa(attempts + 1)
+
+	_, err := s.gardenerClient.Update(context.Background(), shoot, v1.UpdateOptions{})
+	if err != nil {
+		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+	}
+
+	err = apperrors.External(fmt.Sprintf("error during cluster provisioning: transient Gardener error, retry %d of %d triggered", attempts+1, s.retryConfig.MaxAttempts)).SetComponent(apperrors.ErrGardener).SetReason(reason)
+	return operations.StageResult{}, operations.NewRetriableError(err, 20*time.Second)
+}
+
+// capDelay limits the delay to the time left until the step times out, so the operation is not requeued after its time limit
+func (s *WaitForClusterCreationStep) capDelay(delay time.Duration, operation model.Operation) time.Duration {
+	stepStart := operation.StartTimestamp
+	if operation.LastTransition != nil {
+		stepStart = *operation.LastTransition
+	}
+
+	if remaining := time.Until(stepStart.Add(s.timeLimit)); remaining < delay {
+		if remaining < 0 {
+			return 0
+		}
+		return remaining
+	}
+	return delay
+}
+
 func (s *WaitForClusterCreationStep) proceedToInstallation(cluster model.Cluster, shoot *gardener_types.Shoot) (operations.StageResult, error) {
 
 	if cluster.ClusterConfig.Seed == "" && shoot.Spec.SeedName != nil && *shoot.Spec.SeedName != "" {
@@ -10,7 +10,6 @@ import (
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
-	gardener_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning/mocks"
 	provisioning_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	dbMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
@@ -44,16 +43,18 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 	}
 
+	retryConfig := ShootRetry{MaxAttempts: 3, Backoff: time.Minute}
+
 	for _, testCase := range []struct {
 		description   string
-		mockFunc      func(ardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider)
+		mockFunc      func(ardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider)
 		expectedStage model.OperationStage
 		expectedDelay time.Duration
 		cluster       model.Cluster
 	}{
 		{
 			description: "should continue waiting if cluster not created",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInProcessingState(clusterName), nil)
 			},
@@ -63,7 +64,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should continue waiting if last operation not set",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInUnknownState(clusterName), nil)
 			},
@@ -73,7 +74,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should go to the next stage if cluster was created based on configuration with gardener seed provided",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 				kubeconfigProvider.On("FetchRaw", clusterName).Return([]byte("kubeconfig"), nil)
 
@@ -86,7 +87,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should go to the next stage if cluster was created based on configuration without gardener seed provided",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 				kubeconfigProvider.On("FetchRaw", clusterName).Return([]byte("kubeconfig"), nil)
 
@@ -101,13 +102,13 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
-			gardenerClient := &gardener_mocks.GardenerClient{}
+			gardenerClient := &provisioning_mocks.GardenerClient{}
 			dbSession := &dbMocks.ReadWriteSession{}
 			kubeconfigProvider := &provisioning_mocks.KubeconfigProvider{}
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute)
+			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, retryConfig, nextStageName, 10*time.Minute)
 			// when
 			result, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
 
@@ -121,13 +122,13 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 
 	for _, testCase := range []struct {
 		description        string
-		mockFunc           func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider)
+		mockFunc           func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider)
 		cluster            model.Cluster
 		unrecoverableError bool
 	}{
 		{
 			description: "should return error if failed to read Shoot",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, errors.New("some error"))
 			},
 			unrecoverableError: false,
@@ -135,7 +136,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should return error if failed to fetch kubeconfig",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededState(clusterName), nil)
 				kubeconfigProvider.On("FetchRaw", clusterName).Return(nil, errors.New("some error"))
 			},
@@ -144,15 +145,16 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should return error if Shoot is in failed state due to rate limits exceeded",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInFailedStateWithLimitRatingError(clusterName), nil)
+				gardenerClient.On("Update", context.Background(), mock.Anything, mock.Anything).Return(&gardener_types.Shoot{}, nil)
 			},
 			unrecoverableError: false,
 			cluster:            cluster,
 		},
 		{
 			description: "should return error if Shoot is in failed state during reconcile operation",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootDuringReconcileInFailedState(clusterName), nil)
 			},
 			unrecoverableError: false,
@@ -160,7 +162,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should return unrecoverable error if Shoot is in failed state",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInFailedState(clusterName), nil)
 			},
 			unrecoverableError: true,
@@ -168,7 +170,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should return error if failed to update kubeconfig data in database",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 				kubeconfigProvider.On("FetchRaw", clusterName).Return([]byte("kubeconfig"), nil)
 
@@ -179,7 +181,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 		},
 		{
 			description: "should return error if failed to update seed in database",
-			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
+			mockFunc: func(gardenerClient *provisioning_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
 
 				dbSession.On("UpdateGardenerClusterConfig", cluster.ClusterConfig).Return(dberrors.Internal("some error"))
@@ -190,13 +192,13 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
-			gardenerClient := &gardener_mocks.GardenerClient{}
+			gardenerClient := &provisioning_mocks.GardenerClient{}
 			dbSession := &dbMocks.ReadWriteSession{}
 			kubeconfigProvider := &provisioning_mocks.KubeconfigProvider{}
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute)
+			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, retryConfig, nextStageName, 10*time.Minute)
 
 			// when
 			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
@@ -213,6 +215,147 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 	}
 }
 
+func TestWaitForClusterCreation_RetryShootCreation(t *testing.T) {
+
+	clusterName := "name"
+
+	cluster := model.Cluster{
+		ID: "runtimeID",
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	retryConfig := ShootRetry{MaxAttempts: 3, Backoff: time.Minute}
+
+	t.Run("should trigger Shoot retry when backoff elapsed", func(t *testing.T) {
+		// given
+		gardenerClient := &provisioning_mocks.GardenerClient{}
+
+		shoot := fixShootInFailedStateWithErrorCodes(clusterName, time.Now().Add(-5*time.Minute), gardener_types.ErrorInfraQuotaExceeded)
+		shoot.Annotations = map[string]string{retryAttemptsAnnotation: "1"}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(shoot, nil)
+		gardenerClient.On("Update", context.Background(), mock.MatchedBy(func(shoot *gardener_types.Shoot) bool {
+			return shoot.Annotations["gardener.cloud/operation"] == "retry" && shoot.Annotations[retryAttemptsAnnotation] == "2"
+		}), mock.Anything).Return(&gardener_types.Shoot{}, nil)
+
+		waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, nil, nil, retryConfig, nextStageName, 10*time.Minute)
+
+		// when
+		_, err := waitForClusterCreationStep.Run(cluster, model.Operation{}, logrus.New())
+
+		// then
+		require.Error(t, err)
+		retriable := operations.RetriableError{}
+		require.True(t, errors.As(err, &retriable))
+		assert.Contains(t, err.Error(), "retry 2 of 3 triggered")
+		gardenerClient.AssertExpectations(t)
+	})
+
+	t.Run("should wait for backoff before triggering Shoot retry", func(t *testing.T) {
+		// given
+		gardenerClient := &provisioning_mocks.GardenerClient{}
+
+		shoot := fixShootInFailedStateWithErrorCodes(clusterName, time.Now(), gardener_types.ErrorInfraRateLimitsExceeded)
+		shoot.Annotations = map[string]string{retryAttemptsAnnotation: "1"}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(shoot, nil)
+
+		waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, nil, nil, retryConfig, nextStageName, 10*time.Minute)
+
+		// when
+		_, err := waitForClusterCreationStep.Run(cluster, model.Operation{StartTimestamp: time.Now()}, logrus.New())
+
+		// then
+		require.Error(t, err)
+		retriable := operations.RetriableError{}
+		require.True(t, errors.As(err, &retriable))
+		assert.True(t, retriable.Delay() > time.Minute && retriable.Delay() <= 2*time.Minute)
+		gardenerClient.AssertExpectations(t)
+	})
+
+	t.Run("should not wait for backoff longer than the step time limit", func(t *testing.T) {
+		// given
+		gardenerClient := &provisioning_mocks.GardenerClient{}
+
+		shoot := fixShootInFailedStateWithErrorCodes(clusterName, time.Now(), gardener_types.ErrorInfraRateLimitsExceeded)
+		shoot.Annotations = map[string]string{retryAttemptsAnnotation: "2"}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(shoot, nil)
+
+		waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, nil, nil, retryConfig, nextStageName, 10*time.Minute)
+		lastTransition := time.Now().Add(-8 * time.Minute)
+
+		// when
+		_, err := waitForClusterCreationStep.Run(cluster, model.Operation{StartTimestamp: time.Now().Add(-time.Hour), LastTransition: &lastTransition}, logrus.New())
+
+		// then
+		require.Error(t, err)
+		retriable := operations.RetriableError{}
+		require.True(t, errors.As(err, &retriable))
+		assert.True(t, retriable.Delay() > time.Minute && retriable.Delay() <= 2*time.Minute)
+		gardenerClient.AssertExpectations(t)
+	})
+
+	t.Run("should wait for Gardener to pick up triggered retry", func(t *testing.T) {
+		// given
+		gardenerClient := &provisioning_mocks.GardenerClient{}
+
+		shoot := fixShootInFailedStateWithErrorCodes(clusterName, time.Now().Add(-time.Hour), gardener_types.ErrorInfraQuotaExceeded)
+		shoot.Annotations = map[string]string{"gardener.cloud/operation": "retry", retryAttemptsAnnotation: "1"}
+
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(shoot, nil)
+
+		waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, nil, nil, retryConfig, nextStageName, 10*time.Minute)
+
+		// when
+		_, err := waitForClusterCreationStep.Run(cluster, model.Operation{}, logrus.New())
+
+		// then
+		require.Error(t, err)
+		retriable := operations.RetriableError{}
+		require.True(t, errors.As(err, &retriable))
+		gardenerClient.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		shoot       *gardener_types.Shoot
+	}{
+		{
+			description: "should fail when retry budget is exhausted",
+			shoot: func() *gardener_types.Shoot {
+				shoot := fixShootInFailedStateWithErrorCodes(clusterName, time.Now().Add(-time.Hour), gardener_types.ErrorInfraQuotaExceeded)
+				shoot.Annotations = map[string]string{retryAttemptsAnnotation: "3"}
+				return shoot
+			}(),
+		},
+		{
+			description: "should fail on permanent error",
+			shoot:       fixShootInFailedStateWithErrorCodes(clusterName, time.Now().Add(-time.Hour), gardener_types.ErrorInfraQuotaExceeded, gardener_types.ErrorInfraUnauthorized),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &provisioning_mocks.GardenerClient{}
+
+			gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(testCase.shoot, nil)
+
+			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, nil, nil, retryConfig, nextStageName, 10*time.Minute)
+
+			// when
+			_, err := waitForClusterCreationStep.Run(cluster, model.Operation{}, logrus.New())
+
+			// then
+			require.Error(t, err)
+			nonRecoverable := operations.NonRecoverableError{}
+			require.True(t, errors.As(err, &nonRecoverable))
+			gardenerClient.AssertExpectations(t)
+		})
+	}
+}
+
 func fixShootInSucceededState(name string) *gardener_types.Shoot {
 	return fixShoot(name, &gardener_types.LastOperation{
 		State: gardencorev1beta1.LastOperationStateSucceeded,
@@ -255,6 +398,16 @@ func fixShootInFailedStateWithLimitRatingError(name string) *gardener_types.Shoo
 	return shoot
 }
 
+func fixShootInFailedStateWithErrorCodes(name string, lastUpdate time.Time, codes ...gardener_types.ErrorCode) *gardener_types.Shoot {
+	shoot := fixShoot(name, &gardener_types.LastOperation{
+		State:          gardencorev1beta1.LastOperationStateFailed,
+		LastUpdateTime: metav1.NewTime(lastUpdate),
+	})
+	shoot.Status.LastErrors = []gardener_types.LastError{{Codes: codes}}
+
+	return shoot
+}
+
 func fixShootInProcessingState(name string) *gardener_types.Shoot {
 	return fixShoot(name, &gardener_types.LastOperation{
 		State: gardencorev1beta1.LastOperationStateProcessing,
@@ -28,6 +28,7 @@ type WaitForClusterDomainStep struct {
 //go:generate mockery --name=GardenerClient
 type GardenerClient interface {
 	Get(ctx context.Context, name string, options v1.GetOptions) (*gardener_types.Shoot, error)
+	Update(ctx context.Context, shoot *gardener_types.Shoot, options v1.UpdateOptions) (*gardener_types.Shoot, error)
 }
 
 func NewWaitForClusterDomainStep(gardenerClient GardenerClient, directorClient director.DirectorClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForClusterDomainStep {
@@ -90,7 +90,7 @@ func (s *WaitForInstallationStep) Run(cluster model.Cluster, operation model.Ope
 }
 
 func (s *WaitForInstallationStep) saveInstallationState(message string, logger logrus.FieldLogger, operation model.Operation) {
-	dberr := s.dbSession.UpdateOperationState(operation.ID, message, operation.State, time.Now())
+	dberr := s.dbSession.CompareAndSetOperationState(operation.ID, message, model.InProgress, model.InProgress, time.Now())
 	if dberr != nil {
 		logger.Warnf("error updating installation state: %s", dberr.Error())
 	}
@@ -69,8 +69,8 @@ func TestWaitForInstallationStep_Run(t *testing.T) {
 			installationSvc := &installationMocks.Service{}
 			session := &mocks.WriteSession{}
 
-			session.On("UpdateOperationState", operation.ID, mock.AnythingOfType("string"),
-				operation.State, mock.AnythingOfType("time.Time")).Return(nil).Once()
+			session.On("CompareAndSetOperationState", operation.ID, mock.AnythingOfType("string"),
+				model.InProgress, model.InProgress, mock.AnythingOfType("time.Time")).Return(nil).Once()
 
 			testCase.installationMockFunc(installationSvc)
 
@@ -127,8 +127,8 @@ func TestWaitForInstallationStep_Run(t *testing.T) {
 			})
 
 		session := &mocks.WriteSession{}
-		session.On("UpdateOperationState", operation.ID, mock.AnythingOfType("string"),
-			operation.State, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		session.On("CompareAndSetOperationState", operation.ID, mock.AnythingOfType("string"),
+			model.InProgress, model.InProgress, mock.AnythingOfType("time.Time")).Return(nil).Once()
 
 		waitForInstallationStep := NewWaitForInstallationStep(installationSvc, nextStageName, 10*time.Minute, session)
 		expectConvertErr := apperrors.External("error").SetComponent(apperrors.ErrKymaInstaller).SetReason(apperrors.ErrReason("monitoring, newthing"))
@@ -37,10 +37,37 @@ func NewNonRecoverableError(err error) NonRecoverableError {
 	return NonRecoverableError{error: err}
 }
 
+// RetriableError marks a transient failure that the step is retrying, the operation is requeued after the given delay
+type RetriableError struct {
+	error error
+	delay time.Duration
+}
+
+func (r RetriableError) Error() string {
+	return r.error.Error()
+}
+
+func (r RetriableError) Unwrap() error {
+	return r.error
+}
+
+func (r RetriableError) Delay() time.Duration {
+	return r.delay
+}
+
+func NewRetriableError(err error, delay time.Duration) RetriableError {
+	return RetriableError{error: err, delay: delay}
+}
+
 type FailureHandler interface {
 	HandleFailure(operation model.Operation, cluster model.Cluster) error
 }
 
+// CancelHandler reverts the changes made by the operation before it was canceled
+type CancelHandler interface {
+	HandleCancel(operation model.Operation, cluster model.Cluster) error
+}
+
 func ConvertToAppError(err error) apperrors.AppError {
 	if nonRecoverErr := (NonRecoverableError{}); errors.As(err, &nonRecoverErr) {
 		err = nonRecoverErr.error
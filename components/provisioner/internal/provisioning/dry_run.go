@@ -0,0 +1,209 @@
+package provisioning
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const dryRunMessage = "Dry run, no operation was started"
+
+func (r *service) dryRunProvisioning(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	// The Runtime is not registered in Director during dry run so the rendered Shoot has no Runtime ID
+	cluster, err := r.inputConverter.ProvisioningInputToCluster("", config, tenant, subAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, err := r.provisioner.RenderShoot(cluster)
+	if err != nil {
+		return nil, err.Append("Failed to render Shoot")
+	}
+
+	manifest, err := shootManifest(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	operationType := gqlschema.OperationTypeProvisionNoInstall
+	if config.KymaConfig != nil {
+		operationType = gqlschema.OperationTypeProvision
+	}
+
+	return &gqlschema.OperationStatus{
+		Operation:    operationType,
+		State:        gqlschema.OperationStateSucceeded,
+		Message:      util.StringPtr(dryRunMessage),
+		DryRunResult: &gqlschema.DryRunResult{Shoot: manifest},
+	}, nil
+}
+
+func (r *service) dryRunShootUpgrade(cluster model.Cluster, gardenerConfig model.GardenerConfig) (*gqlschema.OperationStatus, apperrors.AppError) {
+	currentShoot, upgradedShoot, err := r.provisioner.RenderShootUpgrade(cluster.ID, gardenerConfig)
+	if err != nil {
+		return nil, err.Append("Failed to render Shoot upgrade")
+	}
+
+	manifest, err := shootManifest(upgradedShoot)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := shootDiff(currentShoot, upgradedShoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlschema.OperationStatus{
+		Operation:    gqlschema.OperationTypeUpgradeShoot,
+		State:        gqlschema.OperationStateSucceeded,
+		Message:      util.StringPtr(dryRunMessage),
+		RuntimeID:    &cluster.ID,
+		DryRunResult: &gqlschema.DryRunResult{Shoot: manifest, Diff: diff},
+	}, nil
+}
+
+func shootManifest(shoot *gardener_Types.Shoot) (string, apperrors.AppError) {
+	manifest := shoot.DeepCopy()
+	manifest.Kind = "Shoot"
+	manifest.APIVersion = gardener_Types.SchemeGroupVersion.String()
+	manifest.ManagedFields = nil
+	manifest.Status = gardener_Types.ShootStatus{}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return "", apperrors.Internal("Failed to render Shoot manifest: %s", err.Error())
+	}
+
+	return string(data), nil
+}
+
+// shootDiff compares the fields that the provisioner manages: labels, annotations and the spec
+func shootDiff(current, desired *gardener_Types.Shoot) ([]*gqlschema.ShootFieldChange, apperrors.AppError) {
+	currentFields, err := comparableShootFields(current)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := comparableShootFields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*gqlschema.ShootFieldChange, 0)
+	collectChanges("", currentFields, desiredFields, &changes)
+
+	return changes, nil
+}
+
+func comparableShootFields(shoot *gardener_Types.Shoot) (map[string]interface{}, apperrors.AppError) {
+	managedFields := struct {
+		Labels      map[string]string        `json:"labels,omitempty"`
+		Annotations map[string]string        `json:"annotations,omitempty"`
+		Spec        gardener_Types.ShootSpec `json:"spec"`
+	}{
+		Labels:      shoot.Labels,
+		Annotations: shoot.Annotations,
+		Spec:        shoot.Spec,
+	}
+
+	data, err := json.Marshal(managedFields)
+	if err != nil {
+		return nil, apperrors.Internal("Failed to compare Shoots: %s", err.Error())
+	}
+
+	var fields map[string]interface{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, apperrors.Internal("Failed to compare Shoots: %s", err.Error())
+	}
+
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels":      fields["labels"],
+			"annotations": fields["annotations"],
+		},
+		"spec": fields["spec"],
+	}, nil
+}
+
+func collectChanges(path string, current, desired interface{}, changes *[]*gqlschema.ShootFieldChange) {
+	currentMap, currentIsMap := current.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if currentIsMap && desiredIsMap {
+		for _, key := range unionKeys(currentMap, desiredMap) {
+			collectChanges(joinPath(path, key), currentMap[key], desiredMap[key], changes)
+		}
+		return
+	}
+
+	currentList, currentIsList := current.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+	if currentIsList && desiredIsList {
+		for i := 0; i < len(currentList) || i < len(desiredList); i++ {
+			var currentItem, desiredItem interface{}
+			if i < len(currentList) {
+				currentItem = currentList[i]
+			}
+			if i < len(desiredList) {
+				desiredItem = desiredList[i]
+			}
+			collectChanges(fmt.Sprintf("%s[%d]", path, i), currentItem, desiredItem, changes)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(current, desired) {
+		return
+	}
+
+	*changes = append(*changes, &gqlschema.ShootFieldChange{
+		Path:    path,
+		Current: fieldValue(current),
+		Desired: fieldValue(desired),
+	})
+}
+
+func unionKeys(current, desired map[string]interface{}) []string {
+	keys := make([]string, 0, len(current)+len(desired))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	for key := range desired {
+		if _, found := current[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func fieldValue(value interface{}) *string {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return util.StringPtr(fmt.Sprintf("%v", value))
+	}
+
+	return util.StringPtr(string(data))
+}
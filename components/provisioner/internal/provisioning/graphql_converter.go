@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"time"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
@@ -8,6 +10,7 @@ import (
 type GraphQLConverter interface {
 	RuntimeStatusToGraphQLStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus
 	OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus
+	RuntimeToGraphQLRuntime(cluster model.Cluster, lastOperation *model.Operation) *gqlschema.Runtime
 }
 
 func NewGraphQLConverter() GraphQLConverter {
@@ -39,10 +42,30 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 			ErrMessage: operation.ErrMessage,
 			Reason:     operation.Reason,
 			Component:  operation.Component,
+			Retriable:  operation.Retriable,
 		},
 	}
 }
 
+func (c graphQLConverter) RuntimeToGraphQLRuntime(cluster model.Cluster, lastOperation *model.Operation) *gqlschema.Runtime {
+	creationTimestamp := cluster.CreationTimestamp.UTC().Format(time.RFC3339)
+
+	runtime := &gqlschema.Runtime{
+		ID:                cluster.ID,
+		Name:              &cluster.ClusterConfig.Name,
+		SubAccountID:      cluster.SubAccountId,
+		Provider:          &cluster.ClusterConfig.Provider,
+		Region:            &cluster.ClusterConfig.Region,
+		KubernetesVersion: &cluster.ClusterConfig.KubernetesVersion,
+		CreationTimestamp: &creationTimestamp,
+	}
+	if lastOperation != nil {
+		runtime.LastOperationStatus = c.OperationStatusToGQLOperationStatus(*lastOperation)
+	}
+
+	return runtime
+}
+
 func (c graphQLConverter) runtimeConnectionStatusToGraphQLStatus(status model.RuntimeAgentConnectionStatus) *gqlschema.RuntimeConnectionStatus {
 	return &gqlschema.RuntimeConnectionStatus{Status: c.runtimeAgentConnectionStatusToGraphQLStatus(status)}
 }
@@ -92,6 +115,8 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		Seed:                                &config.Seed,
 		TargetSecret:                        &config.TargetSecret,
 		WorkerCidr:                          &config.WorkerCidr,
+		PodsCidr:                            config.PodsCidr,
+		ServicesCidr:                        config.ServicesCidr,
 		Region:                              &config.Region,
 		AutoScalerMin:                       &config.AutoScalerMin,
 		AutoScalerMax:                       &config.AutoScalerMax,
@@ -106,7 +131,50 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
 		EuAccess:                            &config.EuAccess,
+		HibernationSchedules:                c.hibernationSchedulesToGraphQLSchedules(config.HibernationSchedules),
+		WorkerPools:                         c.workerPoolsToGraphQLPools(config.WorkerPools),
+		APIServerAllowedCidrs:               config.APIServerAllowedCidrs,
+	}
+}
+
+func (c graphQLConverter) hibernationSchedulesToGraphQLSchedules(schedules []model.HibernationSchedule) []*gqlschema.HibernationSchedule {
+	if schedules == nil {
+		return nil
+	}
+
+	gqlSchedules := make([]*gqlschema.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		gqlSchedules = append(gqlSchedules, &gqlschema.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return gqlSchedules
+}
+
+func (c graphQLConverter) workerPoolsToGraphQLPools(pools []model.WorkerPool) []*gqlschema.WorkerPool {
+	if pools == nil {
+		return nil
+	}
+
+	gqlPools := make([]*gqlschema.WorkerPool, 0, len(pools))
+	for i := range pools {
+		pool := pools[i]
+		gqlPools = append(gqlPools, &gqlschema.WorkerPool{
+			Name:                &pool.Name,
+			MachineType:         &pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGb:        pool.VolumeSizeGB,
+			AutoScalerMin:       &pool.AutoScalerMin,
+			AutoScalerMax:       &pool.AutoScalerMax,
+			MaxSurge:            &pool.MaxSurge,
+			MaxUnavailable:      &pool.MaxUnavailable,
+		})
 	}
+	return gqlPools
 }
 
 func (c graphQLConverter) oidcConfigToGraphQLConfig(config *model.OIDCConfig) *gqlschema.OIDCConfig {
@@ -178,6 +246,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
@@ -191,6 +261,10 @@ func (c graphQLConverter) operationStateToGraphQLState(state model.OperationStat
 		return gqlschema.OperationStateSucceeded
 	case model.Failed:
 		return gqlschema.OperationStateFailed
+	case model.Canceling:
+		return gqlschema.OperationStateCanceling
+	case model.Canceled:
+		return gqlschema.OperationStateCanceled
 	default:
 		return ""
 	}
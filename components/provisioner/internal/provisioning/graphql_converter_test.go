@@ -31,6 +31,7 @@ func TestOperationStatusToGQLOperationStatus(t *testing.T) {
 				ErrMessage: "error msg",
 				Reason:     "ERR_INFRA_QUOTA_EXCEEDED",
 				Component:  "gardener",
+				Retriable:  true,
 			},
 		}
 
@@ -48,6 +49,7 @@ func TestOperationStatusToGQLOperationStatus(t *testing.T) {
 				ErrMessage: "error msg",
 				Reason:     "ERR_INFRA_QUOTA_EXCEEDED",
 				Component:  "gardener",
+				Retriable:  true,
 			},
 		}
 
@@ -101,6 +101,8 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		DiskType:                            input.DiskType,
 		VolumeSizeGB:                        input.VolumeSizeGb,
 		WorkerCidr:                          input.WorkerCidr,
+		PodsCidr:                            input.PodsCidr,
+		ServicesCidr:                        input.ServicesCidr,
 		AutoScalerMin:                       input.AutoScalerMin,
 		AutoScalerMax:                       input.AutoScalerMax,
 		MaxSurge:                            input.MaxSurge,
@@ -117,6 +119,9 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
 		EuAccess:                            util.UnwrapBoolOrDefault(input.EuAccess, c.defaultEuAccess),
+		HibernationSchedules:                hibernationSchedulesFromInput(input.HibernationSchedules),
+		WorkerPools:                         workerPoolsFromInput(input.WorkerPools),
+		APIServerAllowedCidrs:               input.APIServerAllowedCidrs,
 	}, nil
 }
 
@@ -134,6 +139,45 @@ func oidcConfigFromInput(config *gqlschema.OIDCConfigInput) *model.OIDCConfig {
 	return nil
 }
 
+func hibernationSchedulesFromInput(input []*gqlschema.HibernationScheduleInput) []model.HibernationSchedule {
+	if input == nil {
+		return nil
+	}
+
+	schedules := make([]model.HibernationSchedule, 0, len(input))
+	for _, schedule := range input {
+		schedules = append(schedules, model.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return schedules
+}
+
+func workerPoolsFromInput(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	if input == nil {
+		return nil
+	}
+
+	pools := make([]model.WorkerPool, 0, len(input))
+	for _, pool := range input {
+		pools = append(pools, model.WorkerPool{
+			Name:                pool.Name,
+			MachineType:         pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGB:        pool.VolumeSizeGb,
+			AutoScalerMin:       pool.AutoScalerMin,
+			AutoScalerMax:       pool.AutoScalerMax,
+			MaxSurge:            pool.MaxSurge,
+			MaxUnavailable:      pool.MaxUnavailable,
+		})
+	}
+	return pools
+}
+
 func dnsConfigFromInput(input *gqlschema.DNSConfigInput) *model.DNSConfig {
 	config := model.DNSConfig{}
 	if input != nil {
@@ -179,6 +223,8 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		Region:       config.Region,
 		LicenceType:  config.LicenceType,
 		WorkerCidr:   config.WorkerCidr,
+		PodsCidr:     config.PodsCidr,
+		ServicesCidr: config.ServicesCidr,
 
 		Purpose:                             util.DefaultStrIfNil(input.Purpose, config.Purpose),
 		KubernetesVersion:                   util.UnwrapStrOrDefault(input.KubernetesVersion, config.KubernetesVersion),
@@ -197,9 +243,36 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.DefaultStrIfNil(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.DefaultBoolIfNil(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		HibernationSchedules:                hibernationSchedulesOrDefault(input.HibernationSchedules, config.HibernationSchedules),
+		WorkerPools:                         workerPoolsOrDefault(input.WorkerPools, config.WorkerPools),
+		APIServerAllowedCidrs:               apiServerAllowedCidrsOrDefault(input.APIServerAllowedCidrs, config.APIServerAllowedCidrs),
 	}, nil
 }
 
+// hibernationSchedulesOrDefault keeps the current schedules when none are provided, an empty input removes them
+func hibernationSchedulesOrDefault(input []*gqlschema.HibernationScheduleInput, current []model.HibernationSchedule) []model.HibernationSchedule {
+	if input == nil {
+		return current
+	}
+	return hibernationSchedulesFromInput(input)
+}
+
+// apiServerAllowedCidrsOrDefault keeps the current ranges when none are provided, an empty input removes the restriction
+func apiServerAllowedCidrsOrDefault(input []string, current []string) []string {
+	if input == nil {
+		return current
+	}
+	return input
+}
+
+// workerPoolsOrDefault keeps the current pools when none are provided, an empty input removes them
+func workerPoolsOrDefault(input []*gqlschema.WorkerPoolInput, current []model.WorkerPool) []model.WorkerPool {
+	if input == nil {
+		return current
+	}
+	return workerPoolsFromInput(input)
+}
+
 func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
 	if input == nil {
 		return nil, apperrors.Internal("provider config not specified")
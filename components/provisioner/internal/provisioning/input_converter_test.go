@@ -54,6 +54,9 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				TargetSecret:                      "secret",
 				DiskType:                          util.StringPtr("ssd"),
 				WorkerCidr:                        "cidr",
+				PodsCidr:                          util.StringPtr("pods-cidr"),
+				ServicesCidr:                      util.StringPtr("services-cidr"),
+				APIServerAllowedCidrs:             []string{"203.0.113.0/24"},
 				AutoScalerMin:                     1,
 				AutoScalerMax:                     5,
 				MaxSurge:                          1,
@@ -95,6 +98,9 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			Seed:                                "gcp-eu1",
 			TargetSecret:                        "secret",
 			WorkerCidr:                          "cidr",
+			PodsCidr:                            util.StringPtr("pods-cidr"),
+			ServicesCidr:                        util.StringPtr("services-cidr"),
+			APIServerAllowedCidrs:               []string{"203.0.113.0/24"},
 			AutoScalerMin:                       1,
 			AutoScalerMax:                       5,
 			MaxSurge:                            1,
@@ -812,6 +818,142 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
 			},
 		},
+		{
+			description:  "shoot upgrade keeping hibernation schedules",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           oidcConfig(),
+				HibernationSchedules: fixHibernationSchedules(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           upgradedOidcConfig(),
+				HibernationSchedules: fixHibernationSchedules(),
+			},
+		},
+		{
+			description: "shoot upgrade removing hibernation schedules",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           oidcConfig(),
+				HibernationSchedules: fixHibernationSchedules(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           upgradedOidcConfig(),
+				HibernationSchedules: []model.HibernationSchedule{},
+			},
+		},
+		{
+			description: "shoot upgrade replacing hibernation schedules",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 22 * * *"), Location: util.StringPtr("UTC")},
+				}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           oidcConfig(),
+				HibernationSchedules: fixHibernationSchedules(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				HibernationSchedules: []model.HibernationSchedule{
+					{Start: util.StringPtr("00 22 * * *"), Location: util.StringPtr("UTC")},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade keeping API server allowed CIDRs",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            oidcConfig(),
+				APIServerAllowedCidrs: []string{"203.0.113.0/24"},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            upgradedOidcConfig(),
+				APIServerAllowedCidrs: []string{"203.0.113.0/24"},
+			},
+		},
+		{
+			description: "shoot upgrade removing API server allowed CIDRs",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.APIServerAllowedCidrs = []string{}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            oidcConfig(),
+				APIServerAllowedCidrs: []string{"203.0.113.0/24"},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            upgradedOidcConfig(),
+				APIServerAllowedCidrs: []string{},
+			},
+		},
+		{
+			description:  "shoot upgrade keeping worker pools",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        oidcConfig(),
+				WorkerPools:       fixWorkerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools:       fixWorkerPools(),
+			},
+		},
+		{
+			description: "shoot upgrade replacing worker pools",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.WorkerPools = []*gqlschema.WorkerPoolInput{
+					{Name: "memory", MachineType: "m5.8xlarge", DiskType: util.StringPtr("ssd"), VolumeSizeGb: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 6, MaxSurge: 2},
+				}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        oidcConfig(),
+				WorkerPools:       fixWorkerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerPools: []model.WorkerPool{
+					{Name: "memory", MachineType: "m5.8xlarge", DiskType: util.StringPtr("ssd"), VolumeSizeGB: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 6, MaxSurge: 2},
+				},
+			},
+		},
 	}
 
 	casesWithErrors := []struct {
@@ -1024,3 +1166,15 @@ func fixGQLConfigEntryInput(key, val string, secret *bool) *gqlschema.ConfigEntr
 		Secret: secret,
 	}
 }
+
+func fixHibernationSchedules() []model.HibernationSchedule {
+	return []model.HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1-5"), End: util.StringPtr("00 07 * * 1-5"), Location: util.StringPtr("Europe/Berlin")},
+	}
+}
+
+func fixWorkerPools() []model.WorkerPool {
+	return []model.WorkerPool{
+		{Name: "memory", MachineType: "m5.4xlarge", AutoScalerMin: 1, AutoScalerMax: 3, MaxSurge: 1},
+	}
+}
@@ -6,6 +6,8 @@ import (
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	mock "github.com/stretchr/testify/mock"
 
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
 )
 
@@ -92,6 +94,65 @@ func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId strin
 	return r0
 }
 
+// RenderShoot provides a mock function with given fields: cluster
+func (_m *Provisioner) RenderShoot(cluster model.Cluster) (*v1beta1.Shoot, apperrors.AppError) {
+	ret := _m.Called(cluster)
+
+	var r0 *v1beta1.Shoot
+	if rf, ok := ret.Get(0).(func(model.Cluster) *v1beta1.Shoot); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.Cluster) apperrors.AppError); ok {
+		r1 = rf(cluster)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RenderShootUpgrade provides a mock function with given fields: clusterID, upgradeConfig
+func (_m *Provisioner) RenderShootUpgrade(clusterID string, upgradeConfig model.GardenerConfig) (*v1beta1.Shoot, *v1beta1.Shoot, apperrors.AppError) {
+	ret := _m.Called(clusterID, upgradeConfig)
+
+	var r0 *v1beta1.Shoot
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) *v1beta1.Shoot); ok {
+		r0 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
+	}
+
+	var r1 *v1beta1.Shoot
+	if rf, ok := ret.Get(1).(func(string, model.GardenerConfig) *v1beta1.Shoot); ok {
+		r1 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*v1beta1.Shoot)
+		}
+	}
+
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(2).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r2 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // UpgradeCluster provides a mock function with given fields: clusterID, upgradeConfig
 func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
 	ret := _m.Called(clusterID, upgradeConfig)
@@ -107,3 +168,19 @@ func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.Gard
 
 	return r0
 }
+
+// WakeUpCluster provides a mock function with given fields: clusterID, gardenerConfig
+func (_m *Provisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(clusterID, gardenerConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(clusterID, gardenerConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
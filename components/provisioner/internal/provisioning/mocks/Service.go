@@ -6,6 +6,8 @@ import (
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,6 +16,31 @@ type Service struct {
 	mock.Mock
 }
 
+// CancelOperation provides a mock function with given fields: operationID
+func (_m *Service) CancelOperation(operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 *gqlschema.OperationStatus
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeprovisionRuntime provides a mock function with given fields: id
 func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	ret := _m.Called(id)
@@ -62,6 +89,56 @@ func (_m *Service) HibernateCluster(clusterID string) (*gqlschema.OperationStatu
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, first, after
+func (_m *Service) ListOperations(filter model.OperationFilter, first *int, after *string) (*gqlschema.OperationPage, apperrors.AppError) {
+	ret := _m.Called(filter, first, after)
+
+	var r0 *gqlschema.OperationPage
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, *int, *string) *gqlschema.OperationPage); ok {
+		r0 = rf(filter, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationPage)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, *int, *string) apperrors.AppError); ok {
+		r1 = rf(filter, first, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, first, after
+func (_m *Service) ListRuntimes(filter model.RuntimeFilter, first *int, after *string) (*gqlschema.RuntimePage, apperrors.AppError) {
+	ret := _m.Called(filter, first, after)
+
+	var r0 *gqlschema.RuntimePage
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, *int, *string) *gqlschema.RuntimePage); ok {
+		r0 = rf(filter, first, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.RuntimePage)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, *int, *string) apperrors.AppError); ok {
+		r1 = rf(filter, first, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
 func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(config, tenant, subAccount)
@@ -234,3 +311,28 @@ func (_m *Service) UpgradeRuntime(id string, config gqlschema.UpgradeRuntimeInpu
 
 	return r0, r1
 }
+
+// WakeUpCluster provides a mock function with given fields: clusterID
+func (_m *Service) WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(clusterID)
+
+	var r0 *gqlschema.OperationStatus
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(clusterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(clusterID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
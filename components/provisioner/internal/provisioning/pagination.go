@@ -0,0 +1,62 @@
+package provisioning
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+func pageParameters(first *int, after *string) (int, *model.PageCursor, apperrors.AppError) {
+	limit := defaultPageSize
+	if first != nil {
+		if *first < 1 || *first > maxPageSize {
+			return 0, nil, apperrors.BadRequest("page size must be between 1 and %d, got %d", maxPageSize, *first)
+		}
+		limit = *first
+	}
+
+	if after == nil || *after == "" {
+		return limit, nil, nil
+	}
+
+	cursor, appErr := decodePageCursor(*after)
+	if appErr != nil {
+		return 0, nil, appErr
+	}
+
+	return limit, &cursor, nil
+}
+
+func encodePageCursor(cursor model.PageCursor) *string {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return nil
+	}
+	encoded := base64.URLEncoding.EncodeToString(data)
+
+	return &encoded
+}
+
+func decodePageCursor(encoded string) (model.PageCursor, apperrors.AppError) {
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return model.PageCursor{}, apperrors.BadRequest("invalid cursor: %s", err.Error())
+	}
+
+	var cursor model.PageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return model.PageCursor{}, apperrors.BadRequest("invalid cursor: %s", err.Error())
+	}
+	if cursor.ID == "" || cursor.Timestamp.IsZero() {
+		return model.PageCursor{}, apperrors.BadRequest("invalid cursor: missing position")
+	}
+
+	return cursor, nil
+}
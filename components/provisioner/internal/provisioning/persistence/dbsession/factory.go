@@ -27,8 +27,11 @@ type ReadSession interface {
 	GetTenant(runtimeID string) (string, dberrors.Error)
 	ListInProgressOperations() ([]model.Operation, dberrors.Error)
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
+	GetPreUpgradeGardenerConfig(operationID string) (model.GardenerConfig, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	ListClusters(filter model.RuntimeFilter, limit int, after *model.PageCursor) ([]model.ListedCluster, dberrors.Error)
+	ListOperations(filter model.OperationFilter, limit int, after *model.PageCursor) ([]model.Operation, dberrors.Error)
 	//TODO:Remove after schema migration
 	GetProviderSpecificConfigsByProvider(provider string) ([]ProviderData, dberrors.Error)
 	GetUpdatedProviderSpecificConfigByID(id string) (string, dberrors.Error)
@@ -43,7 +46,9 @@ type WriteSession interface {
 	InsertKymaConfig(kymaConfig model.KymaConfig) dberrors.Error
 	InsertOperation(operation model.Operation) dberrors.Error
 	UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error
-	UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error
+	CompareAndSetOperationState(operationID string, message string, expectedState, state model.OperationState, endTime time.Time) dberrors.Error
+	UpdateOperationLastError(operationID, msg, reason, component string, retriable bool) dberrors.Error
+	MarkOperationAsCanceling(operationID string, message string) dberrors.Error
 	TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error
 	UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error
 	SetActiveKymaConfig(runtimeID string, kymaConfigId string) dberrors.Error
@@ -51,6 +56,7 @@ type WriteSession interface {
 	DeleteCluster(runtimeID string) dberrors.Error
 	MarkClusterAsDeleted(runtimeID string) dberrors.Error
 	InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpgrade) dberrors.Error
+	InsertShootUpgrade(operationID string, preUpgradeConfig model.GardenerConfig) dberrors.Error
 	FixShootProvisioningStage(message string, newStage model.OperationStage, transitionTime time.Time) dberrors.Error
 	UpdateTenant(runtimeID string, tenant string) dberrors.Error
 	//TODO:Remove after schema migration
@@ -0,0 +1,40 @@
+package dbsession
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// gardenerConfigSnapshot is the stored form of a Gardener config. The provider config is an interface, so it is kept
+// in its raw form and decoded the same way as the provider_specific_config column.
+type gardenerConfigSnapshot struct {
+	Config                 model.GardenerConfig `json:"config"`
+	ProviderSpecificConfig string               `json:"providerSpecificConfig"`
+}
+
+func newGardenerConfigSnapshot(config model.GardenerConfig) ([]byte, error) {
+	snapshot := gardenerConfigSnapshot{Config: config}
+	if config.GardenerProviderConfig != nil {
+		snapshot.ProviderSpecificConfig = config.GardenerProviderConfig.RawJSON()
+	}
+	snapshot.Config.GardenerProviderConfig = nil
+
+	return json.Marshal(snapshot)
+}
+
+func decodeGardenerConfigSnapshot(data []byte) (model.GardenerConfig, error) {
+	var snapshot gardenerConfigSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return model.GardenerConfig{}, fmt.Errorf("error decoding Gardener config: %s", err.Error())
+	}
+
+	providerConfig, err := model.NewGardenerProviderConfigFromJSON(snapshot.ProviderSpecificConfig)
+	if err != nil {
+		return model.GardenerConfig{}, fmt.Errorf("error decoding Gardener provider config: %s", err.Error())
+	}
+	snapshot.Config.GardenerProviderConfig = providerConfig
+
+	return snapshot.Config, nil
+}
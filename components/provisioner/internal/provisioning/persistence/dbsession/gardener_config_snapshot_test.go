@@ -0,0 +1,44 @@
+package dbsession
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+func Test_gardenerConfigSnapshot(t *testing.T) {
+	// given
+	providerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west3-a"}})
+	require.NoError(t, err)
+
+	config := model.GardenerConfig{
+		ID:                     "config-id",
+		ClusterID:              "cluster-id",
+		Name:                   "shoot",
+		KubernetesVersion:      "1.25.3",
+		MachineImage:           util.StringPtr("gardenlinux"),
+		AutoScalerMax:          5,
+		GardenerProviderConfig: providerConfig,
+		HibernationSchedules:   []model.HibernationSchedule{{Start: util.StringPtr("00 20 * * 1,2,3,4,5")}},
+		WorkerPools:            []model.WorkerPool{{Name: "pool", MachineType: "n2-standard-4"}},
+	}
+
+	// when
+	data, encodeErr := newGardenerConfigSnapshot(config)
+	require.NoError(t, encodeErr)
+
+	decoded, decodeErr := decodeGardenerConfigSnapshot(data)
+	require.NoError(t, decodeErr)
+
+	// then
+	assert.Equal(t, config.KubernetesVersion, decoded.KubernetesVersion)
+	assert.Equal(t, config.MachineImage, decoded.MachineImage)
+	assert.Equal(t, config.HibernationSchedules, decoded.HibernationSchedules)
+	assert.Equal(t, config.WorkerPools, decoded.WorkerPools)
+	assert.Equal(t, providerConfig.RawJSON(), decoded.GardenerProviderConfig.RawJSON())
+}
@@ -107,6 +107,29 @@ func (_m *ReadSession) GetOperation(operationID string) (model.Operation, apperr
 	return r0, r1
 }
 
+// GetPreUpgradeGardenerConfig provides a mock function with given fields: operationID
+func (_m *ReadSession) GetPreUpgradeGardenerConfig(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetProviderSpecificConfigsByProvider provides a mock function with given fields: provider
 func (_m *ReadSession) GetProviderSpecificConfigsByProvider(provider string) ([]dbsession.ProviderData, apperrors.AppError) {
 	ret := _m.Called(provider)
@@ -247,6 +270,31 @@ func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, apper
 	return r0, r1
 }
 
+// ListClusters provides a mock function with given fields: filter, limit, after
+func (_m *ReadSession) ListClusters(filter model.RuntimeFilter, limit int, after *model.PageCursor) ([]model.ListedCluster, apperrors.AppError) {
+	ret := _m.Called(filter, limit, after)
+
+	var r0 []model.ListedCluster
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, int, *model.PageCursor) []model.ListedCluster); ok {
+		r0 = rf(filter, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ListedCluster)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, int, *model.PageCursor) apperrors.AppError); ok {
+		r1 = rf(filter, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -272,6 +320,31 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, limit, after
+func (_m *ReadSession) ListOperations(filter model.OperationFilter, limit int, after *model.PageCursor) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(filter, limit, after)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, int, *model.PageCursor) []model.Operation); ok {
+		r0 = rf(filter, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, int, *model.PageCursor) apperrors.AppError); ok {
+		r1 = rf(filter, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewReadSession interface {
 	mock.TestingT
 	Cleanup(func())
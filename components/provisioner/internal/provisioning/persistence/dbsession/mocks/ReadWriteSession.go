@@ -17,6 +17,22 @@ type ReadWriteSession struct {
 	mock.Mock
 }
 
+// CompareAndSetOperationState provides a mock function with given fields: operationID, message, expectedState, state, endTime
+func (_m *ReadWriteSession) CompareAndSetOperationState(operationID string, message string, expectedState model.OperationState, state model.OperationState, endTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, expectedState, state, endTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, model.OperationState, model.OperationState, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, expectedState, state, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -141,6 +157,29 @@ func (_m *ReadWriteSession) GetOperation(operationID string) (model.Operation, a
 	return r0, r1
 }
 
+// GetPreUpgradeGardenerConfig provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetPreUpgradeGardenerConfig(operationID string) (model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.GardenerConfig
+	if rf, ok := ret.Get(0).(func(string) model.GardenerConfig); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfig)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetProviderSpecificConfigsByProvider provides a mock function with given fields: provider
 func (_m *ReadWriteSession) GetProviderSpecificConfigsByProvider(provider string) ([]dbsession.ProviderData, apperrors.AppError) {
 	ret := _m.Called(provider)
@@ -377,6 +416,47 @@ func (_m *ReadWriteSession) InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpg
 	return r0
 }
 
+// InsertShootUpgrade provides a mock function with given fields: operationID, preUpgradeConfig
+func (_m *ReadWriteSession) InsertShootUpgrade(operationID string, preUpgradeConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(operationID, preUpgradeConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(operationID, preUpgradeConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ListClusters provides a mock function with given fields: filter, limit, after
+func (_m *ReadWriteSession) ListClusters(filter model.RuntimeFilter, limit int, after *model.PageCursor) ([]model.ListedCluster, apperrors.AppError) {
+	ret := _m.Called(filter, limit, after)
+
+	var r0 []model.ListedCluster
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, int, *model.PageCursor) []model.ListedCluster); ok {
+		r0 = rf(filter, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ListedCluster)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, int, *model.PageCursor) apperrors.AppError); ok {
+		r1 = rf(filter, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -402,6 +482,31 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, limit, after
+func (_m *ReadWriteSession) ListOperations(filter model.OperationFilter, limit int, after *model.PageCursor) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(filter, limit, after)
+
+	var r0 []model.Operation
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, int, *model.PageCursor) []model.Operation); ok {
+		r0 = rf(filter, limit, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, int, *model.PageCursor) apperrors.AppError); ok {
+		r1 = rf(filter, limit, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -418,6 +523,22 @@ func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.App
 	return r0
 }
 
+// MarkOperationAsCanceling provides a mock function with given fields: operationID, message
+func (_m *ReadWriteSession) MarkOperationAsCanceling(operationID string, message string) apperrors.AppError {
+	ret := _m.Called(operationID, message)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // SetActiveKymaConfig provides a mock function with given fields: runtimeID, kymaConfigId
 func (_m *ReadWriteSession) SetActiveKymaConfig(runtimeID string, kymaConfigId string) apperrors.AppError {
 	ret := _m.Called(runtimeID, kymaConfigId)
@@ -498,13 +619,13 @@ func (_m *ReadWriteSession) UpdateKubernetesVersion(runtimeID string, version st
 	return r0
 }
 
-// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component
-func (_m *ReadWriteSession) UpdateOperationLastError(operationID string, msg string, reason string, component string) apperrors.AppError {
-	ret := _m.Called(operationID, msg, reason, component)
+// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component, retriable
+func (_m *ReadWriteSession) UpdateOperationLastError(operationID string, msg string, reason string, component string, retriable bool) apperrors.AppError {
+	ret := _m.Called(operationID, msg, reason, component, retriable)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string, string, string) apperrors.AppError); ok {
-		r0 = rf(operationID, msg, reason, component)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, bool) apperrors.AppError); ok {
+		r0 = rf(operationID, msg, reason, component, retriable)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
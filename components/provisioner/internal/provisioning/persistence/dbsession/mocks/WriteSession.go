@@ -17,6 +17,22 @@ type WriteSession struct {
 	mock.Mock
 }
 
+// CompareAndSetOperationState provides a mock function with given fields: operationID, message, expectedState, state, endTime
+func (_m *WriteSession) CompareAndSetOperationState(operationID string, message string, expectedState model.OperationState, state model.OperationState, endTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, expectedState, state, endTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, model.OperationState, model.OperationState, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, expectedState, state, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *WriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -145,6 +161,22 @@ func (_m *WriteSession) InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpgrade
 	return r0
 }
 
+// InsertShootUpgrade provides a mock function with given fields: operationID, preUpgradeConfig
+func (_m *WriteSession) InsertShootUpgrade(operationID string, preUpgradeConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(operationID, preUpgradeConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(operationID, preUpgradeConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -161,6 +193,22 @@ func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppErro
 	return r0
 }
 
+// MarkOperationAsCanceling provides a mock function with given fields: operationID, message
+func (_m *WriteSession) MarkOperationAsCanceling(operationID string, message string) apperrors.AppError {
+	ret := _m.Called(operationID, message)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // SetActiveKymaConfig provides a mock function with given fields: runtimeID, kymaConfigId
 func (_m *WriteSession) SetActiveKymaConfig(runtimeID string, kymaConfigId string) apperrors.AppError {
 	ret := _m.Called(runtimeID, kymaConfigId)
@@ -241,13 +289,13 @@ func (_m *WriteSession) UpdateKubernetesVersion(runtimeID string, version string
 	return r0
 }
 
-// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component
-func (_m *WriteSession) UpdateOperationLastError(operationID string, msg string, reason string, component string) apperrors.AppError {
-	ret := _m.Called(operationID, msg, reason, component)
+// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component, retriable
+func (_m *WriteSession) UpdateOperationLastError(operationID string, msg string, reason string, component string, retriable bool) apperrors.AppError {
+	ret := _m.Called(operationID, msg, reason, component, retriable)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string, string, string) apperrors.AppError); ok {
-		r0 = rf(operationID, msg, reason, component)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, bool) apperrors.AppError); ok {
+		r0 = rf(operationID, msg, reason, component, retriable)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
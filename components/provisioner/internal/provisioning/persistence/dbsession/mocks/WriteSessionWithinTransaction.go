@@ -33,6 +33,22 @@ func (_m *WriteSessionWithinTransaction) Commit() apperrors.AppError {
 	return r0
 }
 
+// CompareAndSetOperationState provides a mock function with given fields: operationID, message, expectedState, state, endTime
+func (_m *WriteSessionWithinTransaction) CompareAndSetOperationState(operationID string, message string, expectedState model.OperationState, state model.OperationState, endTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, expectedState, state, endTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, model.OperationState, model.OperationState, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, expectedState, state, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *WriteSessionWithinTransaction) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -161,6 +177,22 @@ func (_m *WriteSessionWithinTransaction) InsertRuntimeUpgrade(runtimeUpgrade mod
 	return r0
 }
 
+// InsertShootUpgrade provides a mock function with given fields: operationID, preUpgradeConfig
+func (_m *WriteSessionWithinTransaction) InsertShootUpgrade(operationID string, preUpgradeConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(operationID, preUpgradeConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(operationID, preUpgradeConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *WriteSessionWithinTransaction) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -177,6 +209,22 @@ func (_m *WriteSessionWithinTransaction) MarkClusterAsDeleted(runtimeID string)
 	return r0
 }
 
+// MarkOperationAsCanceling provides a mock function with given fields: operationID, message
+func (_m *WriteSessionWithinTransaction) MarkOperationAsCanceling(operationID string, message string) apperrors.AppError {
+	ret := _m.Called(operationID, message)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // RollbackUnlessCommitted provides a mock function with given fields:
 func (_m *WriteSessionWithinTransaction) RollbackUnlessCommitted() {
 	_m.Called()
@@ -262,13 +310,13 @@ func (_m *WriteSessionWithinTransaction) UpdateKubernetesVersion(runtimeID strin
 	return r0
 }
 
-// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component
-func (_m *WriteSessionWithinTransaction) UpdateOperationLastError(operationID string, msg string, reason string, component string) apperrors.AppError {
-	ret := _m.Called(operationID, msg, reason, component)
+// UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component, retriable
+func (_m *WriteSessionWithinTransaction) UpdateOperationLastError(operationID string, msg string, reason string, component string, retriable bool) apperrors.AppError {
+	ret := _m.Called(operationID, msg, reason, component, retriable)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string, string, string) apperrors.AppError); ok {
-		r0 = rf(operationID, msg, reason, component)
+	if rf, ok := ret.Get(0).(func(string, string, string, string, bool) apperrors.AppError); ok {
+		r0 = rf(operationID, msg, reason, component, retriable)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
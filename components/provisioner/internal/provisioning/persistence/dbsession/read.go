@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gocraft/dbr/v2"
 
@@ -181,10 +182,10 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"cluster.creation_timestamp", "cluster.deleted", "cluster.active_kyma_config_id",
 			"name", "project_name", "kubernetes_version",
 			"volume_size_gb", "disk_type", "machine_type", "machine_image", "machine_image_version",
-			"provider", "purpose", "seed", "target_secret", "worker_cidr", "region", "auto_scaler_min",
+			"provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "hibernation_schedules", "worker_pools", "api_server_allowed_cidrs").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
 		Where(dbr.Eq("name", name)).
@@ -340,7 +341,10 @@ func (r readSession) getClusterAdministrators(runtimeID string) ([]model.Cluster
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig    string `db:"provider_specific_config"`
+	HibernationSchedulesJSON  []byte `db:"hibernation_schedules"`
+	WorkerPoolsJSON           []byte `db:"worker_pools"`
+	APIServerAllowedCidrsJSON []byte `db:"api_server_allowed_cidrs"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -350,6 +354,24 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	}
 
 	gcr.GardenerProviderConfig = gardenerConfigProviderConfig
+
+	if len(gcr.HibernationSchedulesJSON) > 0 {
+		if err := json.Unmarshal(gcr.HibernationSchedulesJSON, &gcr.HibernationSchedules); err != nil {
+			return fmt.Errorf("error decoding hibernation schedules: %s", err.Error())
+		}
+	}
+
+	if len(gcr.WorkerPoolsJSON) > 0 {
+		if err := json.Unmarshal(gcr.WorkerPoolsJSON, &gcr.WorkerPools); err != nil {
+			return fmt.Errorf("error decoding worker pools: %s", err.Error())
+		}
+	}
+
+	if len(gcr.APIServerAllowedCidrsJSON) > 0 {
+		if err := json.Unmarshal(gcr.APIServerAllowedCidrsJSON, &gcr.APIServerAllowedCidrs); err != nil {
+			return fmt.Errorf("error decoding API server allowed CIDRs: %s", err.Error())
+		}
+	}
 	return nil
 }
 
@@ -359,11 +381,12 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 	err := r.session.
 		Select("gardener_config.id", "cluster_id", "gardener_config.name", "project_name",
 			"kubernetes_version", "volume_size_gb", "disk_type", "machine_type", "machine_image",
-			"machine_image_version", "provider", "purpose", "seed", "target_secret", "worker_cidr", "region",
+			"machine_image_version", "provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region",
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access",
+			"hibernation_schedules", "worker_pools", "api_server_allowed_cidrs").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -387,7 +410,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 
 var (
 	operationColumns = []string{
-		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component",
+		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component", "retriable",
 	}
 )
 
@@ -440,7 +463,7 @@ func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Err
 	_, err := r.session.
 		Select(operationColumns...).
 		From("operation").
-		Where(dbr.Eq("state", model.InProgress)).
+		Where(dbr.Eq("state", []model.OperationState{model.InProgress, model.Canceling})).
 		Load(&operations)
 
 	if err != nil {
@@ -453,6 +476,158 @@ func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Err
 	return operations, nil
 }
 
+type clusterListRow struct {
+	model.Cluster
+	Name              string
+	KubernetesVersion string
+	Provider          string
+	Region            string
+
+	OperationID             dbr.NullString
+	OperationType           dbr.NullString
+	OperationStartTimestamp dbr.NullTime
+	OperationEndTimestamp   *time.Time
+	OperationState          dbr.NullString
+	OperationMessage        dbr.NullString
+	OperationStage          dbr.NullString
+	OperationLastTransition *time.Time
+	OperationErrMessage     dbr.NullString
+	OperationReason         dbr.NullString
+	OperationComponent      dbr.NullString
+	OperationRetriable      dbr.NullBool
+}
+
+// lastOperationJoin selects the last operation of every listed cluster in the same query
+const lastOperationJoin = `LATERAL (
+	SELECT id, type, start_timestamp, end_timestamp, state, message, stage, last_transition, err_message, reason, component, retriable
+	FROM operation
+	WHERE operation.cluster_id = cluster.id
+	ORDER BY operation.start_timestamp DESC
+	LIMIT 1) AS last_operation`
+
+func (r readSession) ListClusters(filter model.RuntimeFilter, limit int, after *model.PageCursor) ([]model.ListedCluster, dberrors.Error) {
+	query := r.session.
+		Select(
+			"cluster.id", "cluster.tenant", "cluster.creation_timestamp", "cluster.deleted", "cluster.sub_account_id",
+			"gardener_config.name", "gardener_config.kubernetes_version", "gardener_config.provider", "gardener_config.region",
+			"last_operation.id AS operation_id", "last_operation.type AS operation_type",
+			"last_operation.start_timestamp AS operation_start_timestamp", "last_operation.end_timestamp AS operation_end_timestamp",
+			"last_operation.state AS operation_state", "last_operation.message AS operation_message",
+			"last_operation.stage AS operation_stage", "last_operation.last_transition AS operation_last_transition",
+			"last_operation.err_message AS operation_err_message", "last_operation.reason AS operation_reason",
+			"last_operation.component AS operation_component", "last_operation.retriable AS operation_retriable").
+		From("cluster").
+		Join("gardener_config", "gardener_config.cluster_id=cluster.id").
+		LeftJoin(dbr.Expr(lastOperationJoin), "true").
+		Where(dbr.Eq("cluster.tenant", filter.Tenant)).
+		Where(dbr.Eq("cluster.deleted", false))
+
+	if filter.Name != nil {
+		query = query.Where(dbr.Eq("gardener_config.name", *filter.Name))
+	}
+	if filter.SubAccountID != nil {
+		query = query.Where(dbr.Eq("cluster.sub_account_id", *filter.SubAccountID))
+	}
+	if filter.Provider != nil {
+		query = query.Where(dbr.Eq("gardener_config.provider", *filter.Provider))
+	}
+	if filter.Region != nil {
+		query = query.Where(dbr.Eq("gardener_config.region", *filter.Region))
+	}
+	if after != nil {
+		query = query.Where("(cluster.creation_timestamp, cluster.id) > (?, ?)", after.Timestamp, after.ID)
+	}
+
+	var rows []clusterListRow
+
+	_, err := query.
+		OrderAsc("cluster.creation_timestamp").
+		OrderAsc("cluster.id").
+		Limit(uint64(limit)).
+		Load(&rows)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list Clusters: %s", err)
+	}
+
+	clusters := make([]model.ListedCluster, 0, len(rows))
+	for _, row := range rows {
+		cluster := model.ListedCluster{Cluster: row.Cluster}
+		cluster.ClusterConfig = model.GardenerConfig{
+			ClusterID:         row.ID,
+			Name:              row.Name,
+			KubernetesVersion: row.KubernetesVersion,
+			Provider:          row.Provider,
+			Region:            row.Region,
+		}
+		if row.OperationID.Valid {
+			cluster.LastOperation = &model.Operation{
+				ID:             row.OperationID.String,
+				Type:           model.OperationType(row.OperationType.String),
+				StartTimestamp: row.OperationStartTimestamp.Time,
+				EndTimestamp:   row.OperationEndTimestamp,
+				State:          model.OperationState(row.OperationState.String),
+				Message:        row.OperationMessage.String,
+				ClusterID:      row.ID,
+				Stage:          model.OperationStage(row.OperationStage.String),
+				LastTransition: row.OperationLastTransition,
+				LastError: model.LastError{
+					ErrMessage: row.OperationErrMessage.String,
+					Reason:     row.OperationReason.String,
+					Component:  row.OperationComponent.String,
+					Retriable:  row.OperationRetriable.Bool,
+				},
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+func (r readSession) ListOperations(filter model.OperationFilter, limit int, after *model.PageCursor) ([]model.Operation, dberrors.Error) {
+	columns := make([]string, 0, len(operationColumns))
+	for _, column := range operationColumns {
+		columns = append(columns, "operation."+column)
+	}
+
+	query := r.session.
+		Select(columns...).
+		From("operation").
+		Join("cluster", "operation.cluster_id=cluster.id").
+		Where(dbr.Eq("cluster.tenant", filter.Tenant))
+
+	if filter.RuntimeID != nil {
+		query = query.Where(dbr.Eq("operation.cluster_id", *filter.RuntimeID))
+	}
+	if filter.Type != nil {
+		query = query.Where(dbr.Eq("operation.type", *filter.Type))
+	}
+	if filter.State != nil {
+		query = query.Where(dbr.Eq("operation.state", *filter.State))
+	}
+	if filter.Since != nil {
+		query = query.Where(dbr.Gte("operation.start_timestamp", *filter.Since))
+	}
+	if after != nil {
+		query = query.Where("(operation.start_timestamp, operation.id) < (?, ?)", after.Timestamp, after.ID)
+	}
+
+	var operations []model.Operation
+
+	_, err := query.
+		OrderDesc("operation.start_timestamp").
+		OrderDesc("operation.id").
+		Limit(uint64(limit)).
+		Load(&operations)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list operations: %s", err)
+	}
+
+	return operations, nil
+}
+
 func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error) {
 	var runtimeUpgrade model.RuntimeUpgrade
 
@@ -472,6 +647,30 @@ func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade
 	return runtimeUpgrade, nil
 }
 
+func (r readSession) GetPreUpgradeGardenerConfig(operationID string) (model.GardenerConfig, dberrors.Error) {
+	var snapshot []byte
+
+	err := r.session.
+		Select("pre_upgrade_gardener_config").
+		From("shoot_upgrade").
+		Where(dbr.Eq("operation_id", operationID)).
+		LoadOne(&snapshot)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.GardenerConfig{}, dberrors.NotFound("Shoot upgrade not found for operation with %s id", operationID)
+		}
+		return model.GardenerConfig{}, dberrors.Internal("Failed to get Shoot upgrade for operation %s: %s", operationID, err)
+	}
+
+	config, err := decodeGardenerConfigSnapshot(snapshot)
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode pre-upgrade Gardener config of operation %s: %s", operationID, err.Error())
+	}
+
+	return config, nil
+}
+
 func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberrors.Error) {
 	var opsCount []struct {
 		Type  model.OperationType
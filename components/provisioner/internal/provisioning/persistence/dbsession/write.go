@@ -92,7 +92,22 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
-	_, err := ws.insertInto("gardener_config").
+	hibernationSchedules, err := json.Marshal(config.HibernationSchedules)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal hibernation schedules: %s", err.Error())
+	}
+
+	workerPools, err := json.Marshal(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal worker pools: %s", err.Error())
+	}
+
+	apiServerAllowedCidrs, err := json.Marshal(config.APIServerAllowedCidrs)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal API server allowed CIDRs: %s", err.Error())
+	}
+
+	_, err = ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
 		Pair("project_name", config.ProjectName).
@@ -110,6 +125,8 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("target_secret", config.TargetSecret).
 		Pair("disk_type", config.DiskType).
 		Pair("worker_cidr", config.WorkerCidr).
+		Pair("pods_cidr", config.PodsCidr).
+		Pair("services_cidr", config.ServicesCidr).
 		Pair("auto_scaler_min", config.AutoScalerMin).
 		Pair("auto_scaler_max", config.AutoScalerMax).
 		Pair("max_surge", config.MaxSurge).
@@ -121,6 +138,9 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
 		Pair("eu_access", config.EuAccess).
+		Pair("hibernation_schedules", hibernationSchedules).
+		Pair("worker_pools", workerPools).
+		Pair("api_server_allowed_cidrs", apiServerAllowedCidrs).
 		Exec()
 
 	if err != nil {
@@ -204,6 +224,21 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	hibernationSchedules, err := json.Marshal(config.HibernationSchedules)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal hibernation schedules: %s", err.Error())
+	}
+
+	workerPools, err := json.Marshal(config.WorkerPools)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal worker pools: %s", err.Error())
+	}
+
+	apiServerAllowedCidrs, err := json.Marshal(config.APIServerAllowedCidrs)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal API server allowed CIDRs: %s", err.Error())
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -224,6 +259,9 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("hibernation_schedules", hibernationSchedules).
+		Set("worker_pools", workerPools).
+		Set("api_server_allowed_cidrs", apiServerAllowedCidrs).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -386,12 +424,46 @@ func (ws writeSession) UpdateOperationState(operationID string, message string,
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: %s", operationID, err))
 }
 
-func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error {
+// CompareAndSetOperationState sets the state of the operation only if the operation is still in the expected state,
+// so that the progress or the result of a step does not overwrite a cancellation requested in the meantime
+func (ws writeSession) CompareAndSetOperationState(operationID string, message string, expectedState, state model.OperationState, endTime time.Time) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.Eq("id", operationID)).
+		Where(dbr.Eq("state", expectedState)).
+		Set("state", state).
+		Set("message", message).
+		Set("end_timestamp", endTime).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update operation %s state: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: operation is not in state %s", operationID, expectedState))
+}
+
+func (ws writeSession) MarkOperationAsCanceling(operationID string, message string) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.Eq("id", operationID)).
+		Where(dbr.Eq("state", model.InProgress)).
+		Set("state", model.Canceling).
+		Set("message", message).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to mark operation %s as canceling: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to mark operation %s as canceling: operation is not in progress", operationID))
+}
+
+func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, component string, retriable bool) dberrors.Error {
 	res, err := ws.update("operation").
 		Where(dbr.Eq("id", operationID)).
 		Set("err_message", msg).
 		Set("reason", reason).
 		Set("component", component).
+		Set("retriable", retriable).
 		Exec()
 
 	if err != nil {
@@ -532,6 +604,24 @@ func (ws writeSession) InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpgrade)
 	return nil
 }
 
+// InsertShootUpgrade stores the Gardener config from before the shoot upgrade, so that a canceled upgrade can be reverted
+func (ws writeSession) InsertShootUpgrade(operationID string, preUpgradeConfig model.GardenerConfig) dberrors.Error {
+	snapshot, err := newGardenerConfigSnapshot(preUpgradeConfig)
+	if err != nil {
+		return dberrors.Internal("Failed to marshal pre-upgrade Gardener config: %s", err.Error())
+	}
+
+	_, err = ws.insertInto("shoot_upgrade").
+		Pair("operation_id", operationID).
+		Pair("pre_upgrade_gardener_config", snapshot).
+		Exec()
+	if err != nil {
+		return dberrors.Internal("Failed to insert Shoot Upgrade: %s", err.Error())
+	}
+
+	return nil
+}
+
 func (ws writeSession) UpdateTenant(runtimeID, tenant string) dberrors.Error {
 	res, err := ws.update("cluster").
 		Where(dbr.Eq("id", runtimeID)).
@@ -31,6 +31,10 @@ type Service interface {
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
 	RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	HibernateCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ListRuntimes(filter model.RuntimeFilter, first *int, after *string) (*gqlschema.RuntimePage, apperrors.AppError)
+	ListOperations(filter model.OperationFilter, first *int, after *string) (*gqlschema.OperationPage, apperrors.AppError)
+	CancelOperation(operationID string) (*gqlschema.OperationStatus, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
@@ -39,7 +43,10 @@ type Provisioner interface {
 	DeprovisionCluster(cluster model.Cluster, withoutInstallation bool, operationId string) (model.Operation, apperrors.AppError)
 	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
 	HibernateCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
 	GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError)
+	RenderShoot(cluster model.Cluster) (*gardener_Types.Shoot, apperrors.AppError)
+	RenderShootUpgrade(clusterID string, upgradeConfig model.GardenerConfig) (*gardener_Types.Shoot, *gardener_Types.Shoot, apperrors.AppError)
 }
 
 //go:generate mockery --name=ShootProvider
@@ -65,6 +72,7 @@ type service struct {
 	upgradeQueue                 queue.OperationQueue
 	shootUpgradeQueue            queue.OperationQueue
 	hibernationQueue             queue.OperationQueue
+	wakeUpQueue                  queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -83,6 +91,7 @@ func NewProvisioningService(
 	upgradeQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
 	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 
 ) Service {
 	return &service{
@@ -99,12 +108,17 @@ func NewProvisioningService(
 		upgradeQueue:                 upgradeQueue,
 		shootUpgradeQueue:            shootUpgradeQueue,
 		hibernationQueue:             hibernationQueue,
+		wakeUpQueue:                  wakeUpQueue,
 		shootProvider:                shootProvider,
 		installationClient:           installationClient,
 	}
 }
 
 func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	if util.UnwrapBoolOrDefault(config.DryRun, false) {
+		return r.dryRunProvisioning(config, tenant, subAccount)
+	}
+
 	runtimeInput := config.RuntimeInput
 
 	var runtimeID string
@@ -260,6 +274,11 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	if err != nil {
 		return &gqlschema.OperationStatus{}, err.Append("Invalid gardener provider config change")
 	}
+
+	if util.UnwrapBoolOrDefault(input.DryRun, false) {
+		return r.dryRunShootUpgrade(cluster, gardenerConfig)
+	}
+
 	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
 	if dbErr != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
@@ -327,13 +346,105 @@ func (r *service) HibernateCluster(runtimeID string) (*gqlschema.OperationStatus
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) WakeUpCluster(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake up for Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find shoot cluster to wake up in database: %s", dberr.Error())
+	}
+
+	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, gardError := r.setWakeUpStarted(txSession, cluster)
+	if gardError != nil {
+		return nil, apperrors.Internal("Failed to set wake up started: %s", gardError.Error())
+	}
+
+	err = r.provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		return nil, err.Append("Failed to wake up Cluster")
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to commit wake up transaction: %s", dbErr.Error())
+	}
+
+	r.wakeUpQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) CancelOperation(operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Canceling operation '%s'...", operationID)
+
+	session := r.dbSessionFactory.NewReadWriteSession()
+
+	operation, dberr := session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation to cancel")
+	}
+
+	operationQueue, cancelable := r.cancelableOperationQueue(operation.Type)
+	if !cancelable {
+		return nil, apperrors.BadRequest("cannot cancel %s operation %s", operation.Type, operationID)
+	}
+
+	if operation.State != model.InProgress {
+		return nil, apperrors.BadRequest("cannot cancel operation %s in state %s", operationID, operation.State)
+	}
+
+	message := "Operation canceling"
+	dberr = session.MarkOperationAsCanceling(operationID, message)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return nil, apperrors.BadRequest("cannot cancel operation %s: operation is no longer in progress", operationID)
+		}
+		return nil, dberr.Append("failed to cancel operation")
+	}
+
+	operationQueue.Add(operationID)
+
+	operation.State = model.Canceling
+	operation.Message = message
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) cancelableOperationQueue(operationType model.OperationType) (queue.OperationQueue, bool) {
+	switch operationType {
+	case model.Provision:
+		return r.provisioningQueue, true
+	case model.ProvisionNoInstall:
+		return r.provisioningNoInstallQueue, true
+	case model.UpgradeShoot:
+		return r.shootUpgradeQueue, true
+	case model.Hibernate:
+		return r.hibernationQueue, true
+	default:
+		return nil, false
+	}
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
 		return dberr.Append("failed to get last operation")
 	}
 
-	if lastOperation.State == model.InProgress {
+	if lastOperation.State == model.InProgress || lastOperation.State == model.Canceling {
 		return apperrors.BadRequest("cannot start new operation for %s Runtime while previous one is in progress", runtimeId)
 	}
 
@@ -437,6 +548,66 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) ListRuntimes(filter model.RuntimeFilter, first *int, after *string) (*gqlschema.RuntimePage, apperrors.AppError) {
+	limit, cursor, appErr := pageParameters(first, after)
+	if appErr != nil {
+		return nil, appErr.Append("failed to list Runtimes")
+	}
+
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	clusters, dberr := readSession.ListClusters(filter, limit+1, cursor)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+
+	pageInfo := &gqlschema.PageInfo{HasNextPage: len(clusters) > limit}
+	if pageInfo.HasNextPage {
+		clusters = clusters[:limit]
+	}
+	if len(clusters) > 0 {
+		last := clusters[len(clusters)-1]
+		pageInfo.EndCursor = encodePageCursor(model.PageCursor{Timestamp: last.CreationTimestamp, ID: last.ID})
+	}
+
+	runtimes := make([]*gqlschema.Runtime, 0, len(clusters))
+	for _, cluster := range clusters {
+		runtimes = append(runtimes, r.graphQLConverter.RuntimeToGraphQLRuntime(cluster.Cluster, cluster.LastOperation))
+	}
+
+	return &gqlschema.RuntimePage{Data: runtimes, PageInfo: pageInfo}, nil
+}
+
+func (r *service) ListOperations(filter model.OperationFilter, first *int, after *string) (*gqlschema.OperationPage, apperrors.AppError) {
+	limit, cursor, appErr := pageParameters(first, after)
+	if appErr != nil {
+		return nil, appErr.Append("failed to list operations")
+	}
+
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	operations, dberr := readSession.ListOperations(filter, limit+1, cursor)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list operations")
+	}
+
+	pageInfo := &gqlschema.PageInfo{HasNextPage: len(operations) > limit}
+	if pageInfo.HasNextPage {
+		operations = operations[:limit]
+	}
+	if len(operations) > 0 {
+		last := operations[len(operations)-1]
+		pageInfo.EndCursor = encodePageCursor(model.PageCursor{Timestamp: last.StartTimestamp, ID: last.ID})
+	}
+
+	statuses := make([]*gqlschema.OperationStatus, 0, len(operations))
+	for _, operation := range operations {
+		statuses = append(statuses, r.graphQLConverter.OperationStatusToGQLOperationStatus(operation))
+	}
+
+	return &gqlschema.OperationPage{Data: statuses, PageInfo: pageInfo}, nil
+}
+
 func (r *service) RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
 
 	readSession := r.dbSessionFactory.NewReadSession()
@@ -573,6 +744,11 @@ func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSessio
 		return model.Operation{}, dbError.Append("Failed to start operation of Gardener Shoot upgrade %s", dbError.Error())
 	}
 
+	dberr = txSession.InsertShootUpgrade(operation.ID, currentCluster.ClusterConfig)
+	if dberr != nil {
+		return model.Operation{}, dberrors.Internal("Failed to set Shoot Upgrade started: %s", dberr.Error())
+	}
+
 	return operation, nil
 }
 
@@ -621,6 +797,18 @@ func (r *service) setHibernationStarted(txSession dbsession.WriteSession, curren
 	return operation, nil
 }
 
+func (r *service) setWakeUpStarted(txSession dbsession.WriteSession, currentCluster model.Cluster) (model.Operation, error) {
+	log.Infof("Starting wake up operation")
+
+	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.WakeUp, model.WaitForWakeUp, time.Now(), "Starting wake up")
+
+	if dbError != nil {
+		return model.Operation{}, dbError.Append("Failed to start wake up operation:  %s", dbError.Error())
+	}
+
+	return operation, nil
+}
+
 func (r *service) setOperationStarted(
 	dbSession dbsession.WriteSession,
 	runtimeID string,
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	installationSDK "github.com/kyma-incubator/hydroform/install/installation"
 	"github.com/kyma-project/kyma/components/kyma-operator/pkg/apis/installer/v1alpha1"
@@ -125,7 +126,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -170,7 +171,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningNoInstallQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
@@ -205,7 +206,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -237,7 +238,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -258,7 +259,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return("", apperrors.Internal("registering error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -293,7 +294,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -307,6 +308,41 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		directorServiceMock.AssertExpectations(t)
 		provisioner.AssertExpectations(t)
 	})
+
+	t.Run("Should render Shoot without registering Runtime when dry run is requested", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		directorServiceMock := &directormock.DirectorClient{}
+		provisioner := &mocks2.Provisioner{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		dryRunInput := provisionRuntimeInput
+		dryRunInput.DryRun = util.BoolPtr(true)
+
+		shoot := &gardener_Types.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot"},
+			Spec:       gardener_Types.ShootSpec{Region: "europe-west1"},
+		}
+		provisioner.On("RenderShoot", mock.AnythingOfType("model.Cluster")).Return(shoot, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		operationStatus, err := service.ProvisionRuntime(dryRunInput, tenant, subAccountId)
+		require.NoError(t, err)
+
+		// then
+		assert.Nil(t, operationStatus.ID)
+		assert.Equal(t, gqlschema.OperationTypeProvision, operationStatus.Operation)
+		require.NotNil(t, operationStatus.DryRunResult)
+		assert.Contains(t, operationStatus.DryRunResult.Shoot, "kind: Shoot")
+		assert.Contains(t, operationStatus.DryRunResult.Shoot, "region: europe-west1")
+		assert.Empty(t, operationStatus.DryRunResult.Diff)
+		provisioner.AssertExpectations(t)
+		directorServiceMock.AssertNotCalled(t, "CreateRuntime", mock.Anything, mock.Anything)
+		sessionFactoryMock.AssertNotCalled(t, "NewSessionWithinTransaction")
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
 }
 
 func TestService_DeprovisionRuntime(t *testing.T) {
@@ -366,7 +402,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -399,7 +435,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(errorEmptyState, errors.New("Installation error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -437,7 +473,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -480,7 +516,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 		installationClient.On("CheckInstallationState", mock.Anything).Return(notInstalledState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -527,7 +563,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), true, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -554,7 +590,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -579,7 +615,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -603,7 +639,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -626,7 +662,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -662,7 +698,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeOperationStatus(operationID)
@@ -685,7 +721,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeOperationStatus(operationID)
@@ -731,7 +767,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeStatus(operationID)
@@ -753,7 +789,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -772,7 +808,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -794,7 +830,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
 		provisioner.On("GetHibernationStatus", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(model.HibernationStatus{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -921,7 +957,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider, upgradeQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1010,7 +1046,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1085,6 +1121,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(nil)
 				provisioner.On("UpgradeCluster", runtimeID, newUpgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
@@ -1103,6 +1140,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
@@ -1123,7 +1161,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1153,6 +1191,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
@@ -1170,6 +1209,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(apperrors.Internal("error"))
@@ -1243,7 +1283,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1255,6 +1295,54 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 			readSession.AssertExpectations(t)
 		})
 	}
+
+	t.Run("should render Shoot upgrade without starting operation when dry run is requested", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		upgradeShootQueue := &mocks.OperationQueue{}
+
+		dryRunInput := upgradeShootInput
+		dryRunInput.DryRun = util.BoolPtr(true)
+
+		currentShoot := &gardener_Types.Shoot{
+			ObjectMeta: v1.ObjectMeta{Name: "shoot"},
+			Spec: gardener_Types.ShootSpec{
+				Provider: gardener_Types.Provider{
+					Workers: []gardener_Types.Worker{{Name: "cpu-worker-0", Machine: gardener_Types.Machine{Type: "n1-standard-4"}}},
+				},
+			},
+		}
+		upgradedShoot := currentShoot.DeepCopy()
+		upgradedShoot.Spec.Provider.Workers[0].Machine.Type = "n1-standard-8"
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+		provisioner.On("RenderShootUpgrade", runtimeID, upgradedConfig).Return(currentShoot, upgradedShoot, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
+
+		// when
+		operationStatus, err := service.UpgradeGardenerShoot(runtimeID, dryRunInput)
+		require.NoError(t, err)
+
+		// then
+		assert.Nil(t, operationStatus.ID)
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		require.NotNil(t, operationStatus.DryRunResult)
+		assert.Contains(t, operationStatus.DryRunResult.Shoot, "type: n1-standard-8")
+		require.Len(t, operationStatus.DryRunResult.Diff, 1)
+		assert.Equal(t, "spec.provider.workers[0].machine.type", operationStatus.DryRunResult.Diff[0].Path)
+		assert.Equal(t, `"n1-standard-4"`, *operationStatus.DryRunResult.Diff[0].Current)
+		assert.Equal(t, `"n1-standard-8"`, *operationStatus.DryRunResult.Diff[0].Desired)
+		provisioner.AssertExpectations(t)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		upgradeShootQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
 }
 
 func TestService_RollBackLastUpgrade(t *testing.T) {
@@ -1304,7 +1392,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		runtimeStatus, err := service.RollBackLastUpgrade(runtimeID)
@@ -1350,7 +1438,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.RollBackLastUpgrade(runtimeID)
@@ -1468,7 +1556,7 @@ func TestService_HibernateShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock, provisioner)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.HibernateCluster(runtimeID)
@@ -1500,7 +1588,7 @@ func TestService_HibernateShoot(t *testing.T) {
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		hibernationQueue.On("Add", mock.AnythingOfType("string")).Return()
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue, nil)
 
 		// when
 		runtimeStatus, err := service.HibernateCluster(runtimeID)
@@ -1515,6 +1603,91 @@ func TestService_HibernateShoot(t *testing.T) {
 	})
 }
 
+func TestService_WakeUpCluster(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	uuidGenerator := uuid.NewUUIDGenerator()
+	graphQLConverter := NewGraphQLConverter()
+
+	lastOperation := model.Operation{ID: operationID, State: model.Succeeded, Type: model.Hibernate}
+
+	cluster := model.Cluster{
+		ID: runtimeID,
+	}
+
+	timeNow := time.Now()
+	wakeUpOperation := model.Operation{
+		ID:             operationID,
+		Type:           model.WakeUp,
+		StartTimestamp: timeNow,
+		State:          model.InProgress,
+		ClusterID:      runtimeID,
+		Stage:          model.WaitForWakeUp,
+		LastTransition: &timeNow,
+	}
+
+	t.Run("Should return error when failed to wake up cluster", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		readSessionMock := &sessionMocks.ReadSession{}
+		provisionerMock := &mocks2.Provisioner{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSessionMock, nil)
+		readSessionMock.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSessionMock.On("GetCluster", runtimeID).Return(cluster, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return(nil)
+		provisionerMock.On("WakeUpCluster", cluster.ID, cluster.ClusterConfig).Return(apperrors.BadRequest("cluster is not hibernated"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.WakeUpCluster(runtimeID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		sessionFactoryMock.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		readSessionMock.AssertExpectations(t)
+		provisionerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should wake up cluster and return operation ID", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		readSessionMock := &sessionMocks.ReadSession{}
+		provisionerMock := &mocks2.Provisioner{}
+		wakeUpQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSessionMock, nil)
+		readSessionMock.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSessionMock.On("GetCluster", runtimeID).Return(cluster, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return(nil)
+		provisionerMock.On("WakeUpCluster", cluster.ID, cluster.ClusterConfig).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		wakeUpQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, wakeUpQueue)
+
+		// when
+		operationStatus, err := service.WakeUpCluster(runtimeID)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, gqlschema.OperationTypeWakeUp, operationStatus.Operation)
+		sessionFactoryMock.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		readSessionMock.AssertExpectations(t)
+		provisionerMock.AssertExpectations(t)
+		wakeUpQueue.AssertExpectations(t)
+	})
+}
+
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
 	return func(op model.Operation) bool {
 		return op.Type == expected.Type && op.ClusterID == expected.ClusterID &&
@@ -1531,3 +1704,303 @@ func getClusterMatcher(expected model.Cluster) func(model.Cluster) bool {
 func notEmptyUUIDMatcher(id string) bool {
 	return len(id) > 0
 }
+
+func TestService_ListRuntimes(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	creationTimestamp := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	filter := model.RuntimeFilter{Tenant: tenant}
+
+	fixCluster := func(id string, offset time.Duration, lastOperation *model.Operation) model.ListedCluster {
+		return model.ListedCluster{
+			Cluster: model.Cluster{
+				ID:                id,
+				Tenant:            tenant,
+				CreationTimestamp: creationTimestamp.Add(offset),
+				ClusterConfig: model.GardenerConfig{
+					Name:     "shoot-" + id,
+					Provider: "gcp",
+					Region:   "europe-west3",
+				},
+			},
+			LastOperation: lastOperation,
+		}
+	}
+
+	t.Run("Should return page with cursor when there are more Runtimes", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		first := 2
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListClusters", filter, 3, (*model.PageCursor)(nil)).
+			Return([]model.ListedCluster{
+				fixCluster("r1", 0, &model.Operation{ID: operationID, Type: model.Provision, State: model.Succeeded, ClusterID: "r1"}),
+				fixCluster("r2", time.Minute, nil),
+				fixCluster("r3", 2*time.Minute, nil),
+			}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(filter, &first, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, "r1", page.Data[0].ID)
+		assert.Equal(t, "shoot-r1", *page.Data[0].Name)
+		assert.Equal(t, gqlschema.OperationStateSucceeded, page.Data[0].LastOperationStatus.State)
+		assert.Nil(t, page.Data[1].LastOperationStatus)
+		assert.True(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, err := decodePageCursor(*page.PageInfo.EndCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "r2", cursor.ID)
+		assert.True(t, creationTimestamp.Add(time.Minute).Equal(cursor.Timestamp))
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should continue listing after cursor", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		cursor := model.PageCursor{Timestamp: creationTimestamp, ID: "r1"}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListClusters", filter, defaultPageSize+1, mock.MatchedBy(func(after *model.PageCursor) bool {
+			return after != nil && after.ID == cursor.ID && after.Timestamp.Equal(cursor.Timestamp)
+		})).Return([]model.ListedCluster{fixCluster("r2", time.Minute, nil)}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(filter, nil, encodePageCursor(cursor))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.False(t, page.PageInfo.HasNextPage)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return bad request for invalid page parameters", func(t *testing.T) {
+		// given
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		tooLarge := maxPageSize + 1
+		invalidCursor := "not-a-cursor"
+
+		// when
+		_, err := service.ListRuntimes(filter, &tooLarge, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+
+		// when
+		_, err = service.ListRuntimes(filter, nil, &invalidCursor)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("Should return error when failed to list Runtimes", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListClusters", filter, defaultPageSize+1, (*model.PageCursor)(nil)).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(filter, nil, nil)
+
+		// then
+		require.Error(t, err)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
+func TestService_ListOperations(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	startTimestamp := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	operationType := model.UpgradeShoot
+	filter := model.OperationFilter{Tenant: tenant, Type: &operationType}
+
+	t.Run("Should return last page of operations", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		first := 2
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", filter, 3, (*model.PageCursor)(nil)).Return([]model.Operation{
+			{ID: "op2", Type: model.UpgradeShoot, State: model.InProgress, ClusterID: runtimeID, StartTimestamp: startTimestamp.Add(time.Hour)},
+			{ID: "op1", Type: model.UpgradeShoot, State: model.Succeeded, ClusterID: runtimeID, StartTimestamp: startTimestamp},
+		}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListOperations(filter, &first, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, "op2", *page.Data[0].ID)
+		assert.Equal(t, gqlschema.OperationTypeUpgradeShoot, page.Data[0].Operation)
+		assert.False(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, err := decodePageCursor(*page.PageInfo.EndCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "op1", cursor.ID)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return empty page without cursor", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", filter, defaultPageSize+1, (*model.PageCursor)(nil)).Return([]model.Operation{}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListOperations(filter, nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.Nil(t, page.PageInfo.EndCursor)
+		assert.False(t, page.PageInfo.HasNextPage)
+	})
+
+	t.Run("Should return error when failed to list operations", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListOperations", filter, defaultPageSize+1, (*model.PageCursor)(nil)).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListOperations(filter, nil, nil)
+
+		// then
+		require.Error(t, err)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
+func TestService_CancelOperation(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	provisionOperation := model.Operation{
+		ID:        operationID,
+		Type:      model.Provision,
+		State:     model.InProgress,
+		ClusterID: runtimeID,
+		Stage:     model.WaitingForClusterCreation,
+	}
+
+	t.Run("Should mark operation as canceling and add it to the queue", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(provisionOperation, nil)
+		readWriteSession.On("MarkOperationAsCanceling", operationID, "Operation canceling").Return(nil)
+		provisioningQueue.On("Add", operationID).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.CancelOperation(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateCanceling, status.State)
+		assert.Equal(t, gqlschema.OperationTypeProvision, status.Operation)
+		sessionFactoryMock.AssertExpectations(t)
+		readWriteSession.AssertExpectations(t)
+		provisioningQueue.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		operation   model.Operation
+	}{
+		{
+			description: "Should return error when operation type cannot be canceled",
+			operation:   model.Operation{ID: operationID, Type: model.Deprovision, State: model.InProgress},
+		},
+		{
+			description: "Should return error when operation is not in progress",
+			operation:   model.Operation{ID: operationID, Type: model.Provision, State: model.Succeeded},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactoryMock := &sessionMocks.Factory{}
+			readWriteSession := &sessionMocks.ReadWriteSession{}
+
+			sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+			readWriteSession.On("GetOperation", operationID).Return(testCase.operation, nil)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			_, err := service.CancelOperation(operationID)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			readWriteSession.AssertNotCalled(t, "MarkOperationAsCanceling", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("Should return error when operation finished before it was marked as canceling", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(provisionOperation, nil)
+		readWriteSession.On("MarkOperationAsCanceling", operationID, "Operation canceling").Return(dberrors.NotFound("operation not found"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.CancelOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
@@ -46,6 +46,31 @@ func GardenerErrCodesToErrReason(lastErrors ...gardencorev1beta1.LastError) appe
 	return apperrors.ErrReason(strings.Join(vals, ", "))
 }
 
+// retriableGardenerErrCodes are transient infrastructure failures that may succeed when the Shoot operation is retried
+var retriableGardenerErrCodes = map[gardencorev1beta1.ErrorCode]bool{
+	gardencorev1beta1.ErrorInfraRateLimitsExceeded:       true,
+	gardencorev1beta1.ErrorInfraQuotaExceeded:            true,
+	gardencorev1beta1.ErrorInfraResourcesDepleted:        true,
+	gardencorev1beta1.ErrorRetryableInfraDependencies:    true,
+	gardencorev1beta1.ErrorRetryableConfigurationProblem: true,
+}
+
+// IsGardenerErrRetriable returns true if the errors have codes and all of them are transient
+func IsGardenerErrRetriable(lastErrors ...gardencorev1beta1.LastError) bool {
+	hasCodes := false
+
+	for _, e := range lastErrors {
+		for _, code := range e.Codes {
+			if !retriableGardenerErrCodes[code] {
+				return false
+			}
+			hasCodes = true
+		}
+	}
+
+	return hasCodes
+}
+
 func KymaInstallationErrorToErrReason(errEntries ...installationSDK.ErrorEntry) apperrors.ErrReason {
 	var components []string
 
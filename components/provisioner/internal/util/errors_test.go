@@ -0,0 +1,55 @@
+package util
+
+import (
+	"testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsGardenerErrRetriable(t *testing.T) {
+	testCases := []struct {
+		given       []gardencorev1beta1.LastError
+		expected    bool
+		description string
+	}{
+		{
+			given:       nil,
+			expected:    false,
+			description: "no errors",
+		},
+		{
+			given:       []gardencorev1beta1.LastError{{Description: "error without codes"}},
+			expected:    false,
+			description: "errors without codes",
+		},
+		{
+			given: []gardencorev1beta1.LastError{
+				{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}},
+				{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}},
+			},
+			expected:    true,
+			description: "only transient codes",
+		},
+		{
+			given: []gardencorev1beta1.LastError{
+				{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded, gardencorev1beta1.ErrorInfraUnauthorized}},
+			},
+			expected:    false,
+			description: "transient and permanent codes",
+		},
+		{
+			given: []gardencorev1beta1.LastError{
+				{Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}},
+			},
+			expected:    false,
+			description: "only permanent codes",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, IsGardenerErrRetriable(testCase.given...))
+		})
+	}
+}
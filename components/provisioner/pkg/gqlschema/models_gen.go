@@ -122,6 +122,11 @@ type DNSProviderInput struct {
 	Type           string   `json:"type"`
 }
 
+type DryRunResult struct {
+	Shoot string              `json:"shoot"`
+	Diff  []*ShootFieldChange `json:"diff"`
+}
+
 type Error struct {
 	Message *string `json:"message"`
 }
@@ -149,6 +154,8 @@ type GardenerConfig struct {
 	DiskType                            *string                `json:"diskType"`
 	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
 	WorkerCidr                          *string                `json:"workerCidr"`
+	PodsCidr                            *string                `json:"podsCidr"`
+	ServicesCidr                        *string                `json:"servicesCidr"`
 	AutoScalerMin                       *int                   `json:"autoScalerMin"`
 	AutoScalerMax                       *int                   `json:"autoScalerMax"`
 	MaxSurge                            *int                   `json:"maxSurge"`
@@ -164,56 +171,79 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
 	EuAccess                            *bool                  `json:"euAccess"`
+	HibernationSchedules                []*HibernationSchedule `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPool          `json:"workerPools"`
+	APIServerAllowedCidrs               []string               `json:"apiServerAllowedCidrs"`
 }
 
 type GardenerConfigInput struct {
-	Name                                string                 `json:"name"`
-	KubernetesVersion                   string                 `json:"kubernetesVersion"`
-	Provider                            string                 `json:"provider"`
-	TargetSecret                        string                 `json:"targetSecret"`
-	Region                              string                 `json:"region"`
-	MachineType                         string                 `json:"machineType"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	WorkerCidr                          string                 `json:"workerCidr"`
-	AutoScalerMin                       int                    `json:"autoScalerMin"`
-	AutoScalerMax                       int                    `json:"autoScalerMax"`
-	MaxSurge                            int                    `json:"maxSurge"`
-	MaxUnavailable                      int                    `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	LicenceType                         *string                `json:"licenceType"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	DNSConfig                           *DNSConfigInput        `json:"dnsConfig"`
-	Seed                                *string                `json:"seed"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
-	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
-	EuAccess                            *bool                  `json:"euAccess"`
+	Name                                string                      `json:"name"`
+	KubernetesVersion                   string                      `json:"kubernetesVersion"`
+	Provider                            string                      `json:"provider"`
+	TargetSecret                        string                      `json:"targetSecret"`
+	Region                              string                      `json:"region"`
+	MachineType                         string                      `json:"machineType"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	WorkerCidr                          string                      `json:"workerCidr"`
+	PodsCidr                            *string                     `json:"podsCidr"`
+	ServicesCidr                        *string                     `json:"servicesCidr"`
+	AutoScalerMin                       int                         `json:"autoScalerMin"`
+	AutoScalerMax                       int                         `json:"autoScalerMax"`
+	MaxSurge                            int                         `json:"maxSurge"`
+	MaxUnavailable                      int                         `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	LicenceType                         *string                     `json:"licenceType"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	DNSConfig                           *DNSConfigInput             `json:"dnsConfig"`
+	Seed                                *string                     `json:"seed"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	ControlPlaneFailureTolerance        *string                     `json:"controlPlaneFailureTolerance"`
+	EuAccess                            *bool                       `json:"euAccess"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPoolInput          `json:"workerPools"`
+	APIServerAllowedCidrs               []string                    `json:"apiServerAllowedCidrs"`
 }
 
 type GardenerUpgradeInput struct {
-	KubernetesVersion                   *string                `json:"kubernetesVersion"`
-	MachineType                         *string                `json:"machineType"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	AutoScalerMin                       *int                   `json:"autoScalerMin"`
-	AutoScalerMax                       *int                   `json:"autoScalerMax"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	MaxSurge                            *int                   `json:"maxSurge"`
-	MaxUnavailable                      *int                   `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	KubernetesVersion                   *string                     `json:"kubernetesVersion"`
+	MachineType                         *string                     `json:"machineType"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	AutoScalerMin                       *int                        `json:"autoScalerMin"`
+	AutoScalerMax                       *int                        `json:"autoScalerMax"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	MaxSurge                            *int                        `json:"maxSurge"`
+	MaxUnavailable                      *int                        `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	WorkerPools                         []*WorkerPoolInput          `json:"workerPools"`
+	APIServerAllowedCidrs               []string                    `json:"apiServerAllowedCidrs"`
+}
+
+type HibernationSchedule struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
+}
+
+type HibernationScheduleInput struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
 }
 
 type HibernationStatus struct {
@@ -240,6 +270,7 @@ type LastError struct {
 	ErrMessage string `json:"errMessage"`
 	Reason     string `json:"reason"`
 	Component  string `json:"component"`
+	Retriable  bool   `json:"retriable"`
 }
 
 type OIDCConfig struct {
@@ -276,13 +307,24 @@ type OpenStackProviderConfigInput struct {
 	LoadBalancerProvider string   `json:"loadBalancerProvider"`
 }
 
+type OperationPage struct {
+	Data     []*OperationStatus `json:"data"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
 type OperationStatus struct {
-	ID        *string        `json:"id"`
-	Operation OperationType  `json:"operation"`
-	State     OperationState `json:"state"`
-	Message   *string        `json:"message"`
-	RuntimeID *string        `json:"runtimeID"`
-	LastError *LastError     `json:"lastError"`
+	ID           *string        `json:"id"`
+	Operation    OperationType  `json:"operation"`
+	State        OperationState `json:"state"`
+	Message      *string        `json:"message"`
+	RuntimeID    *string        `json:"runtimeID"`
+	LastError    *LastError     `json:"lastError"`
+	DryRunResult *DryRunResult  `json:"dryRunResult"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
 }
 
 type ProviderSpecificInput struct {
@@ -296,6 +338,18 @@ type ProvisionRuntimeInput struct {
 	RuntimeInput  *RuntimeInput       `json:"runtimeInput"`
 	ClusterConfig *ClusterConfigInput `json:"clusterConfig"`
 	KymaConfig    *KymaConfigInput    `json:"kymaConfig"`
+	DryRun        *bool               `json:"dryRun"`
+}
+
+type Runtime struct {
+	ID                  string           `json:"id"`
+	Name                *string          `json:"name"`
+	SubAccountID        *string          `json:"subAccountID"`
+	Provider            *string          `json:"provider"`
+	Region              *string          `json:"region"`
+	KubernetesVersion   *string          `json:"kubernetesVersion"`
+	CreationTimestamp   *string          `json:"creationTimestamp"`
+	LastOperationStatus *OperationStatus `json:"lastOperationStatus"`
 }
 
 type RuntimeConfig struct {
@@ -315,6 +369,11 @@ type RuntimeInput struct {
 	Labels      Labels  `json:"labels"`
 }
 
+type RuntimePage struct {
+	Data     []*Runtime `json:"data"`
+	PageInfo *PageInfo  `json:"pageInfo"`
+}
+
 type RuntimeStatus struct {
 	LastOperationStatus     *OperationStatus         `json:"lastOperationStatus"`
 	RuntimeConnectionStatus *RuntimeConnectionStatus `json:"runtimeConnectionStatus"`
@@ -322,6 +381,19 @@ type RuntimeStatus struct {
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus"`
 }
 
+type RuntimesFilter struct {
+	Name         *string `json:"name"`
+	SubAccountID *string `json:"subAccountID"`
+	Provider     *string `json:"provider"`
+	Region       *string `json:"region"`
+}
+
+type ShootFieldChange struct {
+	Path    string  `json:"path"`
+	Current *string `json:"current"`
+	Desired *string `json:"desired"`
+}
+
 type UpgradeRuntimeInput struct {
 	KymaConfig *KymaConfigInput `json:"kymaConfig"`
 }
@@ -329,6 +401,33 @@ type UpgradeRuntimeInput struct {
 type UpgradeShootInput struct {
 	GardenerConfig *GardenerUpgradeInput `json:"gardenerConfig"`
 	Administrators []string              `json:"administrators"`
+	DryRun         *bool                 `json:"dryRun"`
+}
+
+type WorkerPool struct {
+	Name                *string `json:"name"`
+	MachineType         *string `json:"machineType"`
+	MachineImage        *string `json:"machineImage"`
+	MachineImageVersion *string `json:"machineImageVersion"`
+	DiskType            *string `json:"diskType"`
+	VolumeSizeGb        *int    `json:"volumeSizeGB"`
+	AutoScalerMin       *int    `json:"autoScalerMin"`
+	AutoScalerMax       *int    `json:"autoScalerMax"`
+	MaxSurge            *int    `json:"maxSurge"`
+	MaxUnavailable      *int    `json:"maxUnavailable"`
+}
+
+type WorkerPoolInput struct {
+	Name                string  `json:"name"`
+	MachineType         string  `json:"machineType"`
+	MachineImage        *string `json:"machineImage"`
+	MachineImageVersion *string `json:"machineImageVersion"`
+	DiskType            *string `json:"diskType"`
+	VolumeSizeGb        *int    `json:"volumeSizeGB"`
+	AutoScalerMin       int     `json:"autoScalerMin"`
+	AutoScalerMax       int     `json:"autoScalerMax"`
+	MaxSurge            int     `json:"maxSurge"`
+	MaxUnavailable      int     `json:"maxUnavailable"`
 }
 
 type ConflictStrategy string
@@ -420,6 +519,8 @@ const (
 	OperationStateInProgress OperationState = "InProgress"
 	OperationStateSucceeded  OperationState = "Succeeded"
 	OperationStateFailed     OperationState = "Failed"
+	OperationStateCanceling  OperationState = "Canceling"
+	OperationStateCanceled   OperationState = "Canceled"
 )
 
 var AllOperationState = []OperationState{
@@ -427,11 +528,13 @@ var AllOperationState = []OperationState{
 	OperationStateInProgress,
 	OperationStateSucceeded,
 	OperationStateFailed,
+	OperationStateCanceling,
+	OperationStateCanceled,
 }
 
 func (e OperationState) IsValid() bool {
 	switch e {
-	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed:
+	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed, OperationStateCanceling, OperationStateCanceled:
 		return true
 	}
 	return false
@@ -469,6 +572,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -480,11 +584,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bindings (
+    id                 varchar(255) NOT NULL,
+    instance_id        varchar(255) NOT NULL,
+    created_at         timestamp with time zone NOT NULL,
+    updated_at         timestamp with time zone NOT NULL,
+    expires_at         timestamp with time zone NOT NULL,
+    kubeconfig         text NOT NULL,
+    expiration_seconds integer NOT NULL,
+    PRIMARY KEY (id, instance_id)
+);
+
+CREATE INDEX IF NOT EXISTS bindings_instance_id ON bindings USING HASH (instance_id);
+
+COMMIT;
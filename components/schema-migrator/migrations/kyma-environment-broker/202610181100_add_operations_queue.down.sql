@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS operations_queue_next_run_at;
+
+ALTER TABLE operations DROP COLUMN IF EXISTS queue;
+ALTER TABLE operations DROP COLUMN IF EXISTS next_run_at;
+ALTER TABLE operations DROP COLUMN IF EXISTS lease_owner;
+ALTER TABLE operations DROP COLUMN IF EXISTS lease_expires_at;
+
+COMMIT;
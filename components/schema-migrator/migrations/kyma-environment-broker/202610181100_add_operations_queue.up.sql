@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS queue varchar(64);
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS next_run_at timestamp with time zone;
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS lease_owner varchar(255);
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS lease_expires_at timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS operations_queue_next_run_at ON operations (queue, next_run_at);
+
+-- not finished operations are scheduled by KEB at startup, only if the persistent queue is enabled
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS operation_steps (
+    id           varchar(255) PRIMARY KEY,
+    operation_id varchar(255) NOT NULL,
+    stage        varchar(255) NOT NULL,
+    step         varchar(255) NOT NULL,
+    attempt      integer NOT NULL,
+    started_at   timestamp with time zone NOT NULL,
+    finished_at  timestamp with time zone NOT NULL,
+    outcome      varchar(32) NOT NULL,
+    error        text
+);
+
+CREATE INDEX IF NOT EXISTS operation_steps_operation_id ON operation_steps USING HASH (operation_id);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               varchar(255) PRIMARY KEY,
+    event_id         varchar(255) NOT NULL,
+    event_type       varchar(255) NOT NULL,
+    subscriber       varchar(255) NOT NULL,
+    payload          text NOT NULL,
+    state            varchar(32) NOT NULL,
+    attempts         integer NOT NULL DEFAULT 0,
+    next_attempt_at  timestamp with time zone NOT NULL,
+    last_error       text,
+    lease_owner      varchar(255),
+    lease_expires_at timestamp with time zone,
+    created_at       timestamp with time zone NOT NULL,
+    updated_at       timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_state_next_attempt_at ON webhook_deliveries (state, next_attempt_at);
+
+COMMIT;
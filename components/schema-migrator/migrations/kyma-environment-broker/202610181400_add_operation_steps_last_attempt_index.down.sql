@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS operation_steps_last_attempt;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS operation_steps_last_attempt ON operation_steps (operation_id, stage, step, attempt DESC);
+
+COMMIT;
@@ -0,0 +1,2 @@
+ALTER TABLE orchestrations
+  ADD COLUMN execution_progress text;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config DROP COLUMN hibernation_schedules;
+
+COMMIT;
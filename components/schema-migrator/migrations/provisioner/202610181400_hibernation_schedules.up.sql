@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config ADD COLUMN hibernation_schedules jsonb;
+
+COMMIT;
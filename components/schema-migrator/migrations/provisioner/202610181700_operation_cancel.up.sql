@@ -0,0 +1,2 @@
+ALTER TYPE operation_state ADD VALUE 'CANCELING' AFTER 'FAILED';
+ALTER TYPE operation_state ADD VALUE 'CANCELED' AFTER 'CANCELING';
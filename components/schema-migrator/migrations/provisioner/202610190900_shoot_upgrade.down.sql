@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE shoot_upgrade;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE TABLE shoot_upgrade
+(
+    operation_id uuid PRIMARY KEY,
+    pre_upgrade_gardener_config jsonb NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+COMMIT;
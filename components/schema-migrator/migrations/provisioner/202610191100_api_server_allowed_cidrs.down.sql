@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config DROP COLUMN api_server_allowed_cidrs;
+
+COMMIT;
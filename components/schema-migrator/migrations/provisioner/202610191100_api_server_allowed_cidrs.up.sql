@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE gardener_config ADD COLUMN api_server_allowed_cidrs jsonb;
+
+COMMIT;
@@ -20,6 +20,8 @@ func NewOperationCmd() *cobra.Command {
 	cobraCmd.AddCommand(
 		NewOperationStopCmd(),
 		NewOperationDebugLogsCmd(),
+		NewOperationStepsCmd(),
+		NewOperationResumeCmd(),
 	)
 
 	if cobraCmd.Parent() != nil && cobraCmd.Parent().Context() != nil {
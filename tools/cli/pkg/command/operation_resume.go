@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// OperationResumeCommand represents an execution of the kcp operation resume command
+type OperationResumeCommand struct {
+	cobraCmd     *cobra.Command
+	kebURL       string
+	auth         oauth2.TokenSource
+	ctx          context.Context
+	operationID  string
+	resetTimeout bool
+}
+
+// NewOperationResumeCmd constructs a new instance of OperationResumeCommand and configures it in terms of a cobra.Command
+func NewOperationResumeCmd() *cobra.Command {
+	cmd := OperationResumeCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "resume <operation id>",
+		Short: "Resumes a failed Kyma Environment Broker operation.",
+		Long: `Resumes a failed provisioning, update, or deprovisioning operation from the first unfinished stage.
+The operation is set back to the in progress state and processed again by Kyma Environment Broker. Stages which already finished are not repeated.
+Only the last operation of an instance can be resumed. The command requires the admin permissions.`,
+		Example: `  kcp operation resume 0c4357f5-83e0-4b72-9472-49b5cd417c00                   Resume the given operation.
+  kcp operation resume 0c4357f5-83e0-4b72-9472-49b5cd417c00 --reset-timeout   Resume the given operation and count its timeout from now.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().BoolVar(&cmd.resetTimeout, "reset-timeout", false, "Counts the operation timeout from the time of the resume instead of the creation time of the operation.")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the operation resume command
+func (cmd *OperationResumeCommand) Validate(args []string) error {
+	cmd.operationID = args[0]
+	if cmd.operationID == "" {
+		return errors.New("operation id cannot be empty")
+	}
+	cmd.kebURL = GlobalOpts.KEBAPIURL()
+	cmd.auth = CLICredentialManager(logger.New())
+	cmd.ctx = cmd.cobraCmd.Context()
+	return nil
+}
+
+// Run executes the operation resume command
+func (cmd *OperationResumeCommand) Run() error {
+	httpClient := oauth2.NewClient(cmd.ctx, cmd.auth)
+	client := operation.NewClient(cmd.kebURL, httpClient)
+
+	response, err := client.Resume(cmd.operationID, cmd.resetTimeout)
+	if err != nil {
+		return errors.Wrap(err, "while resuming operation")
+	}
+
+	fmt.Printf("Operation %s resumed, state: %s\n", response.OperationID, response.State)
+	return nil
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationResumeCommand_Run(t *testing.T) {
+	operationID := "0c4357f5-83e0-4b72-9472-49b5cd417c00"
+	expectedPath := fmt.Sprintf("/operations/%s/resume", operationID)
+
+	testCases := map[string]struct {
+		resetTimeout   bool
+		wantErr        bool
+		expectedErrMsg string
+		mockResponse   func(t *testing.T) func(http.ResponseWriter, *http.Request)
+	}{
+		"Success": {
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assertResumeRequest(t, r, expectedPath, false)
+					writeResumeResponse(t, w, operationID)
+				}
+			},
+		},
+		"Success with reset timeout": {
+			resetTimeout: true,
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assertResumeRequest(t, r, expectedPath, true)
+					writeResumeResponse(t, w, operationID)
+				}
+			},
+		},
+		"Operation Not Resumable": {
+			wantErr:        true,
+			expectedErrMsg: "while resuming operation",
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assertResumeRequest(t, r, expectedPath, false)
+					w.WriteHeader(http.StatusConflict)
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			//GIVEN
+			kebSvrMock := httptest.NewServer(http.HandlerFunc(testCase.mockResponse(t)))
+			defer kebSvrMock.Close()
+
+			cmd := &OperationResumeCommand{
+				kebURL:       kebSvrMock.URL,
+				ctx:          context.Background(),
+				operationID:  operationID,
+				resetTimeout: testCase.resetTimeout,
+			}
+
+			//WHEN
+			err := cmd.Run()
+
+			//THEN
+			if testCase.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func assertResumeRequest(t *testing.T, r *http.Request, expectedPath string, resetTimeout bool) {
+	require.Equal(t, http.MethodPost, r.Method)
+	require.Equal(t, expectedPath, r.URL.Path)
+
+	var body operation.ResumeRequest
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	require.Equal(t, resetTimeout, body.ResetTimeout)
+}
+
+func writeResumeResponse(t *testing.T, w http.ResponseWriter, operationID string) {
+	out, err := json.Marshal(operation.ResumeResponse{OperationID: operationID, State: "in progress"})
+	require.NoError(t, err)
+
+	_, err = w.Write(out)
+	require.NoError(t, err)
+}
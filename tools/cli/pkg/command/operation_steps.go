@@ -0,0 +1,170 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+const timelineWidth = 30
+
+// OperationStepsCommand represents an execution of the kcp operation steps command
+type OperationStepsCommand struct {
+	cobraCmd    *cobra.Command
+	kebURL      string
+	auth        oauth2.TokenSource
+	ctx         context.Context
+	output      string
+	operationID string
+}
+
+// NewOperationStepsCmd constructs a new instance of OperationStepsCommand and configures it in terms of a cobra.Command
+func NewOperationStepsCmd() *cobra.Command {
+	cmd := OperationStepsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "steps <operation id>",
+		Short: "Displays the step timeline of a Kyma Environment Broker operation.",
+		Long: `Displays every execution of the operation steps in the order they were started, including retries.
+For each execution, the command shows the stage, the step, the attempt number, the start time relative to the first step, the duration, the outcome, and the error if the step failed.`,
+		Example: `  kcp operation steps 0c4357f5-83e0-4b72-9472-49b5cd417c00           Display the step timeline of the given operation.
+  kcp operation steps 0c4357f5-83e0-4b72-9472-49b5cd417c00 -o json   Display the step journal of the given operation in JSON format.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	SetOutputOpt(cobraCmd, &cmd.output)
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the operation steps command
+func (cmd *OperationStepsCommand) Validate(args []string) error {
+	cmd.operationID = args[0]
+	if cmd.operationID == "" {
+		return errors.New("operation id cannot be empty")
+	}
+	cmd.kebURL = GlobalOpts.KEBAPIURL()
+	cmd.auth = CLICredentialManager(logger.New())
+	cmd.ctx = cmd.cobraCmd.Context()
+	return ValidateOutputOpt(cmd.output)
+}
+
+// Run executes the operation steps command
+func (cmd *OperationStepsCommand) Run() error {
+	httpClient := oauth2.NewClient(cmd.ctx, cmd.auth)
+	client := operation.NewClient(cmd.kebURL, httpClient)
+
+	steps, err := client.ListSteps(cmd.operationID)
+	if err != nil {
+		return errors.Wrap(err, "while listing operation steps")
+	}
+
+	switch {
+	case cmd.output == tableOutput:
+		tp, err := printer.NewTablePrinter(stepColumns(steps), false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(steps)
+	case cmd.output == jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		jp.PrintObj(steps)
+	case strings.HasPrefix(cmd.output, customOutput):
+		_, templateFile := printer.ParseOutputToTemplateTypeAndElement(cmd.output)
+		column, err := printer.ParseColumnToHeaderAndFieldSpec(templateFile)
+		if err != nil {
+			return err
+		}
+		ccp, err := printer.NewTablePrinter(column, false)
+		if err != nil {
+			return err
+		}
+		return ccp.PrintObj(steps)
+	}
+	return nil
+}
+
+// stepColumns returns the columns of the step timeline, the offsets and bars are relative to the first and the last step
+func stepColumns(steps []operation.StepDTO) []printer.Column {
+	var begin, end time.Time
+	for _, s := range steps {
+		if begin.IsZero() || s.StartedAt.Before(begin) {
+			begin = s.StartedAt
+		}
+		if s.FinishedAt.After(end) {
+			end = s.FinishedAt
+		}
+	}
+	total := end.Sub(begin)
+
+	return []printer.Column{
+		{
+			Header: "START",
+			FieldFormatter: func(obj interface{}) string {
+				return fmt.Sprintf("+%s", obj.(operation.StepDTO).StartedAt.Sub(begin).Round(time.Second))
+			},
+		},
+		{
+			Header: "DURATION",
+			FieldFormatter: func(obj interface{}) string {
+				s := obj.(operation.StepDTO)
+				return s.FinishedAt.Sub(s.StartedAt).Round(time.Millisecond).String()
+			},
+		},
+		{
+			Header:    "STAGE",
+			FieldSpec: "{.Stage}",
+		},
+		{
+			Header:    "STEP",
+			FieldSpec: "{.Step}",
+		},
+		{
+			Header:    "ATTEMPT",
+			FieldSpec: "{.Attempt}",
+		},
+		{
+			Header:    "OUTCOME",
+			FieldSpec: "{.Outcome}",
+		},
+		{
+			Header: "TIMELINE",
+			FieldFormatter: func(obj interface{}) string {
+				return timelineBar(obj.(operation.StepDTO), begin, total)
+			},
+		},
+		{
+			Header:    "ERROR",
+			FieldSpec: "{.Error}",
+		},
+	}
+}
+
+// timelineBar draws the step execution as a bar placed on the time axis of the whole operation
+func timelineBar(step operation.StepDTO, begin time.Time, total time.Duration) string {
+	if total <= 0 {
+		return strings.Repeat("#", timelineWidth)
+	}
+	from := int(int64(step.StartedAt.Sub(begin)) * timelineWidth / int64(total))
+	to := int(int64(step.FinishedAt.Sub(begin)) * timelineWidth / int64(total))
+	if to <= from {
+		to = from + 1
+	}
+	if to > timelineWidth {
+		to = timelineWidth
+	}
+	if from >= to {
+		from = to - 1
+	}
+	return strings.Repeat(".", from) + strings.Repeat("#", to-from) + strings.Repeat(".", timelineWidth-to)
+}
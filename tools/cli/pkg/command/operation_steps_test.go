@@ -0,0 +1,145 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/operation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationStepsCommand_Run(t *testing.T) {
+	operationID := "0c4357f5-83e0-4b72-9472-49b5cd417c00"
+	expectedPath := fmt.Sprintf("/operations/%s/steps", operationID)
+
+	testCases := map[string]struct {
+		output         string
+		wantErr        bool
+		expectedErrMsg string
+		mockResponse   func(t *testing.T) func(http.ResponseWriter, *http.Request)
+	}{
+		"Success with table output": {
+			output: tableOutput,
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodGet, r.Method)
+					require.Equal(t, expectedPath, r.URL.Path)
+					writeStepsResponse(t, w, fixRetriedSteps(operationID))
+				}
+			},
+		},
+		"Success with json output": {
+			output: jsonOutput,
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, expectedPath, r.URL.Path)
+					writeStepsResponse(t, w, fixRetriedSteps(operationID))
+				}
+			},
+		},
+		"Operation Not Found": {
+			output:         tableOutput,
+			wantErr:        true,
+			expectedErrMsg: "while listing operation steps",
+			mockResponse: func(t *testing.T) func(http.ResponseWriter, *http.Request) {
+				return func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, expectedPath, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			//GIVEN
+			kebSvrMock := httptest.NewServer(http.HandlerFunc(testCase.mockResponse(t)))
+			defer kebSvrMock.Close()
+
+			cmd := &OperationStepsCommand{
+				kebURL:      kebSvrMock.URL,
+				ctx:         context.Background(),
+				output:      testCase.output,
+				operationID: operationID,
+			}
+
+			//WHEN
+			err := cmd.Run()
+
+			//THEN
+			if testCase.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimelineBar(t *testing.T) {
+	// given
+	steps := fixRetriedSteps("op-id")
+	begin := steps[0].StartedAt
+	total := steps[len(steps)-1].FinishedAt.Sub(begin)
+
+	// when
+	bars := make([]string, 0, len(steps))
+	for _, s := range steps {
+		bars = append(bars, timelineBar(s, begin, total))
+	}
+
+	// then
+	require.Equal(t, "###...........................", bars[0])
+	require.Equal(t, "............###...............", bars[1])
+	require.Equal(t, "........................######", bars[2])
+	for _, bar := range bars {
+		require.Len(t, bar, timelineWidth)
+	}
+}
+
+func fixRetriedSteps(operationID string) []operation.StepDTO {
+	begin := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	return []operation.StepDTO{
+		{
+			OperationID: operationID,
+			Stage:       "create_runtime",
+			Step:        "Check_Runtime",
+			Attempt:     1,
+			StartedAt:   begin,
+			FinishedAt:  begin.Add(10 * time.Second),
+			Outcome:     operation.StepRetrying,
+		},
+		{
+			OperationID: operationID,
+			Stage:       "create_runtime",
+			Step:        "Check_Runtime",
+			Attempt:     2,
+			StartedAt:   begin.Add(40 * time.Second),
+			FinishedAt:  begin.Add(50 * time.Second),
+			Outcome:     operation.StepRetrying,
+		},
+		{
+			OperationID: operationID,
+			Stage:       "create_runtime",
+			Step:        "Check_Runtime",
+			Attempt:     3,
+			StartedAt:   begin.Add(80 * time.Second),
+			FinishedAt:  begin.Add(100 * time.Second),
+			Outcome:     operation.StepSucceeded,
+		},
+	}
+}
+
+func writeStepsResponse(t *testing.T, w http.ResponseWriter, steps []operation.StepDTO) {
+	out, err := json.Marshal(operation.StepsResponse{Data: steps})
+	require.NoError(t, err)
+
+	_, err = w.Write(out)
+	require.NoError(t, err)
+}
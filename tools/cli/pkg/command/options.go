@@ -31,6 +31,13 @@ const (
 	regionTarget     = "region"
 	planTarget       = "plan"
 	shootTarget      = "shoot"
+
+	kubernetesVersionTarget   = "kubernetes-version"
+	machineImageVersionTarget = "machine-image-version"
+	kymaVersionTarget         = "kyma-version"
+	labelTarget               = "label"
+	minAgeTarget              = "min-age"
+	maxAgeTarget              = "max-age"
 )
 
 const (
@@ -219,7 +226,8 @@ func ValidateOutputOpt(opt string) error {
 func SetRuntimeTargetOpts(cmd *cobra.Command, targetInputs *[]string, targetExcludeInputs *[]string) {
 	cmd.Flags().StringArrayVarP(targetInputs, "target", "t", nil,
 		`List of Runtime target specifiers to include. You can specify this option multiple times.
-A target specifier is a comma-separated list of the following selectors:
+A target specifier is a comma-separated list of the following selectors. The value of the kubernetes-version, machine-image-version,
+kyma-version, and label selectors can contain commas, e.g. "kyma-version=>=2.10, <2.12" or "label=env=prod,tier in (a, b)":
   all                 : All Runtimes provisioned successfully and not deprovisioning
   account={REGEXP}    : Regex pattern to match against the Runtime's global account field, e.g. "CA50125541TID000000000741207136", "CA.*"
   subaccount={REGEXP} : Regex pattern to match against the Runtime's subaccount field, e.g. "0d20e315-d0b4-48a2-9512-49bc8eb03cd1"
@@ -227,7 +235,14 @@ A target specifier is a comma-separated list of the following selectors:
   runtime-id={ID}     : Specific Runtime by Runtime ID
   plan={NAME}         : Name of the Runtime's service plan. The possible values are: azure, azure_lite, aws, trial, gcp, openstack
   shoot={NAME}        : Specific Runtime by Shoot cluster name
-  instance-id={ID}    : Specific instance by Instance ID`)
+  instance-id={ID}    : Specific instance by Instance ID
+  kubernetes-version={RANGE}    : Semver range to match against the Shoot cluster's Kubernetes version, e.g. "<1.26", "~1.25"
+  machine-image-version={RANGE} : Semver range to match against the machine image version of any worker pool of the Shoot cluster, e.g. "<934.8"
+  kyma-version={RANGE}          : Semver range to match against the Runtime's Kyma version, e.g. "2.10.x"
+  label={SELECTOR}              : Label selector to match against the Shoot cluster's labels, e.g. "env=prod", "env!=dev", "!deprecated"
+  min-age={DURATION}            : Runtimes which instance was created at least the given time ago, e.g. "720h"
+  max-age={DURATION}            : Runtimes which instance was created at most the given time ago, e.g. "24h"
+Repeat the kubernetes-version, machine-image-version, kyma-version, or label selector in a target specifier to require all of the given conditions.`)
 	cmd.Flags().StringArrayVarP(targetExcludeInputs, "target-exclude", "e", nil,
 		`List of Runtime target specifiers to exclude. You can specify this option multiple times.
 A target specifier is a comma-separated list of the selectors described under the --target option.`)
@@ -255,7 +270,7 @@ func ValidateTransformRuntimeTargetOpts(targetInputs []string, targetExcludeInpu
 
 func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarget, include bool) error {
 	target := orchestration.RuntimeTarget{}
-	selectors := strings.Split(targetInput, ",")
+	selectors := splitTargetSelectors(targetInput)
 	var flagName string
 	if include {
 		flagName = "--target"
@@ -264,7 +279,7 @@ func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarg
 	}
 
 	for _, selector := range selectors {
-		sv := strings.Split(selector, "=")
+		sv := strings.SplitN(selector, "=", 2)
 		selectorKey := sv[0]
 		var selectorValue string
 		if len(sv) > 1 {
@@ -302,6 +317,18 @@ func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarg
 			}
 		case shootTarget:
 			target.Shoot = selectorValue
+		case kubernetesVersionTarget:
+			target.KubernetesVersion = joinRequirement(target.KubernetesVersion, selectorValue)
+		case machineImageVersionTarget:
+			target.MachineImageVersion = joinRequirement(target.MachineImageVersion, selectorValue)
+		case kymaVersionTarget:
+			target.KymaVersion = joinRequirement(target.KymaVersion, selectorValue)
+		case labelTarget:
+			target.LabelSelector = joinRequirement(target.LabelSelector, selectorValue)
+		case minAgeTarget:
+			target.MinAge = selectorValue
+		case maxAgeTarget:
+			target.MaxAge = selectorValue
 		default:
 			return fmt.Errorf("invalid selector: %s %s", flagName, selectorKey)
 		}
@@ -311,6 +338,57 @@ func parseRuntimeTarget(targetInput string, targets *[]orchestration.RuntimeTarg
 	return nil
 }
 
+// splitTargetSelectors splits the target specifier into selectors. A comma separates two selectors only outside of
+// parentheses and only if the selector before it can't hold a list, so the semver ranges like ">=2.10, <2.12" and the
+// label selectors like "env=prod,tier in (a, b)" are kept in one selector
+func splitTargetSelectors(targetInput string) []string {
+	var selectors []string
+	depth, start := 0, 0
+	for i, c := range targetInput {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 && !continuesList(targetInput[start:i], targetInput[i+1:]) {
+				selectors = append(selectors, targetInput[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(selectors, targetInput[start:])
+}
+
+// continuesList tells whether the text after a comma belongs to the list value of the selector before it
+func continuesList(selector, rest string) bool {
+	switch strings.SplitN(selector, "=", 2)[0] {
+	case kubernetesVersionTarget, machineImageVersionTarget, kymaVersionTarget, labelTarget:
+	default:
+		return false
+	}
+	next := strings.SplitN(strings.TrimSpace(rest), ",", 2)[0]
+	if next == orchestration.TargetAll {
+		return false
+	}
+	switch strings.SplitN(next, "=", 2)[0] {
+	case accountTarget, subaccountTarget, regionTarget, runtimeIDTarget, instanceIDTarget, planTarget, shootTarget,
+		kubernetesVersionTarget, machineImageVersionTarget, kymaVersionTarget, labelTarget, minAgeTarget, maxAgeTarget:
+		return false
+	}
+	return true
+}
+
+// joinRequirement adds the requirement to the comma-separated list of requirements, which all must be met
+func joinRequirement(requirements, requirement string) string {
+	if requirements == "" {
+		return requirement
+	}
+	return requirements + "," + requirement
+}
+
 func checkMissingRuntimeTargetSelector(selectorKey, selectorValue string, flagName string) error {
 
 	if selectorKey != orchestration.TargetAll && selectorValue == "" {
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTransformRuntimeTargetOpts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input    string
+		expected orchestration.RuntimeTarget
+	}{
+		"version range with comma": {
+			input:    "kyma-version=>=2.10, <2.12,region=europe",
+			expected: orchestration.RuntimeTarget{KymaVersion: ">=2.10, <2.12", Region: "europe"},
+		},
+		"label selector with set": {
+			input:    "label=env=prod,tier in (a, b),plan=aws",
+			expected: orchestration.RuntimeTarget{LabelSelector: "env=prod,tier in (a, b)", PlanName: "aws"},
+		},
+		"repeated label selector": {
+			input:    "label=env=prod,label=!deprecated",
+			expected: orchestration.RuntimeTarget{LabelSelector: "env=prod,!deprecated"},
+		},
+		"simple selectors": {
+			input:    "account=CA.*,all",
+			expected: orchestration.RuntimeTarget{GlobalAccount: "CA.*", Target: orchestration.TargetAll},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			spec := orchestration.TargetSpec{}
+			err := ValidateTransformRuntimeTargetOpts([]string{tc.input}, nil, &spec)
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, spec.Include, 1)
+			assert.Equal(t, tc.expected, spec.Include[0])
+		})
+	}
+
+	t.Run("comma in a plain selector value", func(t *testing.T) {
+		// when
+		spec := orchestration.TargetSpec{}
+		err := ValidateTransformRuntimeTargetOpts([]string{"account=a,b"}, nil, &spec)
+
+		// then
+		assert.Error(t, err)
+	})
+}
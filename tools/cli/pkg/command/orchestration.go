@@ -17,6 +17,7 @@ import (
 
 const (
 	cancelCommand     = "cancel"
+	resumeCommand     = "resume"
 	retryCommand      = "retry"
 	operationsCommand = "operations"
 	opsCommand        = "ops"
@@ -43,6 +44,7 @@ var cliStates = map[string]string{
 	"canceled":   orchestration.Canceled,
 	"canceling":  orchestration.Canceling,
 	"retrying":   orchestration.Retrying,
+	"paused":     orchestration.Paused,
 }
 
 var orchestrationColumns = []printer.Column{
@@ -111,6 +113,12 @@ Maintenance Window: {{.Parameters.Strategy.MaintenanceWindow}}
 Schedule After:     {{.Parameters.Strategy.ScheduleTime}}
 Notification:       {{.Parameters.Notification}}
 Workers:            {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary:             size {{.Parameters.Strategy.Canary.CanarySize}}, growth factor {{.Parameters.Strategy.Canary.GrowthFactor}}, success ratio {{.Parameters.Strategy.Canary.SuccessRatio}}, soak time {{.Parameters.Strategy.Canary.SoakTime}}
+{{- end }}
+{{- if or .Parameters.Strategy.MaxFailures .Parameters.Strategy.MaxFailurePercentage }}
+Failure Limits:     {{.Parameters.Strategy.MaxFailures}} operations, {{.Parameters.Strategy.MaxFailurePercentage}}%
+{{- end }}
 {{- if eq .Type "upgradeKyma" }}
 Kyma Version:       {{with .Parameters.Kyma}}{{.Version}}{{end}}
 {{- else if eq .Type "upgradeCluster" }}
@@ -142,6 +150,12 @@ Maintenance Window: {{.Parameters.Strategy.MaintenanceWindow}}
 Schedule After:     {{.Parameters.Strategy.Schedule}}
 Notification:       {{.Parameters.Notification}}
 Workers:            {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary:             size {{.Parameters.Strategy.Canary.CanarySize}}, growth factor {{.Parameters.Strategy.Canary.GrowthFactor}}, success ratio {{.Parameters.Strategy.Canary.SuccessRatio}}, soak time {{.Parameters.Strategy.Canary.SoakTime}}
+{{- end }}
+{{- if or .Parameters.Strategy.MaxFailures .Parameters.Strategy.MaxFailurePercentage }}
+Failure Limits:     {{.Parameters.Strategy.MaxFailures}} operations, {{.Parameters.Strategy.MaxFailurePercentage}}%
+{{- end }}
 {{- if eq .Parameters.Kyma.Version "" }}
 Kyma Version:       <determined after start>
 {{- else }}
@@ -165,6 +179,12 @@ Maintenance Window: {{.Parameters.Strategy.MaintenanceWindow}}
 Schedule After:     {{.Parameters.Strategy.Schedule}}
 Notification:       {{.Parameters.Notification}}
 Workers:            {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary:             size {{.Parameters.Strategy.Canary.CanarySize}}, growth factor {{.Parameters.Strategy.Canary.GrowthFactor}}, success ratio {{.Parameters.Strategy.Canary.SuccessRatio}}, soak time {{.Parameters.Strategy.Canary.SoakTime}}
+{{- end }}
+{{- if or .Parameters.Strategy.MaxFailures .Parameters.Strategy.MaxFailurePercentage }}
+Failure Limits:     {{.Parameters.Strategy.MaxFailures}} operations, {{.Parameters.Strategy.MaxFailurePercentage}}%
+{{- end }}
 K8s Version:        <determined after start>
 Targets:
 {{- range $i, $t := .Parameters.Targets.Include }}
@@ -206,7 +226,7 @@ Message:            {{ .Msg }}
 func NewOrchestrationCmd() *cobra.Command {
 	cmd := OrchestrationCommand{}
 	cobraCmd := &cobra.Command{
-		Use:     "orchestrations [id] [ops|operations] [cancel] [retry]",
+		Use:     "orchestrations [id] [ops|operations] [cancel] [resume] [retry]",
 		Aliases: []string{"orchestration", "o"},
 		Short:   "Displays Kyma Control Plane (KCP) orchestrations.",
 		Long: `Displays KCP orchestrations and their primary attributes, such as identifiers, type, state, parameters, or Runtime operations.
@@ -216,6 +236,7 @@ The command has the following modes:
       If the optional --operation flag is provided, it displays details of the specified Runtime operation within the orchestration.
   - When specifying an orchestration ID and ` + "`operations` or `ops`" + ` as arguments. In this mode, the command displays the Runtime operations for the given orchestration.
   - When specifying an orchestration ID and ` + "`cancel`" + ` as arguments. In this mode, the command cancels the orchestration and all pending Runtime operations.
+  - When specifying an orchestration ID and ` + "`resume`" + ` as arguments. In this mode, the command resumes the orchestration paused by the canary strategy.
   - When specifying an orchestration ID and ` + "`retry`" + ` as arguments. In this mode, the command retries all failed Runtime operations of the given orchestration. The ` + "`retry` " + `command only applies to the failed or in progress orchestration.
       If the optional --operation flag is provided, it retries the specified Runtime operation of the given orchestration.`,
 		Example: `  kcp orchestrations --state inprogress                                              Display all orchestrations which are in progress.
@@ -225,6 +246,7 @@ The command has the following modes:
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --operation OID1,OID2       Display details of the specified Runtime operation within the orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 operations                  Display the operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 cancel                      Cancel the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 resume                      Resume the given paused orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry                       Retry all failed operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --operation OID1,OID2 Retry the given operations of the given orchestration
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --now --operation OID1 Retry the given operations of the given orchestration schedule immediately`,
@@ -293,6 +315,8 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 		switch cmd.subCommand {
 		case cancelCommand:
 			return cmd.cancelOrchestration(args[0])
+		case resumeCommand:
+			return cmd.resumeOrchestration(args[0])
 		case retryCommand:
 			return cmd.retryOrchestration(args[0])
 		case operationsCommand, opsCommand:
@@ -325,7 +349,7 @@ func (cmd *OrchestrationCommand) Validate(args []string) error {
 	if len(args) == 2 {
 		cmd.subCommand = args[1]
 		switch cmd.subCommand {
-		case cancelCommand, retryCommand, operationsCommand, opsCommand:
+		case cancelCommand, resumeCommand, retryCommand, operationsCommand, opsCommand:
 		default:
 			return fmt.Errorf("invalid subcommand: %s", cmd.subCommand)
 		}
@@ -471,6 +495,23 @@ func (cmd *OrchestrationCommand) cancelOrchestration(orchestrationID string) err
 
 }
 
+func (cmd *OrchestrationCommand) resumeOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.State != orchestration.Paused {
+		return fmt.Errorf("orchestration is %s, only paused orchestration can be resumed", sr.State)
+	}
+
+	if !PromptUser(fmt.Sprintf("Orchestration was paused: %s\n %d pending operation(s) will be scheduled. Do you want to resume?", sr.Description, sr.OperationStats[orchestration.Pending])) {
+		fmt.Println("resume is not run.")
+		return nil
+	}
+
+	return cmd.client.ResumeOrchestration(orchestrationID)
+}
+
 func (cmd *OrchestrationCommand) retryOrchestration(orchestrationID string) error {
 	sr, err := cmd.client.GetOrchestration(orchestrationID)
 	if err != nil {
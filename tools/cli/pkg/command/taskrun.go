@@ -252,6 +252,16 @@ func (rl RuntimeLister) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
 	return res.Data, nil
 }
 
+// ListRuntimes fetches the runtimes with the given IDs from KEB using the runtime client
+func (rl RuntimeLister) ListRuntimes(runtimeIDs []string) ([]runtime.RuntimeDTO, error) {
+	res, err := rl.client.ListRuntimes(runtime.ListParameters{RuntimeIDs: runtimeIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "while querying runtimes")
+	}
+
+	return res.Data, nil
+}
+
 // NewRuntimeTaskMakager constructs a new RuntimeTaskMakager for the given runtime operations
 func NewRuntimeTaskMakager(cmd *TaskRunCommand, operations []orchestration.RuntimeOperation) *RuntimeTaskMakager {
 	mgr := &RuntimeTaskMakager{
@@ -46,8 +46,15 @@ func NewUpgradeCmd() *cobra.Command {
 // SetUpgradeOpts configures the upgrade specific options on the given command
 func (cmd *UpgradeCommand) SetUpgradeOpts(cobraCmd *cobra.Command) {
 	SetRuntimeTargetOpts(cobraCmd, &cmd.targetInputs, &cmd.targetExcludeInputs)
-	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use.")
+	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use. Possible values: \"parallel\" or \"canary\".")
 	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Parallel.Workers, "parallel-workers", 1, "Number of parallel workers to use in parallel orchestration strategy. By default the amount of workers will be auto-selected on control plane server side.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Canary.CanarySize, "canary-size", orchestration.DefaultCanarySize, "Number of Runtimes in the first wave of the canary orchestration strategy.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Canary.GrowthFactor, "canary-growth-factor", orchestration.DefaultGrowthFactor, "How many times every next wave of the canary orchestration strategy is bigger than the previous one.")
+	cobraCmd.Flags().Float64Var(&cmd.orchestrationParams.Strategy.Canary.SuccessRatio, "canary-success-ratio", orchestration.DefaultSuccessRatio, "Minimal ratio (0-1) of succeeded operations in a wave of the canary orchestration strategy required to start the next wave. Otherwise, the orchestration is paused.")
+	cobraCmd.Flags().StringVar(&cmd.orchestrationParams.Strategy.Canary.SoakTime, "canary-soak-time", "", "Time to wait after a successful wave of the canary orchestration strategy before the next wave starts, e.g. 30m.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.MaxFailures, "max-failures", 0, "Pause the orchestration when the number of failed operations exceeds this value. The value 0 means no limit.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.MaxFailurePercentage, "max-failure-percentage", 0, "Pause the orchestration when the percentage (0-100) of failed operations among the finished ones exceeds this value. The value 0 means no limit.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.MinFinishedOperations, "min-finished-operations", 0, "Minimum number of finished operations before the max-failure-percentage is checked. The value 0 means the default of the control plane server side.")
 	cobraCmd.Flags().BoolVarP(&cmd.maintenancewindow, "maintenancewindow", "", false, "Schedule the upgrade in the next possible maintenancewindow after 'schedule'. (default: false)")
 	cobraCmd.Flags().StringVar(&cmd.schedule, "schedule", "now", "Orchestration schedule to use. Possible values: \"immediate\", \"now\" or a date (2006-01-01) . By default the schedule will be auto-selected on control plane server side.")
 	cobraCmd.Flags().BoolVarP(&cmd.notification, "notification", "", false, "Schedule the upgrade with customer notification enabled. (default: false)")
@@ -93,9 +100,38 @@ func (cmd *UpgradeCommand) ValidateTransformUpgradeOpts() error {
 	switch cmd.strategy {
 	case string(orchestration.ParallelStrategy):
 		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
+		cmd.orchestrationParams.Strategy.Canary = orchestration.CanaryStrategySpec{}
+	case string(orchestration.CanaryStrategy):
+		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
+		canary := cmd.orchestrationParams.Strategy.Canary
+		if canary.CanarySize < 1 {
+			return fmt.Errorf("invalid value for canary-size: %d", canary.CanarySize)
+		}
+		if canary.GrowthFactor < 1 {
+			return fmt.Errorf("invalid value for canary-growth-factor: %d", canary.GrowthFactor)
+		}
+		if canary.SuccessRatio < 0 || canary.SuccessRatio > 1 {
+			return fmt.Errorf("invalid value for canary-success-ratio: %v", canary.SuccessRatio)
+		}
+		if canary.SoakTime != "" {
+			if _, err := time.ParseDuration(canary.SoakTime); err != nil {
+				return fmt.Errorf("invalid value for canary-soak-time: %s", canary.SoakTime)
+			}
+		}
 	default:
 		return fmt.Errorf("invalid value for strategy: %s", cmd.strategy)
 	}
 
+	// Validate failure limits
+	if cmd.orchestrationParams.Strategy.MaxFailures < 0 {
+		return fmt.Errorf("invalid value for max-failures: %d", cmd.orchestrationParams.Strategy.MaxFailures)
+	}
+	if cmd.orchestrationParams.Strategy.MaxFailurePercentage < 0 || cmd.orchestrationParams.Strategy.MaxFailurePercentage > 100 {
+		return fmt.Errorf("invalid value for max-failure-percentage: %d", cmd.orchestrationParams.Strategy.MaxFailurePercentage)
+	}
+	if cmd.orchestrationParams.Strategy.MinFinishedOperations < 0 {
+		return fmt.Errorf("invalid value for min-finished-operations: %d", cmd.orchestrationParams.Strategy.MinFinishedOperations)
+	}
+
 	return nil
 }